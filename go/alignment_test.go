@@ -0,0 +1,117 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlignedToMonthStart(t *testing.T) {
+	s, err := ParseSchedule("every 3 days at 09:00 aligned to month start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); got != "every 3 days at 09:00 aligned to month start" {
+		t.Errorf("String() = %q", got)
+	}
+
+	from := time.Date(2026, 1, 27, 12, 0, 0, 0, time.UTC)
+	got := s.NextNFrom(from, 4)
+	want := []time.Time{
+		time.Date(2026, 1, 28, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 4, 9, 0, 0, 0, time.UTC),
+	}
+	for i := range want {
+		if i >= len(got) || !got[i].Equal(want[i]) {
+			t.Fatalf("NextNFrom() = %v, want %v", got, want)
+		}
+	}
+
+	prev := s.PreviousFrom(time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC))
+	if prev == nil || !prev.Equal(want[2]) {
+		t.Errorf("PreviousFrom() = %v, want %v", prev, want[2])
+	}
+	if !s.Matches(want[1]) || s.Matches(time.Date(2026, 2, 2, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() disagrees with month-start alignment")
+	}
+}
+
+func TestAlignedToISOWeeks(t *testing.T) {
+	s, err := ParseSchedule("every 2 weeks on monday at 09:00 aligned to iso weeks")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2026-12-28 is ISO week 53 of 2026, 2027-01-04 is week 1 of 2027.
+	from := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	got := s.NextNFrom(from, 2)
+	want := []time.Time{
+		time.Date(2026, 12, 28, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 4, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != 2 || !got[0].Equal(want[0]) || !got[1].Equal(want[1]) {
+		t.Errorf("NextNFrom() = %v, want %v", got, want)
+	}
+	if !s.Matches(want[1]) {
+		t.Error("Matches() = false for ISO week 1")
+	}
+}
+
+func TestAlignedToYearStartMonths(t *testing.T) {
+	s := MustParse("every 5 months on the 1st at 09:00 aligned to year start in UTC")
+
+	// Counted from each January: Jan, Jun, Nov, then Jan again
+	from := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	got := s.NextNFrom(from, 4)
+	want := []time.Time{
+		time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 6, 1, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Fatalf("NextNFrom() = %v, want %v", got, want)
+		}
+	}
+
+	prev := s.PreviousFrom(time.Date(2027, 5, 1, 0, 0, 0, 0, time.UTC))
+	if prev == nil || !prev.Equal(want[2]) {
+		t.Errorf("PreviousFrom() = %v, want %v", prev, want[2])
+	}
+	if !s.Matches(want[2]) || s.Matches(time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() disagrees with year-start alignment")
+	}
+}
+
+func TestAlignedToEpochIgnoresStarting(t *testing.T) {
+	s, err := ParseSchedule("every 3 days at 09:00 aligned to epoch starting 2026-02-07")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Epoch-aligned days are Feb 6, 9, 12 regardless of the anchor.
+	next := s.NextFrom(time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	want := time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC)
+	if next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", next, want)
+	}
+}
+
+func TestAlignmentParseErrors(t *testing.T) {
+	inputs := []string{
+		"every day at 09:00 aligned to month start",
+		"every 30 min from 09:00 to 17:00 aligned to month start",
+		"every 2 weeks on monday at 09:00 aligned to month start",
+		"every 2 months on the 1st at 09:00 aligned to iso weeks",
+		"every 3 days at 09:00 aligned to month",
+	}
+	for _, input := range inputs {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("expected parse error for %q", input)
+		}
+	}
+}
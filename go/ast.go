@@ -3,6 +3,7 @@ package hron
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // Weekday represents a day of the week.
@@ -125,21 +126,61 @@ func ParseMonthName(s string) (MonthName, bool) {
 	return m, ok
 }
 
-// IntervalUnit represents the unit of an interval (minutes or hours).
+// SolarEvent is a time of day set by the sun, written in place of the times of an
+// expression (e.g., every day at sunset). The zero SolarEvent means clock times.
+type SolarEvent int
+
+const (
+	SolarSunrise SolarEvent = iota + 1
+	SolarSunset
+	SolarDawn // Civil dawn, when the sun is 6 degrees below the horizon before sunrise
+	SolarDusk // Civil dusk, when the sun is 6 degrees below the horizon after sunset
+)
+
+func (e SolarEvent) String() string {
+	switch e {
+	case SolarSunrise:
+		return "sunrise"
+	case SolarSunset:
+		return "sunset"
+	case SolarDawn:
+		return "dawn"
+	case SolarDusk:
+		return "dusk"
+	}
+	return ""
+}
+
+// IntervalUnit represents the unit of an interval (minutes, hours, or seconds).
 type IntervalUnit int
 
 const (
 	IntervalMin IntervalUnit = iota
 	IntervalHours
+	IntervalSeconds
 )
 
 func (u IntervalUnit) String() string {
-	if u == IntervalMin {
+	switch u {
+	case IntervalMin:
 		return "min"
+	case IntervalSeconds:
+		return "seconds"
 	}
 	return "hours"
 }
 
+// Seconds returns the length of interval units in seconds.
+func (u IntervalUnit) Seconds(interval int) int {
+	switch u {
+	case IntervalSeconds:
+		return interval
+	case IntervalHours:
+		return interval * 3600
+	}
+	return interval * 60
+}
+
 // OrdinalPosition represents an ordinal position (first, second, etc.).
 type OrdinalPosition int
 
@@ -152,7 +193,15 @@ const (
 	Last
 )
 
-// ToN returns the ordinal as a number (1-5, or -1 for Last).
+// Ordinals counted from the end of the month ("second to last").
+const (
+	SecondToLast OrdinalPosition = -(iota + 2)
+	ThirdToLast
+	FourthToLast
+	FifthToLast
+)
+
+// ToN returns the ordinal as a number (1-5, or -1 to -5 counting from the end).
 func (o OrdinalPosition) ToN() int {
 	if o == Last {
 		return -1
@@ -160,14 +209,23 @@ func (o OrdinalPosition) ToN() int {
 	return int(o)
 }
 
+// FromEnd reports whether the ordinal counts from the end of the month.
+func (o OrdinalPosition) FromEnd() bool {
+	return o == Last || o < 0
+}
+
 func (o OrdinalPosition) String() string {
 	names := map[OrdinalPosition]string{
-		First:  "first",
-		Second: "second",
-		Third:  "third",
-		Fourth: "fourth",
-		Fifth:  "fifth",
-		Last:   "last",
+		First:        "first",
+		Second:       "second",
+		Third:        "third",
+		Fourth:       "fourth",
+		Fifth:        "fifth",
+		Last:         "last",
+		SecondToLast: "second to last",
+		ThirdToLast:  "third to last",
+		FourthToLast: "fourth to last",
+		FifthToLast:  "fifth to last",
 	}
 	return names[o]
 }
@@ -181,6 +239,11 @@ func ParseOrdinalPosition(s string) (OrdinalPosition, bool) {
 		"fourth": Fourth,
 		"fifth":  Fifth,
 		"last":   Last,
+
+		"second to last": SecondToLast,
+		"third to last":  ThirdToLast,
+		"fourth to last": FourthToLast,
+		"fifth to last":  FifthToLast,
 	}
 	o, ok := ordinalParse[strings.ToLower(s)]
 	return o, ok
@@ -290,6 +353,9 @@ const (
 	MonthTargetKindLastWeekday
 	MonthTargetKindNearestWeekday
 	MonthTargetKindOrdinalWeekday
+	MonthTargetKindWeekOfMonth
+	MonthTargetKindBusinessDay
+	MonthTargetKindLastBusinessDay
 )
 
 // NearestDirection represents the direction for nearest weekday calculations.
@@ -304,14 +370,22 @@ const (
 	NearestPrevious
 )
 
+// OrdinalWeekday pairs an ordinal position with a weekday (e.g., third monday).
+type OrdinalWeekday struct {
+	Ordinal OrdinalPosition
+	Weekday Weekday
+}
+
 // MonthTarget represents which day(s) within a month a schedule fires on.
 type MonthTarget struct {
 	Kind      MonthTargetKind
 	Specs     []DayOfMonthSpec // Only used when Kind == MonthTargetKindDays
-	Day       int              // Only used when Kind == MonthTargetKindNearestWeekday
+	Day       int              // Used when Kind == MonthTargetKindNearestWeekday or MonthTargetKindBusinessDay
 	Direction NearestDirection // Only used when Kind == MonthTargetKindNearestWeekday
-	Ordinal   OrdinalPosition  // Only used when Kind == MonthTargetKindOrdinalWeekday
+	Ordinal   OrdinalPosition  // Used when Kind == MonthTargetKindOrdinalWeekday or MonthTargetKindWeekOfMonth
 	Weekday   Weekday          // Only used when Kind == MonthTargetKindOrdinalWeekday
+	Ordinals  []OrdinalWeekday // All pairs when Kind == MonthTargetKindOrdinalWeekday lists more than one
+	WeekDays  []Weekday        // Only used when Kind == MonthTargetKindWeekOfMonth
 }
 
 // NewDaysTarget creates a month target for specific days.
@@ -339,6 +413,43 @@ func NewOrdinalWeekdayTarget(ordinal OrdinalPosition, weekday Weekday) MonthTarg
 	return MonthTarget{Kind: MonthTargetKindOrdinalWeekday, Ordinal: ordinal, Weekday: weekday}
 }
 
+// NewOrdinalWeekdaysTarget creates a month target for a list of ordinal weekdays
+// (e.g., first monday, third monday, last friday).
+func NewOrdinalWeekdaysTarget(pairs []OrdinalWeekday) MonthTarget {
+	target := NewOrdinalWeekdayTarget(pairs[0].Ordinal, pairs[0].Weekday)
+	if len(pairs) > 1 {
+		target.Ordinals = pairs
+	}
+	return target
+}
+
+// NewBusinessDayTarget creates a month target for the nth business day (weekday that isn't a holiday).
+func NewBusinessDayTarget(n int) MonthTarget {
+	return MonthTarget{Kind: MonthTargetKindBusinessDay, Day: n}
+}
+
+// NewLastBusinessDayTarget creates a month target for the last business day.
+func NewLastBusinessDayTarget() MonthTarget {
+	return MonthTarget{Kind: MonthTargetKindLastBusinessDay}
+}
+
+// NewWeekOfMonthTarget creates a month target for days within an ISO week of the month
+// (e.g., tuesday of the second week). A week belongs to the month containing its Thursday.
+func NewWeekOfMonthTarget(week OrdinalPosition, days []Weekday) MonthTarget {
+	return MonthTarget{Kind: MonthTargetKindWeekOfMonth, Ordinal: week, WeekDays: days}
+}
+
+// OrdinalWeekdays returns every (ordinal, weekday) pair of an ordinal weekday target.
+func (m MonthTarget) OrdinalWeekdays() []OrdinalWeekday {
+	if m.Kind != MonthTargetKindOrdinalWeekday {
+		return nil
+	}
+	if len(m.Ordinals) > 0 {
+		return m.Ordinals
+	}
+	return []OrdinalWeekday{{Ordinal: m.Ordinal, Weekday: m.Weekday}}
+}
+
 // ExpandDays returns all days specified by this target.
 func (m MonthTarget) ExpandDays() []int {
 	if m.Kind != MonthTargetKindDays {
@@ -361,13 +472,14 @@ const (
 	YearTargetKindOrdinalWeekday
 	YearTargetKindDayOfMonth
 	YearTargetKindLastWeekday
+	YearTargetKindDayOfYear
 )
 
 // YearTarget represents which day within a year a schedule fires on.
 type YearTarget struct {
 	Kind    YearTargetKind
 	Month   MonthName
-	Day     int             // Used for Date and DayOfMonth
+	Day     int             // Used for Date and DayOfMonth, and DayOfYear (1-366)
 	Ordinal OrdinalPosition // Used for OrdinalWeekday
 	Weekday Weekday         // Used for OrdinalWeekday
 }
@@ -392,6 +504,12 @@ func NewYearLastWeekdayTarget(month MonthName) YearTarget {
 	return YearTarget{Kind: YearTargetKindLastWeekday, Month: month}
 }
 
+// NewYearDayOfYearTarget creates a year target for the nth day of the year, counted from
+// jan 1. Day 366 exists only in leap years, which skip it otherwise.
+func NewYearDayOfYearTarget(day int) YearTarget {
+	return YearTarget{Kind: YearTargetKindDayOfYear, Day: day}
+}
+
 // --- Date spec ---
 
 // DateSpecKind represents the type of date specification.
@@ -400,14 +518,29 @@ type DateSpecKind int
 const (
 	DateSpecKindNamed DateSpecKind = iota
 	DateSpecKindISO
+	DateSpecKindEvent
+	DateSpecKindRelative
 )
 
-// DateSpec represents a date (either named like "feb 14" or ISO like "2026-03-15").
+// RelativeUnit is the unit of a relative date offset.
+type RelativeUnit int
+
+const (
+	RelativeDays RelativeUnit = iota
+	RelativeWeekdays
+)
+
+// DateSpec represents a date: named like "feb 14", ISO like "2026-03-15", a named
+// event like "easter", or an offset from one of those like "the weekday before dec 25".
 type DateSpec struct {
-	Kind  DateSpecKind
-	Month MonthName // Used for named dates
-	Day   int       // Used for named dates
-	Date  string    // Used for ISO dates (YYYY-MM-DD)
+	Kind   DateSpecKind
+	Month  MonthName    // Used for named dates
+	Day    int          // Used for named dates
+	Date   string       // Used for ISO dates (YYYY-MM-DD)
+	Event  string       // Used for event dates
+	Offset int          // Used for relative dates; negative means before
+	Unit   RelativeUnit // Used for relative dates
+	Base   *DateSpec    // Used for relative dates
 }
 
 // NewNamedDate creates a named date specification.
@@ -420,6 +553,18 @@ func NewISODate(date string) DateSpec {
 	return DateSpec{Kind: DateSpecKindISO, Date: date}
 }
 
+// NewEventDate creates a date specification for a named event, resolved against the
+// schedule's EventSource when evaluated.
+func NewEventDate(name string) DateSpec {
+	return DateSpec{Kind: DateSpecKindEvent, Event: name}
+}
+
+// NewRelativeDate creates a date specification offset from base by a number of days
+// or weekdays. A negative offset means before the base date.
+func NewRelativeDate(offset int, unit RelativeUnit, base DateSpec) DateSpec {
+	return DateSpec{Kind: DateSpecKindRelative, Offset: offset, Unit: unit, Base: &base}
+}
+
 // --- Exception spec ---
 
 // ExceptionSpecKind represents the type of exception specification.
@@ -428,6 +573,7 @@ type ExceptionSpecKind int
 const (
 	ExceptionSpecKindNamed ExceptionSpecKind = iota
 	ExceptionSpecKindISO
+	ExceptionSpecKindHolidays
 )
 
 // ExceptionSpec represents an exception date.
@@ -448,6 +594,11 @@ func NewISOException(date string) ExceptionSpec {
 	return ExceptionSpec{Kind: ExceptionSpecKindISO, Date: date}
 }
 
+// NewHolidaysException creates an exception for every holiday in the schedule's attached calendar.
+func NewHolidaysException() ExceptionSpec {
+	return ExceptionSpec{Kind: ExceptionSpecKindHolidays}
+}
+
 // --- Until spec ---
 
 // UntilSpecKind represents the type of until specification.
@@ -456,14 +607,24 @@ type UntilSpecKind int
 const (
 	UntilSpecKindISO UntilSpecKind = iota
 	UntilSpecKindNamed
+	UntilSpecKindRelative
 )
 
-// UntilSpec represents an until date.
+// UntilSpec represents an until date, and optionally the time of day on it the schedule
+// ends at.
 type UntilSpec struct {
-	Kind  UntilSpecKind
-	Date  string    // Used for ISO dates
-	Month MonthName // Used for named dates
-	Day   int       // Used for named dates
+	Kind     UntilSpecKind
+	Date     string         // Used for ISO dates
+	Month    MonthName      // Used for named dates
+	Day      int            // Used for named dates
+	Relative RelativeAnchor // Used for relative dates
+	Time     *TimeOfDay     // Last time of day allowed on the date; nil allows the whole day
+}
+
+// At returns the until spec ending at time t of its date.
+func (u UntilSpec) At(t TimeOfDay) UntilSpec {
+	u.Time = &t
+	return u
 }
 
 // NewISOUntil creates an ISO until specification.
@@ -476,6 +637,178 @@ func NewNamedUntil(month MonthName, day int) UntilSpec {
 	return UntilSpec{Kind: UntilSpecKindNamed, Month: month, Day: day}
 }
 
+// NewRelativeUntil creates an until specification relative to a reference time.
+func NewRelativeUntil(relative RelativeAnchor) UntilSpec {
+	return UntilSpec{Kind: UntilSpecKindRelative, Relative: relative}
+}
+
+// --- Offsets ---
+
+// Offset moves every occurrence of a schedule by a fixed amount, written as a trailing
+// plus or minus clause (e.g., minus 30 min). The zero Offset moves nothing.
+type Offset struct {
+	Amount int // Units to move by, negative for minus
+	Unit   IntervalUnit
+}
+
+// NewOffset creates an offset of amount units, negative to move occurrences earlier.
+func NewOffset(amount int, unit IntervalUnit) Offset {
+	return Offset{Amount: amount, Unit: unit}
+}
+
+// Duration returns the offset as a duration.
+func (o Offset) Duration() time.Duration {
+	return time.Duration(o.Unit.Seconds(o.Amount)) * time.Second
+}
+
+// offsetOf returns d as an offset in the largest unit that divides it, or false if d is
+// not a whole number of seconds.
+func offsetOf(d time.Duration) (Offset, bool) {
+	switch {
+	case d == 0:
+		return Offset{}, true
+	case d%time.Hour == 0:
+		return NewOffset(int(d/time.Hour), IntervalHours), true
+	case d%time.Minute == 0:
+		return NewOffset(int(d/time.Minute), IntervalMin), true
+	case d%time.Second == 0:
+		return NewOffset(int(d/time.Second), IntervalSeconds), true
+	}
+	return Offset{}, false
+}
+
+// --- Relative anchors ---
+
+// RelativeAnchorKind represents the type of relative anchor.
+type RelativeAnchorKind int
+
+const (
+	RelativeAnchorToday RelativeAnchorKind = iota
+	RelativeAnchorTomorrow
+	RelativeAnchorNextWeekday // The first such weekday after the reference date
+	RelativeAnchorNextPeriod  // The first day of the following week, month, or year
+	RelativeAnchorEndOfPeriod // The last day of the reference date's week, month, or year
+)
+
+// AnchorPeriod is the calendar period of a relative anchor.
+type AnchorPeriod int
+
+const (
+	AnchorPeriodWeek AnchorPeriod = iota
+	AnchorPeriodMonth
+	AnchorPeriodYear
+)
+
+func (p AnchorPeriod) String() string {
+	switch p {
+	case AnchorPeriodWeek:
+		return "week"
+	case AnchorPeriodMonth:
+		return "month"
+	case AnchorPeriodYear:
+		return "year"
+	}
+	return "unknown"
+}
+
+// RelativeAnchor is a starting or until date named relative to a reference time, such as
+// `tomorrow`, `next monday`, or `end of month`. It is resolved when the schedule is
+// evaluated; weeks run Monday to Sunday.
+type RelativeAnchor struct {
+	Kind    RelativeAnchorKind
+	Weekday Weekday      // Used for NextWeekday
+	Period  AnchorPeriod // Used for NextPeriod and EndOfPeriod
+}
+
+func (r RelativeAnchor) String() string {
+	switch r.Kind {
+	case RelativeAnchorToday:
+		return "today"
+	case RelativeAnchorTomorrow:
+		return "tomorrow"
+	case RelativeAnchorNextWeekday:
+		return "next " + r.Weekday.String()
+	case RelativeAnchorNextPeriod:
+		return "next " + r.Period.String()
+	case RelativeAnchorEndOfPeriod:
+		return "end of " + r.Period.String()
+	}
+	return "unknown"
+}
+
+// Resolve returns the date the anchor names, at midnight UTC, for the civil date of ref
+// in its own location.
+func (r RelativeAnchor) Resolve(ref time.Time) time.Time {
+	d := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+	weekStart := d.AddDate(0, 0, 1-isoWeekday(d))
+	switch r.Kind {
+	case RelativeAnchorTomorrow:
+		return d.AddDate(0, 0, 1)
+	case RelativeAnchorNextWeekday:
+		return d.AddDate(0, 0, (r.Weekday.Number()-isoWeekday(d)+6)%7+1)
+	case RelativeAnchorNextPeriod:
+		switch r.Period {
+		case AnchorPeriodWeek:
+			return weekStart.AddDate(0, 0, 7)
+		case AnchorPeriodMonth:
+			return time.Date(d.Year(), d.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		default:
+			return time.Date(d.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+		}
+	case RelativeAnchorEndOfPeriod:
+		switch r.Period {
+		case AnchorPeriodWeek:
+			return weekStart.AddDate(0, 0, 6)
+		case AnchorPeriodMonth:
+			return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		default:
+			return time.Date(d.Year(), 12, 31, 0, 0, 0, 0, time.UTC)
+		}
+	}
+	return d
+}
+
+// --- During windows ---
+
+// DateWindow is a yearly range of dates for the during clause (e.g., jun 15 to aug 31).
+// Both ends are inclusive; a window whose end precedes its start wraps over the new year.
+type DateWindow struct {
+	FromMonth MonthName
+	FromDay   int
+	ToMonth   MonthName
+	ToDay     int
+}
+
+// NewDateWindow creates a during date window.
+func NewDateWindow(fromMonth MonthName, fromDay int, toMonth MonthName, toDay int) DateWindow {
+	return DateWindow{FromMonth: fromMonth, FromDay: fromDay, ToMonth: toMonth, ToDay: toDay}
+}
+
+// WeekRange is an inclusive range of ISO week numbers (1-53) for the during clause
+// (e.g., weeks 10 to 20). A range whose end precedes its start wraps over the new year.
+type WeekRange struct {
+	From int
+	To   int
+}
+
+// NewWeekRange creates a during week range.
+func NewWeekRange(from, to int) WeekRange {
+	return WeekRange{From: from, To: to}
+}
+
+// YearRange is an inclusive range of calendar years for the during clause
+// (e.g., 2026 to 2028). Unlike the other during entries it bounds the schedule:
+// dates must fall in one of the year ranges and also match the rest of the clause.
+type YearRange struct {
+	From int
+	To   int
+}
+
+// NewYearRange creates a during year range.
+func NewYearRange(from, to int) YearRange {
+	return YearRange{From: from, To: to}
+}
+
 // --- Schedule expressions ---
 
 // ScheduleExprKind represents the type of schedule expression.
@@ -488,20 +821,81 @@ const (
 	ScheduleExprKindMonth
 	ScheduleExprKindSingleDate
 	ScheduleExprKindYear
+	ScheduleExprKindRandom
+	ScheduleExprKindFiscal
+)
+
+// RandomPeriod is the period within which a random expression picks one day.
+type RandomPeriod int
+
+const (
+	RandomPeriodWeek RandomPeriod = iota
+	RandomPeriodMonth
+)
+
+func (p RandomPeriod) String() string {
+	if p == RandomPeriodMonth {
+		return "month"
+	}
+	return "week"
+}
+
+// FiscalUnit is the fiscal period a fiscal repeat runs once in.
+type FiscalUnit int
+
+const (
+	FiscalMonth FiscalUnit = iota
+	FiscalQuarter
+	FiscalYear
 )
 
-// ScheduleExpr represents a schedule expression (one of the 6 variants).
+func (u FiscalUnit) String() string {
+	switch u {
+	case FiscalQuarter:
+		return "quarter"
+	case FiscalYear:
+		return "year"
+	default:
+		return "month"
+	}
+}
+
+// MonthPeriod is the unit a month repeat was written in. Interval always counts months;
+// the period only preserves the quarter or half-year form for display.
+type MonthPeriod int
+
+const (
+	MonthPeriodMonth MonthPeriod = iota
+	MonthPeriodQuarter
+	MonthPeriodHalfYear
+)
+
+// Months returns the number of months in one period.
+func (p MonthPeriod) Months() int {
+	switch p {
+	case MonthPeriodQuarter:
+		return 3
+	case MonthPeriodHalfYear:
+		return 6
+	default:
+		return 1
+	}
+}
+
+// ScheduleExpr represents a schedule expression (one of the 8 variants).
 type ScheduleExpr struct {
 	Kind ScheduleExprKind
 
 	// Common fields
 	Interval int
 	Times    []TimeOfDay
+	Solar    SolarEvent // Replaces Times when set; the times are then empty
 
 	// IntervalRepeat fields
 	Unit      IntervalUnit
 	FromTime  TimeOfDay
 	ToTime    TimeOfDay
+	Overnight bool       // The window ends at ToTime on the next day (`to 02:00 next day`)
 	DayFilter *DayFilter // Optional for interval
 
 	// DayRepeat fields
@@ -510,14 +904,24 @@ type ScheduleExpr struct {
 	// WeekRepeat fields
 	WeekDays []Weekday
 
-	// MonthRepeat fields
+	// MonthRepeat fields, also used by FiscalRepeat
 	MonthTarget MonthTarget
+	MonthPeriod MonthPeriod // Written unit of Interval (every quarter is 3 months)
 
 	// SingleDateExpr fields
-	DateSpec DateSpec
+	DateSpec  DateSpec
+	DateSpecs []DateSpec // All dates when a single date expression lists more than one
 
 	// YearRepeat fields
-	YearTarget YearTarget
+	YearTarget  YearTarget
+	YearTargets []YearTarget // All targets when a year repeat lists more than one
+
+	// RandomPick fields (Days holds the pool of eligible days)
+	Period RandomPeriod
+	Seed   string
+
+	// FiscalRepeat fields
+	Fiscal FiscalUnit
 }
 
 // NewIntervalRepeat creates an interval repeat expression.
@@ -571,6 +975,27 @@ func NewSingleDateExpr(date DateSpec, times []TimeOfDay) ScheduleExpr {
 	}
 }
 
+// NewSingleDatesExpr creates a single date expression running once on each of several
+// dates (e.g., release dates).
+func NewSingleDatesExpr(dates []DateSpec, times []TimeOfDay) ScheduleExpr {
+	expr := NewSingleDateExpr(dates[0], times)
+	if len(dates) > 1 {
+		expr.DateSpecs = dates
+	}
+	return expr
+}
+
+// AllDateSpecs returns every date of a single date expression.
+func (e ScheduleExpr) AllDateSpecs() []DateSpec {
+	if e.Kind != ScheduleExprKindSingleDate {
+		return nil
+	}
+	if len(e.DateSpecs) > 0 {
+		return e.DateSpecs
+	}
+	return []DateSpec{e.DateSpec}
+}
+
 // NewYearRepeat creates a year repeat expression.
 func NewYearRepeat(interval int, target YearTarget, times []TimeOfDay) ScheduleExpr {
 	return ScheduleExpr{
@@ -581,16 +1006,115 @@ func NewYearRepeat(interval int, target YearTarget, times []TimeOfDay) ScheduleE
 	}
 }
 
+// NewYearRepeatTargets creates a year repeat expression firing on each of several
+// targets (e.g., jan 15 and jul 15).
+func NewYearRepeatTargets(interval int, targets []YearTarget, times []TimeOfDay) ScheduleExpr {
+	expr := NewYearRepeat(interval, targets[0], times)
+	if len(targets) > 1 {
+		expr.YearTargets = targets
+	}
+	return expr
+}
+
+// AllYearTargets returns every target of a year repeat.
+func (e ScheduleExpr) AllYearTargets() []YearTarget {
+	if e.Kind != ScheduleExprKindYear {
+		return nil
+	}
+	if len(e.YearTargets) > 0 {
+		return e.YearTargets
+	}
+	return []YearTarget{e.YearTarget}
+}
+
+// NewRandomPick creates an expression that fires on one pseudo-randomly chosen day from
+// the eligible days of each period. The choice is a pure function of the seed and period.
+func NewRandomPick(days DayFilter, period RandomPeriod, seed string, times []TimeOfDay) ScheduleExpr {
+	return ScheduleExpr{
+		Kind:   ScheduleExprKindRandom,
+		Days:   days,
+		Period: period,
+		Seed:   seed,
+		Times:  times,
+	}
+}
+
+// NewFiscalRepeat creates an expression that fires on the target day of every fiscal
+// month, quarter, or year, as laid out by the FiscalCalendar attached to the schedule.
+// Day numbers count from the first day of the period.
+func NewFiscalRepeat(unit FiscalUnit, target MonthTarget, times []TimeOfDay) ScheduleExpr {
+	return ScheduleExpr{
+		Kind:        ScheduleExprKindFiscal,
+		Interval:    1,
+		Fiscal:      unit,
+		MonthTarget: target,
+		Times:       times,
+	}
+}
+
+// --- Alignment ---
+
+// AlignmentKind represents the reference point that interval repeats are aligned to.
+type AlignmentKind int
+
+const (
+	// AlignmentDefault aligns to the starting anchor if present, otherwise the epoch.
+	AlignmentDefault AlignmentKind = iota
+	// AlignmentEpoch always aligns to the epoch, even when a starting anchor is present.
+	AlignmentEpoch
+	// AlignmentWeekStart restarts day counting at every Monday.
+	AlignmentWeekStart
+	// AlignmentMonthStart restarts day counting on the 1st of every month.
+	AlignmentMonthStart
+	// AlignmentYearStart restarts day or month counting on jan 1 of every year.
+	AlignmentYearStart
+	// AlignmentISOWeeks aligns week repeats to ISO week numbers (week 1, 1+n, ...).
+	AlignmentISOWeeks
+)
+
+func (a AlignmentKind) String() string {
+	names := map[AlignmentKind]string{
+		AlignmentEpoch:      "epoch",
+		AlignmentWeekStart:  "week start",
+		AlignmentMonthStart: "month start",
+		AlignmentYearStart:  "year start",
+		AlignmentISOWeeks:   "iso weeks",
+	}
+	return names[a]
+}
+
 // --- Schedule data ---
 
+// AllTimezones returns every zone of the in clause, or nil if there is none.
+func (s *ScheduleData) AllTimezones() []string {
+	if len(s.Timezones) > 0 {
+		return s.Timezones
+	}
+	if s.Timezone == "" {
+		return nil
+	}
+	return []string{s.Timezone}
+}
+
 // ScheduleData represents the complete parsed schedule with all clauses.
 type ScheduleData struct {
-	Expr     ScheduleExpr
-	Timezone string
-	Except   []ExceptionSpec
-	Until    *UntilSpec
-	Anchor   string // ISO date string for starting clause
-	During   []MonthName
+	Expr      ScheduleExpr
+	Alignment AlignmentKind
+	Timezone  string
+	Timezones []string // All zones when the in clause lists more than one; Timezone is the first
+	Except    []ExceptionSpec
+	Until     *UntilSpec
+	Anchor    string // ISO date string for starting clause
+	Offset    Offset // Shift of every occurrence by the plus or minus clause
+	// Relative date of the starting clause, resolved when evaluated; Anchor is then empty
+	AnchorRelative *RelativeAnchor
+	During         []MonthName
+	DuringDates    []DateWindow // Date windows of the during clause, combined with During as a union
+	DuringWeeks    []WeekRange  // ISO week ranges of the during clause, also part of the union
+	// Quarters (1-4) and half years (1-2) of the during clause, expanded to months when evaluated
+	DuringQuarters []int
+	DuringHalves   []int
+	DuringYears    []YearRange // Calendar years the schedule is bounded to
 }
 
 // NewScheduleData creates a new schedule data with just the expression.
@@ -0,0 +1,181 @@
+package hron
+
+import (
+	"container/heap"
+	"iter"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ScheduleNext is the next occurrence of one schedule in a batch. OK is false when the
+// schedule has no occurrence after the batch's now, or fails Validate.
+type ScheduleNext struct {
+	Schedule *Schedule
+	Next     time.Time
+	OK       bool
+}
+
+// NextAcross computes the next occurrence after now of every schedule, in the order
+// given. Each schedule is evaluated with NextTime, against the plan and timezone it
+// compiled when parsed; schedules parsed with the same timezone share one loaded zone.
+func NextAcross(schedules []*Schedule, now time.Time) []ScheduleNext {
+	out := make([]ScheduleNext, len(schedules))
+	for i, s := range schedules {
+		out[i] = scheduleNext(s, now)
+	}
+	return out
+}
+
+// NextAcrossParallel is NextAcross split over workers goroutines, for sets of schedules
+// large enough that the evaluation outweighs starting them. A workers count below 1
+// uses GOMAXPROCS.
+func NextAcrossParallel(schedules []*Schedule, now time.Time, workers int) []ScheduleNext {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(schedules))
+	if workers <= 1 {
+		return NextAcross(schedules, now)
+	}
+
+	out := make([]ScheduleNext, len(schedules))
+	chunk := (len(schedules) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < len(schedules); lo += chunk {
+		hi := min(lo+chunk, len(schedules))
+		wg.Go(func() {
+			for i := lo; i < hi; i++ {
+				out[i] = scheduleNext(schedules[i], now)
+			}
+		})
+	}
+	wg.Wait()
+	return out
+}
+
+func scheduleNext(s *Schedule, now time.Time) ScheduleNext {
+	next, ok := s.NextTime(now)
+	return ScheduleNext{Schedule: s, Next: next, OK: ok}
+}
+
+// MergedOccurrences returns a lazy iterator of the occurrences of all schedules after
+// from, in chronological order and tagged with the schedule each belongs to, for agenda
+// and calendar views. Simultaneous occurrences come in the order of schedules. Like
+// Occurrences it is unbounded while any schedule repeats forever; schedules that fail
+// Validate yield nothing.
+func MergedOccurrences(schedules []*Schedule, from time.Time) iter.Seq2[*Schedule, time.Time] {
+	return func(yield func(*Schedule, time.Time) bool) {
+		q := NewNextQueue(schedules, from)
+		for {
+			n, ok := q.Advance()
+			if !ok || !yield(n.Schedule, n.Next) {
+				return
+			}
+		}
+	}
+}
+
+// NextQueue holds schedules ordered by their next occurrence, so that a scheduler
+// running many jobs can find the soonest one without rescanning them all: Peek the
+// soonest, wait for it, then Advance past it. Schedules with no further occurrence leave
+// the queue. Ties go to the schedule pushed first. A NextQueue is not safe for
+// concurrent use.
+type NextQueue struct {
+	h   nextHeap
+	seq int
+}
+
+// NewNextQueue returns a queue of the schedules' next occurrences after now. It uses
+// NextAcross, so schedules without an occurrence are left out.
+func NewNextQueue(schedules []*Schedule, now time.Time) *NextQueue {
+	q := &NextQueue{}
+	for _, n := range NextAcross(schedules, now) {
+		if n.OK {
+			q.h = append(q.h, queuedNext{n, q.seq})
+			q.seq++
+		}
+	}
+	heap.Init(&q.h)
+	return q
+}
+
+// Len returns the number of schedules in the queue.
+func (q *NextQueue) Len() int {
+	return len(q.h)
+}
+
+// Push adds a schedule at its next occurrence after now, and reports whether it has one.
+func (q *NextQueue) Push(s *Schedule, now time.Time) bool {
+	n := scheduleNext(s, now)
+	if !n.OK {
+		return false
+	}
+	heap.Push(&q.h, queuedNext{n, q.seq})
+	q.seq++
+	return true
+}
+
+// Peek returns the soonest occurrence in the queue, or false if the queue is empty.
+func (q *NextQueue) Peek() (ScheduleNext, bool) {
+	if len(q.h) == 0 {
+		return ScheduleNext{}, false
+	}
+	return q.h[0].ScheduleNext, true
+}
+
+// Advance removes and returns the soonest occurrence, putting its schedule back at the
+// occurrence after it. It returns false if the queue is empty.
+func (q *NextQueue) Advance() (ScheduleNext, bool) {
+	if len(q.h) == 0 {
+		return ScheduleNext{}, false
+	}
+	top := q.h[0]
+	if next, ok := top.Schedule.NextTime(top.Next); ok {
+		q.h[0].Next = next
+		heap.Fix(&q.h, 0)
+	} else {
+		heap.Pop(&q.h)
+	}
+	return top.ScheduleNext, true
+}
+
+// Remove takes every entry of s out of the queue and reports whether there was one.
+func (q *NextQueue) Remove(s *Schedule) bool {
+	n := len(q.h)
+	q.h = slices.DeleteFunc(q.h, func(e queuedNext) bool { return e.Schedule == s })
+	if len(q.h) == n {
+		return false
+	}
+	heap.Init(&q.h)
+	return true
+}
+
+type queuedNext struct {
+	ScheduleNext
+	seq int
+}
+
+// nextHeap is a min-heap of occurrences, implementing heap.Interface.
+type nextHeap []queuedNext
+
+func (h nextHeap) Len() int { return len(h) }
+
+func (h nextHeap) Less(i, j int) bool {
+	if !h[i].Next.Equal(h[j].Next) {
+		return h[i].Next.Before(h[j].Next)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h nextHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *nextHeap) Push(x any) { *h = append(*h, x.(queuedNext)) }
+
+func (h *nextHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,120 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextAcross(t *testing.T) {
+	schedules := []*Schedule{
+		MustParse("every day at 09:00 in UTC"),
+		MustParse("on 2020-01-01 at 09:00 in UTC"), // in the past
+		MustParse("every 15 min from 08:00 to 18:00 in UTC"),
+		MustParse("every day at 09:00 except holidays in UTC"), // no calendar: fails Validate
+	}
+	now := time.Date(2026, 3, 2, 8, 5, 0, 0, time.UTC)
+	want := []ScheduleNext{
+		{schedules[0], time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), true},
+		{schedules[1], time.Time{}, false},
+		{schedules[2], time.Date(2026, 3, 2, 8, 15, 0, 0, time.UTC), true},
+		{schedules[3], time.Time{}, false},
+	}
+
+	for _, workers := range []int{0, 1, 3} {
+		got := NextAcrossParallel(schedules, now, workers)
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("workers %d: [%d] = %+v, want %+v", workers, i, got[i], want[i])
+			}
+		}
+	}
+	if got := NextAcross(nil, now); len(got) != 0 {
+		t.Errorf("NextAcross(nil) = %v", got)
+	}
+}
+
+func TestNextQueue(t *testing.T) {
+	daily := MustParse("every day at 09:00 in UTC")
+	twice := MustParse("every day at 09:00, 12:00 in UTC")
+	once := MustParse("on 2026-03-02 at 10:00 in UTC")
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+
+	q := NewNextQueue([]*Schedule{daily, twice, once}, now)
+	want := []struct {
+		s    *Schedule
+		next time.Time
+	}{
+		{daily, at(2, 9)}, // tie: daily was pushed first
+		{twice, at(2, 9)},
+		{once, at(2, 10)},
+		{twice, at(2, 12)},
+		{daily, at(3, 9)},
+		{twice, at(3, 9)},
+	}
+	for i, w := range want {
+		peek, _ := q.Peek()
+		got, ok := q.Advance()
+		if !ok || got.Schedule != w.s || !got.Next.Equal(w.next) || got != peek {
+			t.Fatalf("Advance #%d = %v at %v, want %v at %v", i, got.Schedule, got.Next, w.s, w.next)
+		}
+	}
+	if q.Len() != 2 {
+		t.Errorf("Len() = %d, want 2 once the single date has passed", q.Len())
+	}
+
+	if !q.Remove(daily) || q.Remove(daily) {
+		t.Error("Remove should report whether the schedule was queued")
+	}
+	if q.Push(once, at(3, 10)) {
+		t.Error("Push of a schedule without a next occurrence should report false")
+	}
+	if !q.Push(daily, at(3, 10)) {
+		t.Error("Push should report true")
+	}
+	if got, _ := q.Peek(); got.Schedule != twice || !got.Next.Equal(at(3, 12)) {
+		t.Errorf("Peek() = %v at %v", got.Schedule, got.Next)
+	}
+
+	empty := NewNextQueue(nil, now)
+	if _, ok := empty.Advance(); ok {
+		t.Error("Advance on an empty queue should report false")
+	}
+}
+
+func TestMergedOccurrences(t *testing.T) {
+	daily := MustParse("every day at 09:00 in UTC")
+	nyc := MustParse("every day at 09:00 in America/New_York")
+	once := MustParse("on 2026-03-02 at 12:00 in UTC")
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+
+	type tagged struct {
+		s *Schedule
+		t time.Time
+	}
+	want := []tagged{
+		{daily, at(2, 9)},
+		{once, at(2, 12)},
+		{nyc, at(2, 14)},
+		{daily, at(3, 9)},
+		{nyc, at(3, 14)},
+		{daily, at(4, 9)},
+	}
+	var got []tagged
+	for s, occ := range MergedOccurrences([]*Schedule{daily, nyc, once}, from) {
+		got = append(got, tagged{s, occ})
+		if len(got) == len(want) {
+			break
+		}
+	}
+	for i := range want {
+		if i >= len(got) || got[i].s != want[i].s || !got[i].t.Equal(want[i].t) {
+			t.Fatalf("MergedOccurrences = %v, want %v", got, want)
+		}
+	}
+
+	for range MergedOccurrences([]*Schedule{MustParse("on 2020-01-01 at 09:00")}, from) {
+		t.Error("expected no occurrences")
+	}
+}
@@ -0,0 +1,99 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func benchmarkNextFrom(b *testing.B, expr string) {
+	s := MustParse(expr)
+	from := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for b.Loop() {
+		s.NextFrom(from)
+	}
+}
+
+func benchmarkPreviousFrom(b *testing.B, expr string) {
+	s := MustParse(expr)
+	from := time.Date(2026, 11, 15, 12, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for b.Loop() {
+		s.PreviousFrom(from)
+	}
+}
+
+func BenchmarkNextFromSparseDuringDay(b *testing.B) {
+	benchmarkNextFrom(b, "every day at 09:00 during dec")
+}
+
+func BenchmarkNextFromSparseDuringInterval(b *testing.B) {
+	benchmarkNextFrom(b, "every 15 min from 09:00 to 17:00 during dec")
+}
+
+func BenchmarkNextFromSparseDuringWeek(b *testing.B) {
+	benchmarkNextFrom(b, "every 2 weeks on monday at 09:00 during dec")
+}
+
+func BenchmarkNextFromSparseDuringExcept(b *testing.B) {
+	benchmarkNextFrom(b, "every day at 09:00 except dec 1, dec 2, dec 3 during dec")
+}
+
+func BenchmarkPreviousFromSparseDuringDay(b *testing.B) {
+	benchmarkPreviousFrom(b, "every day at 09:00 during jan")
+}
+
+func benchmarkCountYear(b *testing.B, count func(s *Schedule, from, to time.Time) int) {
+	s := MustParse("every weekday at 09:00, 17:00 in America/New_York")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+	b.ReportAllocs()
+	for b.Loop() {
+		count(s, from, to)
+	}
+}
+
+func BenchmarkCountBetweenYear(b *testing.B) {
+	benchmarkCountYear(b, (*Schedule).CountBetween)
+}
+
+func BenchmarkCountBetweenYearByIteration(b *testing.B) {
+	benchmarkCountYear(b, countByIteration)
+}
+
+func BenchmarkNextFromDay(b *testing.B) {
+	benchmarkNextFrom(b, "every weekday at 09:00, 17:00 in America/New_York")
+}
+
+func BenchmarkNextFromWeek(b *testing.B) {
+	benchmarkNextFrom(b, "every 2 weeks on friday, monday at 09:00 in America/New_York")
+}
+
+func benchmarkNextTime(b *testing.B, expr string) {
+	s := MustParse(expr)
+	from := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for b.Loop() {
+		s.NextTime(from)
+	}
+}
+
+func BenchmarkNextTimeDay(b *testing.B) {
+	benchmarkNextTime(b, "every weekday at 09:00, 17:00 in America/New_York")
+}
+
+func BenchmarkNextTimeWeek(b *testing.B) {
+	benchmarkNextTime(b, "every 2 weeks on friday, monday at 09:00 in America/New_York")
+}
+
+func BenchmarkNextTimeInterval(b *testing.B) {
+	benchmarkNextTime(b, "every 15 min from 09:00 to 17:00 on weekdays in America/New_York")
+}
+
+func BenchmarkNextFromAnchoredMonth(b *testing.B) {
+	benchmarkNextFrom(b, "every 3 months on the 15th at 09:00 starting 2025-02-01")
+}
+
+func BenchmarkNextTimeAnchoredDay(b *testing.B) {
+	benchmarkNextTime(b, "every 3 days at 09:00 starting 2025-02-01")
+}
@@ -0,0 +1,94 @@
+package hron
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// parseCache memoizes Parse and ParseWithWarnings when enabled with EnableCache. It
+// holds only the parsed schedules: deprecation warnings are found on every call, so they
+// follow DeprecateKeyword calls made after an input was cached.
+var parseCache atomic.Pointer[lru[*ScheduleData]]
+
+// EnableCache makes Parse, ParseWithWarnings, and the functions built on them (such as
+// ParseSchedule and MustParse) remember the schedules parsed from the last size distinct
+// inputs, for services that parse the same expressions from configuration or a database
+// over and over. Inputs that fail to parse are not cached. A size of 0 or less disables
+// the cache; calling it again replaces the cache with an empty one.
+//
+// Each call returns its own ScheduleData, but the slices inside it are shared with other
+// calls for the same input and must not be modified in place.
+func EnableCache(size int) {
+	if size <= 0 {
+		parseCache.Store(nil)
+		return
+	}
+	parseCache.Store(newLRU[*ScheduleData](size))
+}
+
+// cachedParse returns the cached parse of input, or parses it and caches the result.
+func cachedParse(cache *lru[*ScheduleData], input string) (*ScheduleData, error) {
+	cached, ok := cache.get(input)
+	if !ok {
+		data, err := parseInput(input)
+		if err != nil {
+			return nil, withSuggestion(input, err)
+		}
+		cached = data
+		cache.add(input, cached)
+	}
+	data := *cached
+	return &data, nil
+}
+
+// lru is a map of at most size entries that evicts the least recently used one. It is
+// safe for concurrent use.
+type lru[V any] struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // Of *lruEntry[V], most recently used first
+	items map[string]*list.Element
+}
+
+type lruEntry[V any] struct {
+	key   string
+	value V
+}
+
+func newLRU[V any](size int) *lru[V] {
+	return &lru[V]{size: size, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (c *lru[V]) get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*lruEntry[V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *lru[V]) add(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		e.Value.(*lruEntry[V]).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[V]{key, value})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[V]).key)
+	}
+}
+
+func (c *lru[V]) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,103 @@
+package hron
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestLRU(t *testing.T) {
+	c := newLRU[int](2)
+	c.add("a", 1)
+	c.add("b", 2)
+	c.get("a") // b is now least recently used
+	c.add("c", 3)
+	if _, ok := c.get("b"); ok {
+		t.Error("b should have been evicted")
+	}
+	if v, ok := c.get("a"); !ok || v != 1 {
+		t.Errorf("get(a) = %d, %v, want 1", v, ok)
+	}
+	c.add("a", 10)
+	if v, _ := c.get("a"); v != 10 || c.len() != 2 {
+		t.Errorf("after update get(a) = %d, len %d, want 10, 2", v, c.len())
+	}
+}
+
+func TestEnableCache(t *testing.T) {
+	EnableCache(2)
+	t.Cleanup(func() { EnableCache(0) })
+
+	a := MustParse("every weekday at 09:00")
+	b := MustParse("every weekday at 09:00")
+	if a.Data() == b.Data() {
+		t.Error("cached parses should return distinct ScheduleData")
+	}
+	if a.String() != b.String() || parseCache.Load().len() != 1 {
+		t.Errorf("cache holds %d inputs, want 1", parseCache.Load().len())
+	}
+
+	if _, err := Parse("every blue moon"); err == nil {
+		t.Error("Parse of an invalid expression succeeded with the cache enabled")
+	}
+	if n := parseCache.Load().len(); n != 1 {
+		t.Errorf("cache holds %d inputs after an error, want 1", n)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			for j := range 50 {
+				if _, err := Parse(fmt.Sprintf("every day at %02d:00", (i+j)%24)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+	if n := parseCache.Load().len(); n > 2 {
+		t.Errorf("cache holds %d inputs, limit 2", n)
+	}
+
+	EnableCache(0)
+	if parseCache.Load() != nil {
+		t.Error("EnableCache(0) should disable the cache")
+	}
+}
+
+func TestEnableCacheDeprecationWarnings(t *testing.T) {
+	deprecateHrs(t)
+	hooked := 0
+	hook := WithDeprecationHook(func(Warning) { hooked++ })
+
+	EnableCache(4)
+	t.Cleanup(func() { EnableCache(0) })
+	for range 3 {
+		if _, warnings, err := ParseWithWarnings("every 2 hrs from 09:00 to 17:00", hook); err != nil || len(warnings) != 1 {
+			t.Fatalf("ParseWithWarnings() = %v, %v, want 1 warning", warnings, err)
+		}
+	}
+	if hooked != 3 {
+		t.Errorf("hook called %d times, want 3", hooked)
+	}
+}
+
+// Warnings follow the registry, not the state it was in when the input was cached.
+func TestEnableCacheDeprecateAfterCaching(t *testing.T) {
+	EnableCache(4)
+	t.Cleanup(func() { EnableCache(0) })
+	input := "every 2 hrs from 09:00 to 17:00"
+	if _, warnings, err := ParseWithWarnings(input); err != nil || len(warnings) != 0 {
+		t.Fatalf("ParseWithWarnings() = %v, %v, want no warnings", warnings, err)
+	}
+
+	deprecateHrs(t)
+	if _, warnings, err := ParseWithWarnings(input); err != nil || len(warnings) != 1 {
+		t.Errorf("ParseWithWarnings() after DeprecateKeyword = %v, %v, want 1 warning", warnings, err)
+	}
+	undeprecateKeyword("hrs")
+	if _, warnings, err := ParseWithWarnings(input); err != nil || len(warnings) != 0 {
+		t.Errorf("ParseWithWarnings() after undeprecating = %v, %v, want no warnings", warnings, err)
+	}
+}
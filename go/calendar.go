@@ -0,0 +1,96 @@
+package hron
+
+import (
+	"fmt"
+	"time"
+)
+
+// HolidayCalendar reports which dates are holidays. Business-day targets skip holidays
+// when a calendar is attached to the schedule with WithHolidayCalendar.
+type HolidayCalendar interface {
+	// IsHoliday reports whether the given civil date (midnight UTC) is a holiday.
+	IsHoliday(date time.Time) bool
+}
+
+// WithHolidayCalendar returns a copy of the schedule that evaluates against cal.
+func (s *Schedule) WithHolidayCalendar(cal HolidayCalendar) *Schedule {
+	c := *s
+	c.calendar = cal
+	return &c
+}
+
+// HolidayCalendar returns the calendar attached to the schedule, or nil if none is set.
+func (s *Schedule) HolidayCalendar() HolidayCalendar {
+	return s.calendar
+}
+
+// StaticCalendar is a HolidayCalendar backed by a fixed set of dates.
+type StaticCalendar struct {
+	dates map[time.Time]struct{}
+}
+
+// NewStaticCalendar creates a calendar from a list of dates. Only the civil date
+// (year, month, day) of each value is used.
+func NewStaticCalendar(dates []time.Time) *StaticCalendar {
+	c := &StaticCalendar{dates: make(map[time.Time]struct{}, len(dates))}
+	for _, d := range dates {
+		c.dates[dateOnly(d)] = struct{}{}
+	}
+	return c
+}
+
+// ParseStaticCalendar creates a calendar from ISO dates (YYYY-MM-DD).
+func ParseStaticCalendar(dates []string) (*StaticCalendar, error) {
+	parsed := make([]time.Time, len(dates))
+	for i, s := range dates {
+		d, err := parseISODate(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q (expected YYYY-MM-DD)", s)
+		}
+		parsed[i] = d
+	}
+	return NewStaticCalendar(parsed), nil
+}
+
+// IsHoliday implements HolidayCalendar.
+func (c *StaticCalendar) IsHoliday(date time.Time) bool {
+	_, ok := c.dates[dateOnly(date)]
+	return ok
+}
+
+// Len returns the number of holidays in the calendar.
+func (c *StaticCalendar) Len() int {
+	return len(c.dates)
+}
+
+// isBusinessDay reports whether d is a weekday that isn't a holiday in cal.
+func isBusinessDay(d time.Time, cal HolidayCalendar) bool {
+	if isoWeekday(d) > 5 {
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(dateOnly(d))
+}
+
+// businessDayOfMonth returns the nth business day of a month, or false if the month has fewer.
+func businessDayOfMonth(year int, month time.Month, n int, cal HolidayCalendar) (time.Time, bool) {
+	count := 0
+	for d := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC); d.Month() == month; d = d.AddDate(0, 0, 1) {
+		if isBusinessDay(d, cal) {
+			count++
+			if count == n {
+				return d, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// lastBusinessDayOfMonth returns the last business day of a month, or false if it has none.
+func lastBusinessDayOfMonth(year int, month time.Month, cal HolidayCalendar) (time.Time, bool) {
+	for d := lastDayOfMonth(year, month); d.Month() == month; d = d.AddDate(0, 0, -1) {
+		if isBusinessDay(d, cal) {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,86 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStaticCalendar(t *testing.T) {
+	cal, err := ParseStaticCalendar([]string{"2026-12-25", "2026-01-01", "2026-12-25"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", cal.Len())
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+	if !cal.IsHoliday(time.Date(2026, 12, 25, 23, 30, 0, 0, ny)) {
+		t.Error("IsHoliday should compare civil dates regardless of time and zone")
+	}
+	if cal.IsHoliday(time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsHoliday(dec 24) = true")
+	}
+
+	if _, err := ParseStaticCalendar([]string{"2026-13-01"}); err == nil {
+		t.Error("expected error for invalid date")
+	}
+}
+
+func TestAttachHolidayCalendar(t *testing.T) {
+	s := MustParse("every month on the 1st business day at 09:00")
+	if s.HolidayCalendar() != nil {
+		t.Error("HolidayCalendar() should be nil by default")
+	}
+
+	cal := NewStaticCalendar([]time.Time{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	withCal := s.WithHolidayCalendar(cal)
+	if withCal.HolidayCalendar() != HolidayCalendar(cal) {
+		t.Error("HolidayCalendar() should return the attached calendar")
+	}
+
+	from := time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC)
+	want := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	if got := withCal.NextFrom(from); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", got, want)
+	}
+}
+
+func TestExceptHolidays(t *testing.T) {
+	s := MustParse("every weekday at 09:00 except holidays, 2026-12-28")
+	if got := s.String(); got != "every weekday at 09:00 except holidays, 2026-12-28" {
+		t.Errorf("String() = %q", got)
+	}
+
+	err := s.Validate()
+	if hronErr, ok := err.(*HronError); !ok || hronErr.Kind != ErrorKindEval {
+		t.Fatalf("Validate() = %v, want eval error", err)
+	}
+	from := time.Date(2026, 12, 24, 10, 0, 0, 0, time.UTC)
+	if got := s.NextFrom(from); got != nil {
+		t.Errorf("NextFrom() without calendar = %v, want nil", got)
+	}
+	if _, nextErr := s.NextFromErr(from); nextErr == nil || nextErr.Error() != err.Error() {
+		t.Errorf("NextFromErr() without calendar = %v, want %v", nextErr, err)
+	}
+	if _, prevErr := s.PreviousFromErr(from); prevErr == nil || prevErr.Error() != err.Error() {
+		t.Errorf("PreviousFromErr() without calendar = %v, want %v", prevErr, err)
+	}
+	if match, matchErr := s.MatchesErr(time.Date(2026, 12, 24, 9, 0, 0, 0, time.UTC)); match || matchErr == nil || matchErr.Error() != err.Error() {
+		t.Errorf("MatchesErr() without calendar = %v, %v, want false, %v", match, matchErr, err)
+	}
+
+	withCal := s.WithHolidayCalendar(NewStaticCalendar([]time.Time{time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)}))
+	if err := withCal.Validate(); err != nil {
+		t.Fatalf("Validate() with calendar: %v", err)
+	}
+	want := time.Date(2026, 12, 29, 9, 0, 0, 0, time.UTC)
+	if got := withCal.NextFrom(from); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", got, want)
+	}
+	if withCal.Matches(time.Date(2026, 12, 25, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() should exclude holidays")
+	}
+	if got, want := withCal.PreviousFrom(want), time.Date(2026, 12, 24, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("PreviousFrom() = %v, want %v", got, want)
+	}
+}
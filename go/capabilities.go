@@ -0,0 +1,116 @@
+package hron
+
+// CapabilityKind groups the entries returned by Capabilities.
+type CapabilityKind int
+
+const (
+	// CapabilityGrammar is an expression form the parser accepts.
+	CapabilityGrammar CapabilityKind = iota
+	// CapabilityCronDialect is a cron syntax supported by FromCron and ToCron.
+	CapabilityCronDialect
+	// CapabilityBehavior is an evaluation rule clients may rely on.
+	CapabilityBehavior
+)
+
+func (k CapabilityKind) String() string {
+	switch k {
+	case CapabilityGrammar:
+		return "grammar"
+	case CapabilityCronDialect:
+		return "cron dialect"
+	default:
+		return "behavior"
+	}
+}
+
+// Capability describes one supported feature of this library version. Names are stable
+// identifiers: they are never renamed or reused, so clients can match on them.
+type Capability struct {
+	Kind        CapabilityKind
+	Name        string
+	Description string
+	Example     string // An expression using the feature; empty for non-grammar entries
+	Extension   bool   // Go only: not in the spec, so other hron implementations reject it
+}
+
+var capabilities = []Capability{
+	{CapabilityGrammar, "day-repeat", "every day, weekday, weekend, or listed days at times", "every weekday at 09:00", false},
+	{CapabilityGrammar, "day-range", "weekday ranges in day lists", "every monday to thursday at 08:00", true},
+	{CapabilityGrammar, "every-other", "every other day, week, month, or year as an interval of 2", "every other week on monday at 09:00", true},
+	{CapabilityGrammar, "week-repeat", "every N weeks on listed days", "every 2 weeks on monday at 09:00", false},
+	{CapabilityGrammar, "week-repeat-weekday", "weekday and weekend as the days of a week repeat", "every week on weekdays at 09:00", true},
+	{CapabilityGrammar, "month-repeat", "every N months on days, ranges, last day, or last weekday", "every month on the 1st, 15th at 09:00", false},
+	{CapabilityGrammar, "month-ordinal-weekday", "ordinal weekdays of the month", "every month on the first monday at 10:00", false},
+	{CapabilityGrammar, "month-ordinal-weekday-list", "several ordinal weekdays in one month repeat", "every month on the first, third monday at 09:00", true},
+	{CapabilityGrammar, "month-ordinal-from-end", "ordinal weekdays counted from the end of the month", "every month on the second to last friday at 09:00", true},
+	{CapabilityGrammar, "month-ordinal-numeric", "ordinal weekdays written as numbers", "every month on the 1st monday, 2nd to last friday at 09:00", true},
+	{CapabilityGrammar, "month-nearest-weekday", "nearest weekday to a day of the month", "every month on the nearest weekday to 15th at 09:00", false},
+	{CapabilityGrammar, "month-week-of-month", "days in the Nth week of the month", "every month in the second week on monday at 09:00", true},
+	{CapabilityGrammar, "month-business-day", "Nth or last business day of the month", "every month on the 3rd business day at 09:00", true},
+	{CapabilityGrammar, "quarter-repeat", "every N quarters or every half year on a month target", "every quarter on the 1st at 09:00", true},
+	{CapabilityGrammar, "fiscal-repeat", "every fiscal month, quarter, or year of a calendar attached with WithFiscalCalendar", "every fiscal quarter on the first business day at 08:00", true},
+	{CapabilityGrammar, "year-repeat", "every N years on a date or ordinal weekday", "every year on the first monday of march at 10:00", false},
+	{CapabilityGrammar, "year-day-of-year", "the nth day of the year, with day 366 only in leap years", "every year on the 256th day at 09:00", true},
+	{CapabilityGrammar, "year-repeat-list", "several dates or ordinal weekdays in one year repeat", "every jan 15 and jul 15 at 09:00", true},
+	{CapabilityGrammar, "single-date", "a one-off named or ISO date", "on 2026-03-15 at 14:30", false},
+	{CapabilityGrammar, "single-date-list", "several one-off dates in one expression", "on 2026-03-01, 2026-06-01 at 10:00", true},
+	{CapabilityGrammar, "event-date", "named events such as easter, resolved against the schedule's event source", "on easter at 09:00", true},
+	{CapabilityGrammar, "relative-date", "days or weekdays before or after a date", "on 2 days before easter at 09:00", true},
+	{CapabilityGrammar, "random-pick", "a seeded random day per week or month", `one random weekday each week at 09:00 seeded by "team"`, true},
+	{CapabilityGrammar, "interval-repeat", "every N minutes or hours within a daily window", "every 30 min from 09:00 to 17:00", false},
+	{CapabilityGrammar, "interval-open-window", "interval repeats whose window defaults to the rest of the day or the whole day", "every 2 hours starting at 06:00", true},
+	{CapabilityGrammar, "interval-seconds", "second units in interval repeats", "every 90 seconds from 09:00 to 10:00", true},
+	{CapabilityGrammar, "interval-across-midnight", "interval windows that run past midnight with next day", "every 30 min from 22:00 to 02:00 next day", true},
+	{CapabilityGrammar, "interval-inline-window", "a stepped time window on a day repeat", "every weekday at 09:00 to 17:00 every 30 min", true},
+	{CapabilityGrammar, "time-12-hour", "12-hour times with am/pm", "every day at 9:30pm", true},
+	{CapabilityGrammar, "time-named", "noon, midnight, and end of day", "every day at noon", true},
+	{CapabilityGrammar, "clause-aligned", "aligned to epoch, iso weeks, or week, month, year start", "every 3 days at 09:00 aligned to month start", true},
+	{CapabilityGrammar, "clause-except", "excluded dates", "every weekday at 09:00 except dec 25", false},
+	{CapabilityGrammar, "clause-except-holidays", "excluded holidays from an attached calendar", "every weekday at 09:00 except holidays", true},
+	{CapabilityGrammar, "clause-until", "an end date", "every day at 09:00 until 2026-12-31", false},
+	{CapabilityGrammar, "time-solar", "sunrise, sunset, dawn, or dusk at a location attached with WithCoordinates", "every day at 30 min before sunset", true},
+	{CapabilityGrammar, "clause-offset", "occurrences moved earlier or later by a fixed amount", "every month on the last weekday at 17:00 minus 1 hour", true},
+	{CapabilityGrammar, "clause-until-time", "an end date with a time of day", "every 30 min from 09:00 to 17:00 until 2026-06-30 12:00", true},
+	{CapabilityGrammar, "clause-starting", "an anchor date", "every 2 weeks on monday at 09:00 starting 2026-01-05", false},
+	{CapabilityGrammar, "clause-relative-date", "starting and until dates relative to a reference time", "every day at 09:00 until end of month starting next monday", true},
+	{CapabilityGrammar, "clause-during", "allowed months", "every weekday at 9:00 during jan, jun", false},
+	{CapabilityGrammar, "clause-during-dates", "allowed date windows", "every day at 06:00 during jun 15 to aug 31", true},
+	{CapabilityGrammar, "clause-during-quarters", "allowed quarters and half years", "every day at 09:00 during q1, q3", true},
+	{CapabilityGrammar, "clause-during-weeks", "allowed ISO week ranges", "every weekday at 09:00 during weeks 10 to 20", true},
+	{CapabilityGrammar, "clause-during-years", "calendar years the schedule is bounded to", "every day at 09:00 during 2026 to 2028", true},
+	{CapabilityGrammar, "clause-timezone", "an IANA timezone", "every weekday at 9:00 in America/Vancouver", false},
+	{CapabilityGrammar, "clause-timezone-offset", "a fixed UTC offset such as UTC+05:30 or GMT-8", "every day at 09:00 in UTC+05:30", true},
+	{CapabilityGrammar, "clause-timezone-abbreviation", "a common abbreviation such as PDT or JST, read as its IANA zone; ambiguous ones such as IST are rejected with the candidates", "every day at 09:00 in PDT", true},
+	{CapabilityGrammar, "clause-timezone-list", "several IANA timezones, running at the times in each", "every weekday at 09:00 in America/New_York, Europe/London", true},
+	{CapabilityGrammar, "clause-timezone-local", "a placeholder timezone bound per user at evaluation", "every day at 09:00 in local", true},
+	{CapabilityCronDialect, "cron-5-field", "5-field cron with @ macros and the L, W, and # extensions", "", false},
+	{CapabilityCronDialect, "cron-kubernetes", "Kubernetes CronJob schedules with a separate timeZone field", "", true},
+	{CapabilityCronDialect, "cron-eventbridge", "AWS EventBridge cron(...) expressions with the ? rule and year field", "", true},
+	{CapabilityCronDialect, "cron-jenkins", "Jenkins trigger specs with H hashed from a key", "", true},
+	{CapabilityBehavior, "dst-gap-forward", "times in a spring-forward gap move to the first valid time after it", "", false},
+	{CapabilityBehavior, "dst-fold-first", "ambiguous fall-back times resolve to the first occurrence", "", false},
+	{CapabilityBehavior, "starting-floor", "no occurrence is produced before the starting anchor", "", false},
+	{CapabilityBehavior, "checkpoint-resume", "iteration can resume from a checkpoint token", "", true},
+	{CapabilityBehavior, "locale-keywords", "expressions can be parsed and rendered with a locale's keyword pack", "", true},
+}
+
+// Capabilities lists the grammar features, cron dialects, and behaviors supported by this
+// library version, so services can advertise which expressions they accept. Entries with
+// Extension set are outside the spec, and services sharing expressions with other hron
+// implementations should not rely on them. The returned slice is a copy and may be
+// modified.
+func Capabilities() []Capability {
+	out := make([]Capability, len(capabilities))
+	copy(out, capabilities)
+	return out
+}
+
+// HasCapability reports whether this library version supports the named capability.
+func HasCapability(name string) bool {
+	for _, c := range capabilities {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
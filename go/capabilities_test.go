@@ -0,0 +1,71 @@
+package hron
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCapabilityExamplesParse(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, c := range Capabilities() {
+		if seen[c.Name] {
+			t.Errorf("duplicate capability %q", c.Name)
+		}
+		seen[c.Name] = true
+
+		if c.Kind != CapabilityGrammar {
+			continue
+		}
+		if c.Example == "" {
+			t.Errorf("grammar capability %q has no example", c.Name)
+			continue
+		}
+		if _, err := ParseSchedule(c.Example); err != nil {
+			t.Errorf("capability %q example %q: %v", c.Name, c.Example, err)
+		}
+	}
+}
+
+func TestHasCapability(t *testing.T) {
+	if !HasCapability("interval-seconds") {
+		t.Error("expected interval-seconds capability")
+	}
+	if HasCapability("no-such-feature") {
+		t.Error("unexpected capability")
+	}
+
+	caps := Capabilities()
+	caps[0].Name = "changed"
+	if Capabilities()[0].Name == "changed" {
+		t.Error("Capabilities should return a copy")
+	}
+}
+
+func TestCapabilityExtensionsOutsideSpec(t *testing.T) {
+	spec := loadSpec(t)
+	inputs := make(map[string]bool)
+	for section, raw := range spec.Parse {
+		if section == "description" {
+			continue
+		}
+		var group ParseGroup
+		if err := json.Unmarshal(raw, &group); err != nil {
+			t.Fatalf("failed to parse section %s: %v", section, err)
+		}
+		for _, tc := range group.Tests {
+			inputs[tc.Input], inputs[tc.Canonical] = true, true
+		}
+	}
+
+	for _, c := range Capabilities() {
+		if c.Kind != CapabilityGrammar {
+			continue
+		}
+		switch {
+		case c.Extension && inputs[c.Example]:
+			t.Errorf("extension %q example %q is a spec parse case", c.Name, c.Example)
+		case !c.Extension && !inputs[c.Example]:
+			t.Errorf("spec capability %q example %q is not a spec parse case", c.Name, c.Example)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package hron
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"iter"
+	"strings"
+	"time"
+)
+
+const checkpointPrefix = "hron1."
+
+// checkpoint is the payload of a checkpoint token.
+type checkpoint struct {
+	Expr     string `json:"expr"`
+	After    string `json:"after"`              // RFC 3339 with nanoseconds
+	Attached bool   `json:"attached,omitempty"` // The schedule had state Expr does not record
+}
+
+// Checkpoint returns an opaque token recording that iteration has processed every
+// occurrence up to and including after. Pass it to ResumeOccurrences, possibly in
+// another process, to continue with the first occurrence strictly after it.
+func (s *Schedule) Checkpoint(after time.Time) string {
+	payload, _ := json.Marshal(checkpoint{Expr: s.String(), After: after.Format(time.RFC3339Nano), Attached: s.hasAttachedState()})
+	return checkpointPrefix + base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// ResumeOccurrences parses the schedule stored in a checkpoint token and returns its
+// occurrences strictly after the checkpoint. The token holds only the expression, so it
+// returns an EvalError for the token of a schedule with state attached, such as a
+// HolidayCalendar, a bound timezone, or a filter; resume those with the
+// Schedule.ResumeOccurrences method of the same schedule instead.
+func ResumeOccurrences(token string) (iter.Seq[time.Time], error) {
+	cp, after, err := decodeCheckpoint(token)
+	if err != nil {
+		return nil, err
+	}
+	if cp.Attached {
+		return nil, EvalError("checkpoint was created by a schedule with attached state (resume with Schedule.ResumeOccurrences)")
+	}
+	s, err := ParseSchedule(cp.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return s.Occurrences(after), nil
+}
+
+// ResumeOccurrences returns the schedule's occurrences strictly after a checkpoint
+// token, evaluated with the state attached to s. It returns an EvalError if the token
+// was created by a schedule with a different expression.
+func (s *Schedule) ResumeOccurrences(token string) (iter.Seq[time.Time], error) {
+	cp, after, err := decodeCheckpoint(token)
+	if err != nil {
+		return nil, err
+	}
+	if cp.Expr != s.String() {
+		return nil, EvalError("checkpoint was created by a different schedule: " + cp.Expr)
+	}
+	return s.Occurrences(after), nil
+}
+
+// hasAttachedState reports whether the schedule evaluates with state its expression does
+// not record: what the With methods and Filtered attach.
+func (s *Schedule) hasAttachedState() bool {
+	return s.calendar != nil || s.solar != nil || s.fiscal != nil || s.events != nil || s.filter != nil ||
+		s.tzName != s.data.Timezone || !s.reference.IsZero() || s.options != (EvalOptions{})
+}
+
+func decodeCheckpoint(token string) (checkpoint, time.Time, error) {
+	encoded, ok := strings.CutPrefix(token, checkpointPrefix)
+	if !ok {
+		return checkpoint{}, time.Time{}, EvalError("invalid checkpoint token (unknown version)")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return checkpoint{}, time.Time{}, EvalError("invalid checkpoint token (bad encoding)")
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(payload, &cp); err != nil {
+		return checkpoint{}, time.Time{}, EvalError("invalid checkpoint token (bad payload)")
+	}
+	after, err := time.Parse(time.RFC3339Nano, cp.After)
+	if err != nil {
+		return checkpoint{}, time.Time{}, EvalError("invalid checkpoint token (bad position)")
+	}
+	return cp, after, nil
+}
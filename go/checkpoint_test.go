@@ -0,0 +1,88 @@
+package hron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	s := MustParse("every 15 min from 09:00 to 10:00 in America/New_York")
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	var all []time.Time
+	for occ := range s.Occurrences(from) {
+		all = append(all, occ)
+		if len(all) == 8 {
+			break
+		}
+	}
+
+	// A worker processes three occurrences, then hands off
+	token := s.Checkpoint(all[2])
+
+	next, err := ResumeOccurrences(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resumed []time.Time
+	for occ := range next {
+		resumed = append(resumed, occ)
+		if len(resumed) == 5 {
+			break
+		}
+	}
+	if !slices.EqualFunc(resumed, all[3:], time.Time.Equal) {
+		t.Errorf("resumed = %v, want %v", resumed, all[3:])
+	}
+
+	method, err := s.ResumeOccurrences(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for occ := range method {
+		if !occ.Equal(all[3]) {
+			t.Errorf("first resumed occurrence = %v, want %v", occ, all[3])
+		}
+		break
+	}
+}
+
+func TestCheckpointErrors(t *testing.T) {
+	token := MustParse("every day at 09:00").Checkpoint(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC))
+
+	if _, err := MustParse("every day at 10:00").ResumeOccurrences(token); err == nil {
+		t.Error("expected error resuming a checkpoint from a different schedule")
+	}
+	// The token cannot carry a calendar, so only the schedule holding one may resume it
+	after := time.Date(2026, 12, 24, 9, 0, 0, 0, time.UTC)
+	cal := NewStaticCalendar([]time.Time{time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)})
+	s := MustParse("every day at 09:00 except holidays in UTC").WithHolidayCalendar(cal)
+	token = s.Checkpoint(after)
+	if _, err := ResumeOccurrences(token); err == nil {
+		t.Error("ResumeOccurrences() succeeded for a schedule with a calendar attached")
+	}
+	next, err := s.ResumeOccurrences(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for occ := range next {
+		if want := time.Date(2026, 12, 26, 9, 0, 0, 0, time.UTC); !occ.Equal(want) {
+			t.Errorf("first resumed occurrence = %v, want %v", occ, want)
+		}
+		break
+	}
+	if _, err := ResumeOccurrences(MustParse("every day at 09:00 in local").Checkpoint(after)); err != nil {
+		t.Errorf("ResumeOccurrences() of a schedule without attached state: %v", err)
+	}
+	bound, _ := MustParse("every day at 09:00 in local").WithTimezone("Asia/Tokyo")
+	if _, err := ResumeOccurrences(bound.Checkpoint(after)); err == nil {
+		t.Error("ResumeOccurrences() succeeded for a schedule with a bound timezone")
+	}
+
+	for _, bad := range []string{"", "hron1.!!!", "hron2." + token[len("hron1."):], "hron1.e30"} {
+		if _, err := ResumeOccurrences(bad); err == nil {
+			t.Errorf("ResumeOccurrences(%q) succeeded, want error", bad)
+		}
+	}
+}
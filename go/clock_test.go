@@ -0,0 +1,59 @@
+package hron
+
+import (
+	"testing"
+)
+
+func TestTwelveHourTimes(t *testing.T) {
+	cases := []struct{ input, canonical string }{
+		{"every day at 9am, 5:30pm", "every day at 09:00, 17:30"},
+		{"every day at 9 AM", "every day at 09:00"},
+		{"every day at 12am", "every day at 00:00"},
+		{"every day at 12:15 pm", "every day at 12:15"},
+		{"every 30 min from 9am to 5pm", "every 30 min from 09:00 to 17:00"},
+		{"on dec 25 at 7pm", "on dec 25 at 19:00"},
+	}
+	for _, tc := range cases {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tc.input, err)
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+	}
+
+	for _, input := range []string{
+		"every day at 13pm",
+		"every day at 0am",
+		"every day at 17:30pm",
+		"every day at 9ampm",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestNamedTimes(t *testing.T) {
+	cases := []struct{ input, canonical string }{
+		{"every weekday at noon", "every weekday at 12:00"},
+		{"every day at midnight", "every day at 00:00"},
+		{"every day at end of day", "every day at 23:59"},
+		{"every day at Noon, 18:00", "every day at 12:00, 18:00"},
+		{"every 2 hours from midnight to end of day", "every 2 hours from 00:00 to 23:59"},
+	}
+	for _, tc := range cases {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tc.input, err)
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+	}
+
+	if _, err := ParseSchedule("every day at end of week"); err == nil {
+		t.Error("expected error for 'end of week'")
+	}
+}
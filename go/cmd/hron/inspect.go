@@ -0,0 +1,302 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// readExpressions returns the expression given as arguments, joined by spaces so it
+// need not be quoted, or else the expressions read one per line from stdin. Blank lines
+// and lines starting with '#' are skipped.
+func readExpressions(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) > 0 {
+		return []string{strings.Join(args, " ")}, nil
+	}
+	var exprs []string
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			exprs = append(exprs, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(exprs) == 0 {
+		return nil, errors.New("no expression given")
+	}
+	return exprs, nil
+}
+
+// forEach calls fn with each expression, reporting its errors on stderr, and returns an
+// error counting the failures. With several expressions, the output of each is headed
+// by the expression.
+func forEach(exprs []string, stdout, stderr io.Writer, fn func(expr string) error) error {
+	failed := 0
+	for i, expr := range exprs {
+		if len(exprs) > 1 {
+			if i > 0 {
+				fmt.Fprintln(stdout)
+			}
+			fmt.Fprintf(stdout, "%s\n", expr)
+		}
+		if err := fn(expr); err != nil {
+			failed++
+			printError(stderr, err)
+		}
+	}
+	switch {
+	case failed == 0:
+		return nil
+	case len(exprs) == 1:
+		return errors.New("invalid expression")
+	default:
+		return fmt.Errorf("%d of %d expressions failed", failed, len(exprs))
+	}
+}
+
+// printError writes err to w, underlining the offending text of parse errors.
+func printError(w io.Writer, err error) {
+	var herr *hron.HronError
+	if errors.As(err, &herr) {
+		fmt.Fprintln(w, herr.DisplayRich())
+		return
+	}
+	fmt.Fprintf(w, "error: %v\n", err)
+}
+
+// expressionFlags parses the flags of a subcommand working on expressions and returns
+// the expressions.
+func expressionFlags(fs *flag.FlagSet, args []string, stdin io.Reader, stderr io.Writer) ([]string, error) {
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return readExpressions(fs.Args(), stdin)
+}
+
+// fromFlag defines the -from flag of a subcommand searching for occurrences.
+func fromFlag(fs *flag.FlagSet) *string {
+	return fs.String("from", "", "search after this RFC 3339 time instead of now")
+}
+
+// searchStart returns the time given to -from, or now if it was not given.
+func searchStart(from string, now time.Time) (time.Time, error) {
+	if from == "" {
+		return now, nil
+	}
+	t, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("-from: %w", err)
+	}
+	return t, nil
+}
+
+// runNext prints the next occurrences of expressions after now.
+func runNext(args []string, stdin io.Reader, stdout, stderr io.Writer, now time.Time) error {
+	fs := flag.NewFlagSet("next", flag.ContinueOnError)
+	n := fs.Int("n", 5, "number of occurrences")
+	from := fromFlag(fs)
+	exprs, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	// Relative dates, like starting tomorrow, are resolved against now even with -from
+	start, err := searchStart(*from, now)
+	if err != nil {
+		return err
+	}
+	return forEach(exprs, stdout, stderr, func(expr string) error {
+		s, err := hron.ParseScheduleAt(expr, now)
+		if err != nil {
+			return err
+		}
+		cursor := start
+		for range *n {
+			next, err := s.NextFromErr(cursor)
+			if err != nil {
+				return err
+			}
+			if next == nil {
+				break
+			}
+			fmt.Fprintln(stdout, next.Format(time.RFC3339))
+			cursor = *next
+		}
+		return nil
+	})
+}
+
+// runValidate checks expressions, printing the canonical form of each valid one and
+// any warnings.
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	exprs, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	return forEach(exprs, stdout, stderr, func(expr string) error {
+		report, err := hron.ValidateDetailed(expr)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "ok: %s\n", hron.Display(report.Schedule))
+		for _, f := range report.Findings {
+			fmt.Fprintf(stderr, "warning: %s: %s\n", f.Kind, f.Message)
+		}
+		return nil
+	})
+}
+
+// runToCron converts expressions to cron.
+func runToCron(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("to-cron", flag.ContinueOnError)
+	approx := fs.Bool("approx", false, "convert to the closest cron, listing the differences")
+	exprs, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	return forEach(exprs, stdout, stderr, func(expr string) error {
+		s, err := hron.ParseSchedule(expr)
+		if err != nil {
+			return err
+		}
+		if !*approx {
+			cron, err := s.ToCron()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(stdout, cron)
+			return nil
+		}
+		cron, warnings, err := s.ToCronApprox()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, cron)
+		for _, w := range warnings {
+			fmt.Fprintf(stderr, "warning: %s\n", w)
+		}
+		return nil
+	})
+}
+
+// runFromCron converts cron expressions to hron.
+func runFromCron(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("from-cron", flag.ContinueOnError)
+	both := fs.Bool("both", false, "run on days matching both day fields instead of either")
+	crons, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	match := hron.CronDayEither
+	if *both {
+		match = hron.CronDayBoth
+	}
+	return forEach(crons, stdout, stderr, func(cron string) error {
+		schedules, err := hron.FromCronExprDays(cron, match)
+		if err != nil {
+			return err
+		}
+		for _, s := range schedules {
+			fmt.Fprintln(stdout, s)
+		}
+		return nil
+	})
+}
+
+// runDescribe prints a sentence describing each expression.
+func runDescribe(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("describe", flag.ContinueOnError)
+	locale := fs.String("locale", "en", "language of the description: en, es, de, or fr")
+	exprs, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	return forEach(exprs, stdout, stderr, func(expr string) error {
+		s, err := hron.ParseSchedule(expr)
+		if err != nil {
+			return err
+		}
+		text, err := s.DescribeIn(*locale)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, text)
+		return nil
+	})
+}
+
+// runExplain prints what each expression means: its canonical form, description, cron
+// equivalent, next occurrences after now, and any warnings or style findings.
+func runExplain(args []string, stdin io.Reader, stdout, stderr io.Writer, now time.Time) error {
+	fs := flag.NewFlagSet("explain", flag.ContinueOnError)
+	n := fs.Int("n", 3, "number of occurrences to show")
+	from := fromFlag(fs)
+	exprs, err := expressionFlags(fs, args, stdin, stderr)
+	if err != nil {
+		return err
+	}
+	start, err := searchStart(*from, now)
+	if err != nil {
+		return err
+	}
+	return forEach(exprs, stdout, stderr, func(expr string) error {
+		report, err := hron.ValidateDetailed(expr)
+		if err != nil {
+			return err
+		}
+		s, err := hron.ParseScheduleAt(expr, now)
+		if err != nil {
+			return err
+		}
+		field := func(name, format string, args ...any) {
+			if name != "" {
+				name += ":"
+			}
+			fmt.Fprintf(stdout, "  %-12s %s\n", name, fmt.Sprintf(format, args...))
+		}
+		field("canonical", "%s", s)
+		field("meaning", "%s", s.Describe())
+		if tz := s.Timezone(); tz != "" {
+			field("timezone", "%s", tz)
+		} else {
+			field("timezone", "system local time")
+		}
+		if cron, warnings, err := s.ToCronApprox(); err != nil {
+			field("cron", "none (%v)", err)
+		} else if len(warnings) > 0 {
+			field("cron", "%s (approximate: %s)", cron, strings.Join(warnings, "; "))
+		} else {
+			field("cron", "%s", cron)
+		}
+
+		next := s.NextNFrom(start, *n)
+		if len(next) == 0 {
+			field("next", "none")
+		}
+		for i, t := range next {
+			name := ""
+			if i == 0 {
+				name = "next"
+			}
+			field(name, "%s", t.Format(time.RFC3339))
+		}
+
+		for _, f := range report.Findings {
+			field("warning", "%s", f.Message)
+		}
+		for _, f := range hron.Lint(expr) {
+			field("style", "%s", f.Message)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+// Command hron is a command-line tool for working with hron expressions.
+//
+// Usage:
+//
+//	hron next [-n count] [-from time] [expression]
+//	hron validate [expression]
+//	hron explain [-n count] [-from time] [expression]
+//	hron describe [-locale lang] [expression]
+//	hron to-cron [-approx] [expression]
+//	hron from-cron [-both] [cron]
+//	hron migrate [-to version] [-w] [file ...]
+//
+// Commands taking an expression read it from the arguments, which need not be quoted,
+// or else read one expression per line from stdin.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const usage = `usage: hron <command> [arguments]
+
+commands:
+  next        print the next occurrences of an expression
+  validate    check expressions, printing their canonical form and warnings
+  explain     show what an expression means: description, cron, next runs, warnings
+  describe    describe an expression in words
+  to-cron     convert an expression to a 5-field cron expression
+  from-cron   convert a 5-field cron expression to hron
+  migrate     canonicalize stored expressions (one per line) from files or stdin
+
+Expressions are read from the arguments, or one per line from stdin.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, time.Now()))
+}
+
+// run runs the command line args, without the program name, and returns the exit
+// status. now is the time commands search for occurrences after.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer, now time.Time) int {
+	if len(args) < 1 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "next":
+		err = runNext(args[1:], stdin, stdout, stderr, now)
+	case "validate":
+		err = runValidate(args[1:], stdin, stdout, stderr)
+	case "explain":
+		err = runExplain(args[1:], stdin, stdout, stderr, now)
+	case "describe":
+		err = runDescribe(args[1:], stdin, stdout, stderr)
+	case "to-cron":
+		err = runToCron(args[1:], stdin, stdout, stderr)
+	case "from-cron":
+		err = runFromCron(args[1:], stdin, stdout, stderr)
+	case "migrate":
+		err = runMigrate(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "hron: unknown command %q\n%s", args[0], usage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "hron %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		stdout string
+		stderr string // Contained in standard error
+		status int
+	}{
+		{
+			name:   "next",
+			args:   []string{"next", "-n", "2", "every", "day", "at", "9:00", "in", "UTC"},
+			stdout: "2026-02-07T09:00:00Z\n2026-02-08T09:00:00Z\n",
+		},
+		{
+			name:   "next from",
+			args:   []string{"next", "-n", "1", "-from", "2026-03-01T00:00:00Z", "every day at 9:00 in UTC"},
+			stdout: "2026-03-01T09:00:00Z\n",
+		},
+		{
+			name:   "next stdin",
+			args:   []string{"next", "-n", "1"},
+			stdin:  "every day at 9:00 in UTC\n\n# weekly\nevery monday at 9:00 in UTC\n",
+			stdout: "every day at 9:00 in UTC\n2026-02-07T09:00:00Z\n\nevery monday at 9:00 in UTC\n2026-02-09T09:00:00Z\n",
+		},
+		{
+			name:   "next relative",
+			args:   []string{"next", "-n", "2", "every day at 09:00 starting next monday in UTC"},
+			stdout: "2026-02-09T09:00:00Z\n2026-02-10T09:00:00Z\n",
+		},
+		{
+			name:   "next invalid",
+			args:   []string{"next", "every dy at 9:00"},
+			stderr: "unknown keyword 'dy'",
+			status: 1,
+		},
+		{
+			name:   "next bad from",
+			args:   []string{"next", "-from", "tomorrow", "every day at 9:00"},
+			stderr: "hron next: -from:",
+			status: 1,
+		},
+		{
+			name:   "validate",
+			args:   []string{"validate", "every 1 weeks on monday at 9:00"},
+			stdout: "ok: every week on monday at 09:00\n",
+		},
+		{
+			name: "explain",
+			args: []string{"explain", "-n", "2", "every weekday at 9:00 in UTC"},
+			stdout: "  canonical:   every weekday at 09:00 in UTC\n" +
+				"  meaning:     Runs at 9:00 AM on weekdays, in UTC\n" +
+				"  timezone:    UTC\n" +
+				"  cron:        0 9 * * 1-5\n" +
+				"  next:        2026-02-09T09:00:00Z\n" +
+				"               2026-02-10T09:00:00Z\n",
+		},
+		{
+			name: "explain relative",
+			args: []string{"explain", "-n", "3", "-from", "2026-02-26T00:00:00Z", "every weekday at 9:00 until end of month in UTC"},
+			stdout: "  canonical:   every weekday at 09:00 until end of month in UTC\n" +
+				"  meaning:     Runs at 9:00 AM on weekdays, until the end of the month, in UTC\n" +
+				"  timezone:    UTC\n" +
+				"  cron:        0 9 * * 1-5 (approximate: until end of month dropped: keeps running after it)\n" +
+				"  next:        2026-02-26T09:00:00Z\n" +
+				"               2026-02-27T09:00:00Z\n",
+		},
+		{
+			name:   "describe",
+			args:   []string{"describe", "every weekday at 9:00 in UTC"},
+			stdout: "Runs at 9:00 AM on weekdays, in UTC\n",
+		},
+		{
+			name:   "to-cron",
+			args:   []string{"to-cron", "every weekday at 9:00"},
+			stdout: "0 9 * * 1-5\n",
+		},
+		{
+			name:   "to-cron unsupported",
+			args:   []string{"to-cron", "every 2 days at 9:00"},
+			stderr: "not expressible as cron",
+			status: 1,
+		},
+		{
+			name:   "to-cron approx",
+			args:   []string{"to-cron", "-approx", "every 2 days at 9:00"},
+			stdout: "0 9 */2 * *\n",
+			stderr: "warning: every 2 days restarts on the 1st of each month",
+		},
+		{
+			name:   "from-cron",
+			args:   []string{"from-cron", "0 9 * * 1-5"},
+			stdout: "every weekday at 09:00\n",
+		},
+		{
+			name:   "migrate",
+			args:   []string{"migrate"},
+			stdin:  "every 1 weeks on monday at 9:00\n",
+			stdout: "every week on monday at 09:00\n",
+			stderr: "<stdin>: 1 expressions, 1 changed, 0 failed",
+		},
+		{
+			name:   "migrate keeps indentation",
+			args:   []string{"migrate"},
+			stdin:  "  every 1 weeks on monday at 9:00\n\t# nightly\n\tevery day at 2am  \n",
+			stdout: "  every week on monday at 09:00\n\t# nightly\n\tevery day at 02:00  \n",
+			stderr: "<stdin>: 2 expressions, 2 changed, 0 failed",
+		},
+		{
+			name:   "migrate keeps line endings",
+			args:   []string{"migrate"},
+			stdin:  "every 1 weeks on monday at 9:00\r\n# nightly\r\nevery day at 2am",
+			stdout: "every week on monday at 09:00\r\n# nightly\r\nevery day at 02:00",
+			stderr: "<stdin>: 2 expressions, 2 changed, 0 failed",
+		},
+		{
+			name:   "help",
+			args:   []string{"help"},
+			stdout: usage,
+		},
+		{
+			name:   "no command",
+			stderr: usage,
+			status: 2,
+		},
+		{
+			name:   "unknown command",
+			args:   []string{"bogus"},
+			stderr: `hron: unknown command "bogus"`,
+			status: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr, now)
+			if status != tt.status {
+				t.Errorf("status = %d, want %d (stderr %q)", status, tt.status, stderr.String())
+			}
+			if got := stdout.String(); got != tt.stdout {
+				t.Errorf("stdout = %q, want %q", got, tt.stdout)
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr.String(), tt.stderr)
+			}
+		})
+	}
+}
+
+func TestMigrateWrite(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.txt")
+	good := filepath.Join(dir, "good.txt")
+	if err := os.WriteFile(bad, []byte("every blue moon\nevery 1 weeks on monday at 9:00\n"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(good, []byte("every day at 2am\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr strings.Builder
+	args := []string{"migrate", "-w", filepath.Join(dir, "missing.txt"), bad, good}
+	if status := run(args, strings.NewReader(""), &stdout, &stderr, time.Now()); status != 1 {
+		t.Errorf("status = %d, want 1", status)
+	}
+	for _, want := range []string{"missing.txt", "1 expressions in " + bad} {
+		if !strings.Contains(stderr.String(), want) {
+			t.Errorf("stderr = %q, want it to contain %q", stderr.String(), want)
+		}
+	}
+
+	// Every file is processed, failed lines are kept, and line endings and modes survive
+	for name, want := range map[string]string{
+		bad:  "every blue moon\nevery week on monday at 09:00\n",
+		good: "every day at 02:00\r\n",
+	} {
+		got, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", filepath.Base(name), got, want)
+		}
+	}
+	info, err := os.Stat(bad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o640 {
+		t.Errorf("mode of %s = %v, want 0640", filepath.Base(bad), mode)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("directory has %d entries, want no temporary files left", len(entries))
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// runMigrate canonicalizes expressions read one per line from files or stdin, keeping
+// the whitespace around each. Blank lines and lines starting with '#' are passed through
+// unchanged. Lines that fail to migrate are kept as-is and reported on stderr, and every
+// file is processed even when an earlier one fails.
+func runMigrate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	target := fs.String("to", hron.Version, "target version")
+	write := fs.Bool("w", false, "rewrite files in place instead of printing to stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		if *write {
+			return fmt.Errorf("-w requires file arguments")
+		}
+		return migrateStream("<stdin>", stdin, stdout, stderr, *target)
+	}
+
+	// Keep going past a failing file, so one run reports every problem
+	var errs []error
+	for _, name := range fs.Args() {
+		if err := migrateFile(name, stdout, stderr, *target, *write); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func migrateFile(name string, stdout, stderr io.Writer, target string, write bool) error {
+	info, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	out, failed, err := migrateText(name, string(data), stderr, target)
+	if err != nil {
+		return err
+	}
+	if write {
+		// Lines that fail are kept as-is, so the file is rewritten even when some do
+		err = writeFileAtomic(name, []byte(out), info.Mode().Perm())
+	} else {
+		_, err = io.WriteString(stdout, out)
+	}
+	if err != nil {
+		return err
+	}
+	return migrateFailed(name, failed)
+}
+
+func migrateStream(name string, r io.Reader, w, stderr io.Writer, target string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	out, failed, err := migrateText(name, string(data), stderr, target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, out); err != nil {
+		return err
+	}
+	return migrateFailed(name, failed)
+}
+
+// writeFileAtomic replaces name with data through a temporary file in the same directory,
+// so an interrupted write leaves the original file intact.
+func writeFileAtomic(name string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // Fails harmlessly once renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// migrateText migrates the expression on each line of text, reporting failures and a
+// summary on stderr, and returns the result with how many expressions failed. Lines keep
+// their endings, "\n" or "\r\n", and a missing final newline stays missing.
+func migrateText(name, text string, stderr io.Writer, target string) (string, int, error) {
+	lines := strings.SplitAfter(text, "\n")
+
+	var exprs []string
+	var lineNums []int
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		exprs = append(exprs, trimmed)
+		lineNums = append(lineNums, i)
+	}
+
+	results, err := hron.MigrateExpressions(exprs, target)
+	if err != nil {
+		return "", 0, err
+	}
+
+	changed, failed := 0, 0
+	for i, m := range results {
+		switch {
+		case m.Err != nil:
+			failed++
+			fmt.Fprintf(stderr, "%s:%d: %v\n", name, lineNums[i]+1, m.Err)
+			continue
+		case m.Changed:
+			changed++
+		}
+		for _, warning := range m.Warnings {
+			fmt.Fprintf(stderr, "%s:%d: warning: %s\n", name, lineNums[i]+1, warning.Message)
+		}
+		line := lines[lineNums[i]]
+		start := strings.Index(line, exprs[i])
+		lines[lineNums[i]] = line[:start] + m.Output + line[start+len(exprs[i]):]
+	}
+
+	fmt.Fprintf(stderr, "%s: %d expressions, %d changed, %d failed\n", name, len(results), changed, failed)
+	return strings.Join(lines, ""), failed, nil
+}
+
+func migrateFailed(name string, failed int) error {
+	if failed > 0 {
+		return fmt.Errorf("%d expressions in %s could not be migrated", failed, name)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package hron
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CompletionKind is what a Completion inserts.
+type CompletionKind int
+
+const (
+	CompletionKeyword CompletionKind = iota
+	CompletionDayName
+	CompletionMonthName
+	CompletionTime     // An example time of day to edit
+	CompletionNumber   // An example number or ordinal to edit
+	CompletionDate     // The date passed to Complete, to edit
+	CompletionTimezone // A timezone of the in clause
+)
+
+// Completion is a word Complete suggests: Label replaces the text at Span, the partial
+// word before the cursor.
+type Completion struct {
+	Label string
+	Kind  CompletionKind
+	Span  Span
+}
+
+// completionTimezones are the zones offered after `in`: UTC, local, and the zones of the
+// timezone abbreviations.
+var completionTimezones = func() []string {
+	zones := []string{"UTC", LocalTimezone}
+	for _, candidates := range timezoneAbbreviations {
+		zones = append(zones, candidates...)
+	}
+	slices.Sort(zones[2:])
+	return slices.Compact(zones)
+}()
+
+// Complete suggests the words that can follow the text of input before cursor, a byte
+// offset, for autocomplete in schedule editors. The partial word before the cursor
+// filters the suggestions and is the span they replace. A word is suggested when the
+// parser accepts the text with it in place, as a complete expression or one that needs
+// more words; text after the cursor is not considered. Day names are offered in full and
+// month names abbreviated, as the canonical form writes them, and times, numbers, and
+// dates as examples to edit, the date being that of now. Completions are ordered by
+// kind, then label.
+func Complete(input string, cursor int, now time.Time) []Completion {
+	cursor = min(max(cursor, 0), len(input))
+	start := cursor
+	for start > 0 && !isWhitespace(input[start-1]) && input[start-1] != ',' {
+		start--
+	}
+	base, partial := input[:start], strings.ToLower(input[start:cursor])
+	span := Span{start, cursor}
+
+	var out []Completion
+	seen := map[string]bool{}
+	for _, c := range completionCandidates(now) {
+		if seen[c.Label] || !strings.HasPrefix(strings.ToLower(c.Label), partial) || !acceptsNext(base+c.Label) {
+			continue
+		}
+		seen[c.Label] = true
+		c.Span = span
+		out = append(out, c)
+	}
+	slices.SortStableFunc(out, func(a, b Completion) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Label, b.Label))
+	})
+	return out
+}
+
+// completionCandidates returns every word Complete may suggest on the date of now.
+func completionCandidates(now time.Time) []Completion {
+	var out []Completion
+	for _, word := range slices.Sorted(maps.Keys(keywordMap)) {
+		switch tok := keywordMap[word]; tok.Kind {
+		case TokenDayName:
+			if word == strings.ToLower(tok.DayNameVal.String()) {
+				out = append(out, Completion{Label: word, Kind: CompletionDayName})
+			}
+		case TokenMonthName:
+			if word == strings.ToLower(tok.MonthNameVal.String()) {
+				out = append(out, Completion{Label: word, Kind: CompletionMonthName})
+			}
+		default:
+			out = append(out, Completion{Label: word, Kind: CompletionKeyword})
+		}
+	}
+	out = append(out,
+		Completion{Label: "09:00", Kind: CompletionTime},
+		Completion{Label: "1", Kind: CompletionNumber},
+		Completion{Label: "1st", Kind: CompletionNumber},
+		Completion{Label: now.Format(time.DateOnly), Kind: CompletionDate},
+	)
+	for _, zone := range completionTimezones {
+		out = append(out, Completion{Label: zone, Kind: CompletionTimezone})
+	}
+	return out
+}
+
+// acceptsNext reports whether text parses, or fails only for want of more words: its
+// error is at the end. A timezone it ends with must also load.
+func acceptsNext(text string) bool {
+	tokens, err := Tokenize(text)
+	if err != nil || len(tokens) == 0 {
+		return false
+	}
+	if last := tokens[len(tokens)-1]; last.Kind == TokenTimezone && last.TimezoneVal != LocalTimezone {
+		if _, err := resolveTimezone(last.TimezoneVal); err != nil {
+			return false
+		}
+	}
+	_, err = parseTokens(tokens, text)
+	if err == nil {
+		return true
+	}
+	herr, ok := err.(*HronError)
+	return ok && herr.Span != nil && herr.Span.Start >= len(text)
+}
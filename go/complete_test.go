@@ -0,0 +1,80 @@
+package hron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// completeNow is the date Complete offers to edit.
+var completeNow = time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		input   string
+		cursor  int
+		want    []string // Labels that must be offered
+		without []string // Labels that must not be
+	}{
+		{"", 0, []string{"every", "on", "first"}, []string{"at", "monday"}},
+		{"on ", 3, []string{"2026-02-06", "jan"}, []string{"at"}},
+		{"every ", 6, []string{"day", "weekday", "monday", "jan", "1"}, []string{"at", "every"}},
+		{"every mo", 8, []string{"monday", "month", "months"}, []string{"day", "friday"}},
+		{"every MO", 8, []string{"monday", "month"}, nil},
+		{"every monday ", 13, []string{"at"}, []string{"on", "monday"}},
+		{"every monday at ", 16, []string{"09:00", "noon", "midnight"}, []string{"at"}},
+		{"every monday at 09:00 ", 22, []string{"in", "except", "until", "starting", "during"}, []string{"at"}},
+		{"every monday at 09:00 in Am", 27, []string{"America/New_York", "America/Chicago"}, []string{"UTC"}},
+		{"every monday at 09:00 in ", 25, []string{"UTC", "local", "Asia/Tokyo"}, nil},
+		{"every month on the ", 19, []string{"first", "last", "1st"}, []string{"monday"}},
+		{"every monday at 09:00", 8, []string{"monday", "month"}, nil},
+		{"every monday at 09:00, ", 23, []string{"09:00", "noon"}, []string{"in", "at"}},
+	}
+	for _, tt := range tests {
+		var labels []string
+		for _, c := range Complete(tt.input, tt.cursor, completeNow) {
+			labels = append(labels, c.Label)
+		}
+		for _, w := range tt.want {
+			if !slices.Contains(labels, w) {
+				t.Errorf("Complete(%q, %d) = %v, want %q", tt.input, tt.cursor, labels, w)
+			}
+		}
+		for _, w := range tt.without {
+			if slices.Contains(labels, w) {
+				t.Errorf("Complete(%q, %d) = %v, want no %q", tt.input, tt.cursor, labels, w)
+			}
+		}
+	}
+}
+
+func TestCompleteSpanAndKind(t *testing.T) {
+	completions := Complete("every mon at 09:00", 9, completeNow)
+	i := slices.IndexFunc(completions, func(c Completion) bool { return c.Label == "monday" })
+	if i < 0 {
+		t.Fatalf("Complete = %v, want monday", completions)
+	}
+	c := completions[i]
+	if c.Kind != CompletionDayName || c.Span != (Span{6, 9}) {
+		t.Errorf("monday = %+v, want a day name replacing 6..9", c)
+	}
+	for i := 1; i < len(completions); i++ {
+		if completions[i-1].Kind > completions[i].Kind {
+			t.Errorf("completions not ordered by kind: %v", completions)
+			break
+		}
+	}
+}
+
+func TestCompleteEveryCandidateParses(t *testing.T) {
+	// Whatever is offered after a complete expression keeps it parseable or needs more words
+	input := "every weekday at 09:00 "
+	for _, c := range Complete(input, len(input), completeNow) {
+		if !acceptsNext(input + c.Label) {
+			t.Errorf("offered %q, which the parser rejects", c.Label)
+		}
+	}
+	if got := Complete("every monday at 09:00 in Nowhere/Zone", 37, completeNow); len(got) != 0 {
+		t.Errorf("Complete of an unknown zone = %v, want none", got)
+	}
+}
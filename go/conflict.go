@@ -0,0 +1,39 @@
+package hron
+
+import (
+	"iter"
+	"time"
+)
+
+// ConflictsWithin returns the occurrences of a in [from, to) that have an occurrence of b
+// within tolerance of them (before or after), for detecting jobs that collide on a shared
+// resource. Each conflicting occurrence of a is listed once, in order. A tolerance of zero
+// finds exact coincidences. Schedules that fail Validate have no conflicts.
+func ConflictsWithin(a, b *Schedule, from, to time.Time, tolerance time.Duration) []time.Time {
+	if tolerance < 0 || !to.After(from) || a.Validate() != nil || b.Validate() != nil {
+		return nil
+	}
+
+	// Occurrences is strictly after its argument; step back so ones at the bound count
+	nextB, stop := iter.Pull(b.Occurrences(from.Add(-tolerance - time.Nanosecond)))
+	defer stop()
+	occB, okB := nextB()
+
+	var conflicts []time.Time
+	for occA := range a.Occurrences(from.Add(-time.Nanosecond)) {
+		if !occA.Before(to) {
+			break
+		}
+		// Skip occurrences of b too early to conflict with this or any later a
+		for okB && occB.Before(occA.Add(-tolerance)) {
+			occB, okB = nextB()
+		}
+		if !okB {
+			break
+		}
+		if !occB.After(occA.Add(tolerance)) {
+			conflicts = append(conflicts, occA)
+		}
+	}
+	return conflicts
+}
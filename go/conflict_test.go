@@ -0,0 +1,40 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConflictsWithin(t *testing.T) {
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday
+	to := from.AddDate(0, 0, 7)
+	tests := []struct {
+		a, b      string
+		tolerance time.Duration
+		want      []string
+	}{
+		{"every weekday at 09:00 in UTC", "every monday at 09:10 in UTC", 15 * time.Minute,
+			[]string{"2026-03-02T09:00:00Z"}},
+		{"every weekday at 09:00 in UTC", "every monday at 09:10 in UTC", 5 * time.Minute, nil},
+		{"every day at 02:00 in UTC", "every 30 min from 00:00 to 23:59 in UTC", 0, []string{
+			"2026-03-02T02:00:00Z", "2026-03-03T02:00:00Z", "2026-03-04T02:00:00Z", "2026-03-05T02:00:00Z",
+			"2026-03-06T02:00:00Z", "2026-03-07T02:00:00Z", "2026-03-08T02:00:00Z",
+		}},
+		// b fires just before from; it still conflicts with a at from
+		{"every monday at 00:00 in UTC", "every sunday at 23:55 in UTC", 10 * time.Minute,
+			[]string{"2026-03-02T00:00:00Z"}},
+		{"every saturday at 09:00 in UTC", "every sunday at 09:00 in UTC", time.Hour, nil},
+	}
+	for _, tt := range tests {
+		got := ConflictsWithin(MustParse(tt.a), MustParse(tt.b), from, to, tt.tolerance)
+		if len(got) != len(tt.want) {
+			t.Errorf("ConflictsWithin(%q, %q, %v) = %v, want %v", tt.a, tt.b, tt.tolerance, got, tt.want)
+			continue
+		}
+		for i, occ := range got {
+			if occ.Format(time.RFC3339) != tt.want[i] {
+				t.Errorf("ConflictsWithin(%q, %q, %v)[%d] = %v, want %s", tt.a, tt.b, tt.tolerance, i, occ, tt.want[i])
+			}
+		}
+	}
+}
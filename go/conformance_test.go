@@ -558,6 +558,9 @@ func TestToCronErrors(t *testing.T) {
 
 	for _, tc := range spec.Cron.ToCronErrors.Tests {
 		t.Run(tc.Name, func(t *testing.T) {
+			if _, ok := toCronExtensions[tc.Name]; ok {
+				t.Skip("converted by a Go-only extension (see TestToCronExtensions)")
+			}
 			s, err := ParseSchedule(tc.Hron)
 			if err != nil {
 				t.Fatalf("failed to parse %q: %v", tc.Hron, err)
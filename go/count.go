@@ -0,0 +1,103 @@
+package hron
+
+import (
+	"slices"
+	"time"
+)
+
+// CountBetween returns the number of occurrences in (from, to], the ones Between
+// yields, without producing them. Day, week, and month repeats at an interval of one
+// with day-list, last-day, or last-weekday targets are counted a day at a time with a
+// fixed number of occurrences per day; other schedules, filtered ones, and the partial
+// days at either end of the range, are counted by iterating.
+func (s *Schedule) CountBetween(from, to time.Time) int {
+	if s.Validate() != nil || !to.After(from) {
+		return 0
+	}
+	fires, ok := dailyPredicate(s.data, s.calendar)
+	if !ok || s.filter != nil {
+		return countByIteration(s, from, to)
+	}
+
+	loc := s.location
+	firstDay := dateOnly(from.In(loc)).AddDate(0, 0, 1)
+	endDay := dateOnly(to.In(loc))
+	if !firstDay.Before(endDay) {
+		return countByIteration(s, from, to)
+	}
+
+	// Whole days in [firstDay, endDay) are counted per day; the rest is iterated
+	count := countByIteration(s, from, localMidnight(firstDay, loc).Add(-time.Nanosecond)) +
+		countByIteration(s, localMidnight(endDay, loc).Add(-time.Nanosecond), to)
+	perDay := len(sortedUnique(s.data.Expr.Times, compareTimes))
+	end := localMidnight(firstDay, loc)
+	for d := firstDay; d.Before(endDay); d = d.AddDate(0, 0, 1) {
+		start := end
+		end = localMidnight(d.AddDate(0, 0, 1), loc)
+		if !fires(d) {
+			continue
+		}
+		if zoneOffset(start) != zoneOffset(end) {
+			// Times in a DST gap can land on another listed time, so iterate this day
+			count += countByIteration(s, start.Add(-time.Nanosecond), end.Add(-time.Nanosecond))
+			continue
+		}
+		count += perDay
+	}
+	return count
+}
+
+// dailyPredicate returns a function reporting whether a schedule fires on a civil date,
+// for schedules that fire at all of their times on every such date. It returns false for
+// other schedules.
+func dailyPredicate(schedule *ScheduleData, cal HolidayCalendar) (func(d time.Time) bool, bool) {
+	expr := schedule.Expr
+	if expr.Interval > 1 || displayStarting(schedule) != "" || schedule.Until != nil || schedule.Offset != (Offset{}) || usesSolar(schedule) || len(schedule.Timezones) > 0 {
+		return nil, false
+	}
+
+	var target func(d time.Time) bool
+	switch expr.Kind {
+	case ScheduleExprKindDay:
+		target = func(d time.Time) bool { return matchesDayFilter(d, expr.Days) }
+	case ScheduleExprKindWeek:
+		target = func(d time.Time) bool { return slices.Contains(expr.WeekDays, Weekday(isoWeekday(d))) }
+	case ScheduleExprKindMonth:
+		switch expr.MonthTarget.Kind {
+		case MonthTargetKindDays:
+			days := expr.MonthTarget.ExpandDays()
+			target = func(d time.Time) bool { return slices.Contains(days, d.Day()) }
+		case MonthTargetKindLastDay:
+			target = func(d time.Time) bool { return d.Equal(lastDayOfMonth(d.Year(), d.Month())) }
+		case MonthTargetKindLastWeekday:
+			target = func(d time.Time) bool { return d.Equal(lastWeekdayOfMonth(d.Year(), d.Month())) }
+		default:
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	return func(d time.Time) bool {
+		return target(d) && !isExcepted(d, schedule.Except, cal) &&
+			(!hasDuringClause(schedule) || matchesDuringClause(d, schedule))
+	}, true
+}
+
+func zoneOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+// localMidnight returns the start of a civil date in loc.
+func localMidnight(d time.Time, loc *time.Location) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+}
+
+func countByIteration(s *Schedule, from, to time.Time) int {
+	n := 0
+	for range s.Between(from, to) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,56 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+// CountBetween must agree with iterating Between, including at DST transitions and at
+// range bounds that fall exactly on an occurrence.
+func TestCountBetweenMatchesIteration(t *testing.T) {
+	schedules := []string{
+		"every day at 09:00, 17:00 in UTC",
+		"every weekday at 02:30 in America/New_York",
+		"every day at 02:00, 02:30, 03:00 in America/New_York",
+		"every day at 01:30 in America/New_York",
+		"every monday, thursday at 08:00 in Europe/London",
+		"every month on the 1st, 15th to 17th, 31st at 12:00 in UTC",
+		"every month on the last day at 23:00 in Asia/Tokyo",
+		"every month on the last weekday at 18:00 in UTC",
+		"every day at 09:00 except dec 25, 2026-07-04 during jun, jul, dec in UTC",
+		"every day at 09:00 during weeks 10 to 12 in UTC",
+		"every 2 days at 09:00 in UTC",
+		"every 30 min from 09:00 to 17:00 on weekday in UTC",
+		"every month on the first monday at 10:00 in UTC",
+		"every day at 09:00 until 2026-06-30 in UTC",
+	}
+	ranges := [][2]time.Time{
+		{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 17, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 10, 31, 12, 0, 0, 0, time.UTC), time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 5, 5, 8, 0, 0, 0, time.UTC), time.Date(2026, 5, 5, 20, 0, 0, 0, time.UTC)},
+	}
+	for _, input := range schedules {
+		s := MustParse(input)
+		for _, r := range ranges {
+			want := 0
+			for range s.Between(r[0], r[1]) {
+				want++
+			}
+			if got := s.CountBetween(r[0], r[1]); got != want {
+				t.Errorf("%q.CountBetween(%v, %v) = %d, want %d", input, r[0], r[1], got, want)
+			}
+		}
+	}
+}
+
+func TestCountBetweenEmptyRange(t *testing.T) {
+	s := MustParse("every day at 09:00")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := s.CountBetween(now, now); got != 0 {
+		t.Errorf("CountBetween(now, now) = %d, want 0", got)
+	}
+	if got := s.CountBetween(now, now.Add(-time.Hour)); got != 0 {
+		t.Errorf("CountBetween(now, earlier) = %d, want 0", got)
+	}
+}
@@ -2,12 +2,15 @@ package hron
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 )
 
-// ToCron converts a schedule to a 5-field cron expression.
+// ToCron converts a schedule to a 5-field cron expression. A during clause of whole
+// months becomes the month field. Several times become minute and hour lists, when
+// every combination of a listed minute and hour is one of the times.
 func ToCron(schedule *ScheduleData) (string, error) {
 	if len(schedule.Except) > 0 {
 		return "", CronError("not expressible as cron (except clauses not supported)")
@@ -15,8 +18,22 @@ func ToCron(schedule *ScheduleData) (string, error) {
 	if schedule.Until != nil {
 		return "", CronError("not expressible as cron (until clauses not supported)")
 	}
-	if len(schedule.During) > 0 {
-		return "", CronError("not expressible as cron (during clauses not supported)")
+	if schedule.Offset != (Offset{}) {
+		return "", CronError("not expressible as cron (plus and minus clauses not supported)")
+	}
+	if usesSolar(schedule) {
+		return "", CronError("not expressible as cron (solar times not supported)")
+	}
+	if len(schedule.Timezones) > 1 {
+		return "", CronError("not expressible as cron (a cron runs in one timezone)")
+	}
+	months, exact := cronDuringMonths(schedule)
+	if !exact {
+		return "", CronError("not expressible as cron (during clauses with dates or weeks not supported)")
+	}
+	month := "*"
+	if months != nil {
+		month = formatIntList(months)
 	}
 
 	expr := schedule.Expr
@@ -26,12 +43,12 @@ func ToCron(schedule *ScheduleData) (string, error) {
 		if expr.Interval > 1 {
 			return "", CronError("not expressible as cron (multi-day intervals not supported)")
 		}
-		if len(expr.Times) != 1 {
-			return "", CronError("not expressible as cron (multiple times not supported)")
+		minute, hour, err := cronExactTimeFields(expr.Times)
+		if err != nil {
+			return "", err
 		}
-		t := expr.Times[0]
 		dow := dayFilterToCronDOW(expr.Days)
-		return fmt.Sprintf("%d %d * * %s", t.Minute, t.Hour, dow), nil
+		return fmt.Sprintf("%s %s * %s %s", minute, hour, month, dow), nil
 
 	case ScheduleExprKindInterval:
 		fullDay := expr.FromTime.Hour == 0 && expr.FromTime.Minute == 0 && expr.ToTime.Hour == 23 && expr.ToTime.Minute == 59
@@ -41,26 +58,37 @@ func ToCron(schedule *ScheduleData) (string, error) {
 		if expr.DayFilter != nil {
 			return "", CronError("not expressible as cron (interval with day filter not supported)")
 		}
+		if expr.Unit == IntervalSeconds {
+			return "", CronError("not expressible as cron (cron has no seconds field)")
+		}
 		if expr.Unit == IntervalMin {
 			if 60%expr.Interval != 0 {
 				return "", CronError(fmt.Sprintf("not expressible as cron (*/%d breaks at hour boundaries)", expr.Interval))
 			}
-			return fmt.Sprintf("*/%d * * * *", expr.Interval), nil
+			return fmt.Sprintf("*/%d * * %s *", expr.Interval, month), nil
 		}
 		// hours
-		return fmt.Sprintf("0 */%d * * *", expr.Interval), nil
+		return fmt.Sprintf("0 */%d * %s *", expr.Interval, month), nil
 
 	case ScheduleExprKindWeek:
-		return "", CronError("not expressible as cron (multi-week intervals not supported)")
+		if expr.Interval > 1 {
+			return "", CronError("not expressible as cron (multi-week intervals not supported)")
+		}
+		minute, hour, err := cronExactTimeFields(expr.Times)
+		if err != nil {
+			return "", err
+		}
+		dow := dayFilterToCronDOW(normalizeDayFilter(NewDayFilterDays(expr.WeekDays)))
+		return fmt.Sprintf("%s %s * %s %s", minute, hour, month, dow), nil
 
 	case ScheduleExprKindMonth:
 		if expr.Interval > 1 {
 			return "", CronError("not expressible as cron (multi-month intervals not supported)")
 		}
-		if len(expr.Times) != 1 {
-			return "", CronError("not expressible as cron (multiple times not supported)")
+		minute, hour, err := cronExactTimeFields(expr.Times)
+		if err != nil {
+			return "", err
 		}
-		t := expr.Times[0]
 		switch expr.MonthTarget.Kind {
 		case MonthTargetKindDays:
 			var expanded []int
@@ -68,7 +96,7 @@ func ToCron(schedule *ScheduleData) (string, error) {
 				expanded = append(expanded, spec.Expand()...)
 			}
 			dom := formatIntList(expanded)
-			return fmt.Sprintf("%d %d %s * *", t.Minute, t.Hour, dom), nil
+			return fmt.Sprintf("%s %s %s %s *", minute, hour, dom, month), nil
 		case MonthTargetKindLastDay:
 			return "", CronError("not expressible as cron (last day of month not supported)")
 		case MonthTargetKindLastWeekday:
@@ -77,9 +105,13 @@ func ToCron(schedule *ScheduleData) (string, error) {
 			if expr.MonthTarget.Direction != NearestNone {
 				return "", CronError("not expressible as cron (directional nearest weekday not supported)")
 			}
-			return fmt.Sprintf("%d %d %dW * *", t.Minute, t.Hour, expr.MonthTarget.Day), nil
+			return fmt.Sprintf("%s %s %dW %s *", minute, hour, expr.MonthTarget.Day, month), nil
 		case MonthTargetKindOrdinalWeekday:
 			return "", CronError("not expressible as cron (ordinal weekday of month not supported)")
+		case MonthTargetKindWeekOfMonth:
+			return "", CronError("not expressible as cron (week of month not supported)")
+		case MonthTargetKindBusinessDay, MonthTargetKindLastBusinessDay:
+			return "", CronError("not expressible as cron (business days not supported)")
 		}
 
 	case ScheduleExprKindSingleDate:
@@ -87,11 +119,27 @@ func ToCron(schedule *ScheduleData) (string, error) {
 
 	case ScheduleExprKindYear:
 		return "", CronError("not expressible as cron (yearly schedules not supported in 5-field cron)")
+
+	case ScheduleExprKindRandom:
+		return "", CronError("not expressible as cron (random picks not supported)")
+
+	case ScheduleExprKindFiscal:
+		return "", CronError("not expressible as cron (fiscal periods not supported)")
 	}
 
 	return "", CronError(fmt.Sprintf("unknown expression type: %d", expr.Kind))
 }
 
+// cronExactTimeFields returns the minute and hour fields for times, or a CronError when
+// cron would also run at combinations of them that are not listed.
+func cronExactTimeFields(times []TimeOfDay) (string, string, error) {
+	minute, hour, extra := cronTimeFields(times)
+	if len(extra) > 0 {
+		return "", "", CronError(fmt.Sprintf("not expressible as cron (the minute and hour lists would also run at %s)", formatTimeList(extra)))
+	}
+	return minute, hour, nil
+}
+
 func dayFilterToCronDOW(f DayFilter) string {
 	switch f.Kind {
 	case DayFilterKindEvery:
@@ -120,7 +168,8 @@ func formatIntList(nums []int) string {
 	return strings.Join(parts, ",")
 }
 
-// FromCron converts a 5-field cron expression to a Schedule.
+// FromCron converts a 5-field cron expression to a Schedule. Minute and hour lists
+// become a time for each combination of a listed hour and minute.
 func FromCron(cron string) (*ScheduleData, error) {
 	cron = strings.TrimSpace(cron)
 
@@ -147,6 +196,9 @@ func FromCron(cron string) (*ScheduleData, error) {
 	if dowField == "?" {
 		dowField = "*"
 	}
+	if domField != "*" && dowField != "*" {
+		return nil, CronError(fmt.Sprintf("%q restricts both day of month and day of week; use FromCronDays to choose how they combine", cron))
+	}
 
 	// Parse month field into during clause
 	during, err := parseMonthField(monthField)
@@ -191,15 +243,10 @@ func FromCron(cron string) (*ScheduleData, error) {
 	}
 
 	// Standard time-based cron
-	minute, err := parseSingleValue(minuteField, "minute", 0, 59)
+	times, err := parseCronTimes(minuteField, hourField)
 	if err != nil {
 		return nil, err
 	}
-	hour, err := parseSingleValue(hourField, "hour", 0, 23)
-	if err != nil {
-		return nil, err
-	}
-	t := TimeOfDay{hour, minute}
 
 	// DOM-based (monthly) - when DOM is specified and DOW is *
 	if domField != "*" && dowField == "*" {
@@ -207,7 +254,7 @@ func FromCron(cron string) (*ScheduleData, error) {
 		if err != nil {
 			return nil, err
 		}
-		schedule := NewScheduleData(NewMonthRepeat(1, target, []TimeOfDay{t}))
+		schedule := NewScheduleData(NewMonthRepeat(1, target, times))
 		schedule.During = during
 		return schedule, nil
 	}
@@ -217,11 +264,83 @@ func FromCron(cron string) (*ScheduleData, error) {
 	if err != nil {
 		return nil, err
 	}
-	schedule = NewScheduleData(NewDayRepeat(1, days, []TimeOfDay{t}))
+	schedule = NewScheduleData(NewDayRepeat(1, days, times))
 	schedule.During = during
 	return schedule, nil
 }
 
+// CronDayMatch says which days a cron restricting both the day-of-month and day-of-week
+// fields runs on.
+type CronDayMatch int
+
+const (
+	// CronDayEither runs on days matching either field, as vixie cron and most cron
+	// implementations do: "0 9 1 * 1" runs on the 1st and on every Monday.
+	CronDayEither CronDayMatch = iota
+	// CronDayBoth runs on days matching both fields, the usual intent of a day range
+	// with a weekday: "0 9 1-7 * 1" runs on the first Monday of the month.
+	CronDayBoth
+)
+
+// FromCronDays converts a 5-field cron expression to schedules whose occurrences
+// together are the cron's. A cron restricting only one day field gives the single
+// schedule FromCron does. One restricting both gives, with CronDayEither, a schedule for
+// each field; with CronDayBoth, a schedule on an ordinal weekday, which needs one weekday
+// and a day-of-month range of the 1st to 7th, 8th to 14th, 15th to 21st, or 22nd to 28th.
+func FromCronDays(cron string, match CronDayMatch) ([]*ScheduleData, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 || fields[2] == "*" || fields[2] == "?" || fields[4] == "*" || fields[4] == "?" {
+		data, err := FromCron(cron)
+		if err != nil {
+			return nil, err
+		}
+		return []*ScheduleData{data}, nil
+	}
+
+	minute, hour, dom, month, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+	var crons []string
+	if match == CronDayEither {
+		crons = []string{
+			strings.Join([]string{minute, hour, dom, month, "*"}, " "),
+			strings.Join([]string{minute, hour, "*", month, dow}, " "),
+		}
+	} else {
+		nth, err := cronDOMWeek(dom)
+		if err != nil {
+			return nil, err
+		}
+		days, err := parseCronDOW(dow)
+		if err != nil {
+			return nil, err
+		}
+		if days.Kind != DayFilterKindDays || len(days.Days) != 1 {
+			return nil, CronError(fmt.Sprintf("matching both day fields needs a single weekday, got %q", dow))
+		}
+		crons = []string{strings.Join([]string{minute, hour, "*", month, fmt.Sprintf("%d#%d", days.Days[0].CronDOW(), nth)}, " ")}
+	}
+
+	out := make([]*ScheduleData, len(crons))
+	for i, c := range crons {
+		data, err := FromCron(c)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = data
+	}
+	return out, nil
+}
+
+// cronDOMWeek returns which week of the month a day-of-month range of seven days covers.
+func cronDOMWeek(dom string) (int, error) {
+	first, last, ok := strings.Cut(dom, "-")
+	from, err1 := strconv.Atoi(first)
+	to, err2 := strconv.Atoi(last)
+	if !ok || err1 != nil || err2 != nil || to != from+6 || from%7 != 1 || to > 28 {
+		return 0, CronError(fmt.Sprintf("matching both day fields needs a day-of-month range of one week (1-7, 8-14, 15-21, or 22-28), got %q", dom))
+	}
+	return (from + 6) / 7, nil
+}
+
 // parseCronShortcut parses @ shortcuts like @daily, @hourly, etc.
 func parseCronShortcut(cron string) (*ScheduleData, error) {
 	switch strings.ToLower(cron) {
@@ -375,17 +494,13 @@ func tryParseNthWeekday(minuteField, hourField, domField, dowField string, durin
 			return nil, false, CronError("DOM must be * when using # for nth weekday")
 		}
 
-		minute, err := parseSingleValue(minuteField, "minute", 0, 59)
-		if err != nil {
-			return nil, false, err
-		}
-		hour, err := parseSingleValue(hourField, "hour", 0, 23)
+		times, err := parseCronTimes(minuteField, hourField)
 		if err != nil {
 			return nil, false, err
 		}
 
 		target := NewOrdinalWeekdayTarget(ordinal, weekday)
-		schedule := NewScheduleData(NewMonthRepeat(1, target, []TimeOfDay{{hour, minute}}))
+		schedule := NewScheduleData(NewMonthRepeat(1, target, times))
 		schedule.During = during
 		return schedule, true, nil
 	}
@@ -406,17 +521,13 @@ func tryParseNthWeekday(minuteField, hourField, domField, dowField string, durin
 			return nil, false, CronError("DOM must be * when using nL for last weekday")
 		}
 
-		minute, err := parseSingleValue(minuteField, "minute", 0, 59)
-		if err != nil {
-			return nil, false, err
-		}
-		hour, err := parseSingleValue(hourField, "hour", 0, 23)
+		times, err := parseCronTimes(minuteField, hourField)
 		if err != nil {
 			return nil, false, err
 		}
 
 		target := NewOrdinalWeekdayTarget(Last, weekday)
-		schedule := NewScheduleData(NewMonthRepeat(1, target, []TimeOfDay{{hour, minute}}))
+		schedule := NewScheduleData(NewMonthRepeat(1, target, times))
 		schedule.During = during
 		return schedule, true, nil
 	}
@@ -445,17 +556,13 @@ func tryParseNearestWeekday(minuteField, hourField, domField, dowField string, d
 		return nil, false, CronError("DOW must be * when using W in DOM")
 	}
 
-	minute, err := parseSingleValue(minuteField, "minute", 0, 59)
-	if err != nil {
-		return nil, false, err
-	}
-	hour, err := parseSingleValue(hourField, "hour", 0, 23)
+	times, err := parseCronTimes(minuteField, hourField)
 	if err != nil {
 		return nil, false, err
 	}
 
 	target := NewNearestWeekdayTarget(day, NearestNone)
-	schedule := NewScheduleData(NewMonthRepeat(1, target, []TimeOfDay{{hour, minute}}))
+	schedule := NewScheduleData(NewMonthRepeat(1, target, times))
 	schedule.During = during
 	return schedule, true, nil
 }
@@ -470,11 +577,7 @@ func tryParseLastDay(minuteField, hourField, domField, dowField string, during [
 		return nil, false, CronError("DOW must be * when using L or LW in DOM")
 	}
 
-	minute, err := parseSingleValue(minuteField, "minute", 0, 59)
-	if err != nil {
-		return nil, false, err
-	}
-	hour, err := parseSingleValue(hourField, "hour", 0, 23)
+	times, err := parseCronTimes(minuteField, hourField)
 	if err != nil {
 		return nil, false, err
 	}
@@ -486,7 +589,7 @@ func tryParseLastDay(minuteField, hourField, domField, dowField string, during [
 		target = NewLastDayTarget()
 	}
 
-	schedule := NewScheduleData(NewMonthRepeat(1, target, []TimeOfDay{{hour, minute}}))
+	schedule := NewScheduleData(NewMonthRepeat(1, target, times))
 	schedule.During = during
 	return schedule, true, nil
 }
@@ -940,6 +1043,36 @@ func cronDOWToWeekday(n int) (Weekday, error) {
 	return wd, nil
 }
 
+// parseCronTimes parses the minute and hour fields, each a value or a comma-separated
+// list of values, into every combination of a listed hour and minute, in order.
+func parseCronTimes(minuteField, hourField string) ([]TimeOfDay, error) {
+	var minutes, hours []int
+	for _, f := range strings.Split(minuteField, ",") {
+		minute, err := parseSingleValue(f, "minute", 0, 59)
+		if err != nil {
+			return nil, err
+		}
+		minutes = append(minutes, minute)
+	}
+	for _, f := range strings.Split(hourField, ",") {
+		hour, err := parseSingleValue(f, "hour", 0, 23)
+		if err != nil {
+			return nil, err
+		}
+		hours = append(hours, hour)
+	}
+	slices.Sort(minutes)
+	slices.Sort(hours)
+
+	var times []TimeOfDay
+	for _, h := range slices.Compact(hours) {
+		for _, m := range slices.Compact(minutes) {
+			times = append(times, TimeOfDay{h, m})
+		}
+	}
+	return times, nil
+}
+
 // parseSingleValue parses a single numeric value with validation.
 func parseSingleValue(field, name string, min, max int) (int, error) {
 	value, err := strconv.Atoi(field)
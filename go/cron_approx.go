@@ -0,0 +1,414 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ToCronApprox converts a schedule to the closest 5-field cron expression, for schedules
+// ToCron rejects. Each warning states one way the cron differs from the schedule: runs
+// it adds, runs it drops, or a clause it ignores. A schedule ToCron accepts converts to
+// the same expression without warnings. Like ToCron, the timezone is not part of the
+// result, and the L, W, and # extensions may be used.
+//
+// Schedules without a usable approximation (second intervals, business days, event
+// dates, random picks, ...) still return a CronError.
+func ToCronApprox(schedule *ScheduleData) (string, []string, error) {
+	if cron, err := ToCron(schedule); err == nil {
+		return cron, nil, nil
+	}
+	a := &cronApprox{schedule: schedule}
+	cron, err := a.convert()
+	if err != nil {
+		return "", nil, err
+	}
+	return cron, a.warnings, nil
+}
+
+// ToCronApprox converts this schedule to the closest 5-field cron expression, with
+// warnings describing what differs; see the ToCronApprox function.
+func (s *Schedule) ToCronApprox() (string, []string, error) {
+	return ToCronApprox(s.data)
+}
+
+type cronApprox struct {
+	schedule *ScheduleData
+	warnings []string
+}
+
+func (a *cronApprox) lose(format string, args ...any) {
+	a.warnings = append(a.warnings, fmt.Sprintf(format, args...))
+}
+
+func (a *cronApprox) convert() (string, error) {
+	s := a.schedule
+	if usesSolar(s) {
+		return "", CronError("not expressible as cron (solar times move every day)")
+	}
+	if len(s.Timezones) > 1 {
+		return "", CronError("not expressible as cron (a cron runs in one timezone)")
+	}
+	if len(s.Except) > 0 {
+		a.lose("except %s dropped: also runs on those dates", displayExceptions(s.Except))
+	}
+	if s.Until != nil {
+		a.lose("until %s dropped: keeps running after it", displayUntil(*s.Until))
+	}
+	if starting := displayStarting(s); starting != "" {
+		a.lose("starting %s dropped: also runs before it", starting)
+	}
+	if s.Offset != (Offset{}) {
+		a.lose("%s dropped: runs at the unshifted times", displayOffset(s.Offset))
+	}
+
+	months, exact := cronDuringMonths(s)
+	if !exact {
+		a.lose("during %s approximated as %s", displayDuring(s), describeCronMonths(months))
+	}
+
+	expr := s.Expr
+	minute, hour, dom, dow := "*", "*", "*", "*"
+	switch expr.Kind {
+	case ScheduleExprKindInterval:
+		var err error
+		if minute, hour, err = a.intervalFields(expr); err != nil {
+			return "", err
+		}
+		if expr.DayFilter != nil {
+			dow = dayFilterToCronDOW(*expr.DayFilter)
+		}
+
+	case ScheduleExprKindDay:
+		dow = dayFilterToCronDOW(expr.Days)
+		if expr.Interval > 1 {
+			if expr.Days.Kind == DayFilterKindEvery {
+				dom = fmt.Sprintf("*/%d", expr.Interval)
+				a.lose("every %d days restarts on the 1st of each month", expr.Interval)
+			} else {
+				a.lose("runs on every matching day instead of every %d days", expr.Interval)
+			}
+		}
+
+	case ScheduleExprKindWeek:
+		dow = dayFilterToCronDOW(NewDayFilterDays(expr.WeekDays))
+		if expr.Interval > 1 {
+			a.lose("runs every week instead of every %d weeks", expr.Interval)
+		}
+
+	case ScheduleExprKindMonth:
+		var err error
+		if dom, dow, err = cronMonthTargetFields(expr.MonthTarget); err != nil {
+			return "", err
+		}
+		if expr.Interval > 1 {
+			months = intersectCronMonths(months, a.intervalMonths(expr.Interval))
+		}
+
+	case ScheduleExprKindYear:
+		if len(expr.YearTargets) > 1 {
+			var dates [][2]int
+			for _, target := range expr.YearTargets {
+				if target.Kind != YearTargetKindDate && target.Kind != YearTargetKindDayOfMonth {
+					return "", CronError("not expressible as cron (several yearly targets must all be dates)")
+				}
+				dates = append(dates, [2]int{target.Month.Number(), target.Day})
+			}
+			dom, months = a.dateFields(dates, months)
+			if expr.Interval > 1 {
+				a.lose("runs every year instead of every %d years", expr.Interval)
+			}
+			break
+		}
+		target := expr.YearTarget
+		switch target.Kind {
+		case YearTargetKindDayOfYear:
+			return "", CronError("not expressible as cron (days of the year fall on different dates in leap years)")
+		case YearTargetKindDate, YearTargetKindDayOfMonth:
+			dom = fmt.Sprint(target.Day)
+		case YearTargetKindLastWeekday:
+			dom = "LW"
+		case YearTargetKindOrdinalWeekday:
+			var err error
+			if dow, err = cronOrdinalWeekday(OrdinalWeekday{target.Ordinal, target.Weekday}); err != nil {
+				return "", err
+			}
+		}
+		months = intersectCronMonths(months, []int{target.Month.Number()})
+		if expr.Interval > 1 {
+			a.lose("runs every year instead of every %d years", expr.Interval)
+		}
+
+	case ScheduleExprKindSingleDate:
+		var dates [][2]int
+		var named bool
+		years := map[int]bool{}
+		for _, date := range expr.AllDateSpecs() {
+			switch date.Kind {
+			case DateSpecKindNamed:
+				dates = append(dates, [2]int{date.Month.Number(), date.Day})
+				named = true
+			case DateSpecKindISO:
+				d, err := parseISODate(date.Date)
+				if err != nil {
+					return "", CronError(fmt.Sprintf("invalid date: %s", date.Date))
+				}
+				dates = append(dates, [2]int{int(d.Month()), d.Day()})
+				years[d.Year()] = true
+			default:
+				return "", CronError("not expressible as cron (event and relative dates have no cron equivalent)")
+			}
+		}
+		dom, months = a.dateFields(dates, months)
+		if len(years) == 1 && !named {
+			for year := range years {
+				a.lose("repeats every year instead of only in %d", year)
+			}
+		} else {
+			a.lose("repeats every year instead of running once")
+		}
+
+	case ScheduleExprKindRandom:
+		return "", CronError("not expressible as cron (random picks have no cron equivalent)")
+
+	case ScheduleExprKindFiscal:
+		return "", CronError("not expressible as cron (fiscal periods follow the attached fiscal calendar)")
+	}
+
+	if expr.Kind != ScheduleExprKindInterval {
+		var extra []TimeOfDay
+		minute, hour, extra = cronTimeFields(expr.Times)
+		if len(extra) > 0 {
+			a.lose("also runs at %s", formatTimeList(extra))
+		}
+	}
+
+	if months != nil && len(months) == 0 {
+		return "", CronError("not expressible as cron (no month satisfies both the repeat and the during clause)")
+	}
+	month := "*"
+	if months != nil {
+		month = formatIntList(months)
+	}
+	return strings.Join([]string{minute, hour, dom, month, dow}, " "), nil
+}
+
+// intervalFields returns the minute and hour fields of an interval repeat's window.
+// Hour steps are exact; minute steps also run in the parts of the first and last hours
+// outside the window.
+func (a *cronApprox) intervalFields(expr ScheduleExpr) (string, string, error) {
+	from, to := expr.FromTime, expr.ToTime
+	if expr.Unit == IntervalSeconds {
+		return "", "", CronError("not expressible as cron (cron has no seconds field)")
+	}
+	if to.TotalMinutes() < from.TotalMinutes() {
+		return "", "", CronError("not expressible as cron (interval windows across midnight not supported)")
+	}
+
+	n := expr.Interval
+	if expr.Unit == IntervalHours {
+		minute, hour := cronHourWindow(from, to, n)
+		return minute, hour, nil
+	}
+
+	r := from.Minute % n
+	minute := fmt.Sprintf("*/%d", n)
+	if r != 0 {
+		minute = fmt.Sprintf("%d-59/%d", r, n)
+	}
+	if 60%n != 0 {
+		a.lose("every %d min restarts at the top of each hour", n)
+	}
+	if from.Minute > r {
+		a.lose("also runs before %s in its hour", from)
+	}
+	if lastSlot := r + (59-r)/n*n; lastSlot > to.Minute {
+		a.lose("also runs after %s in its hour", to)
+	}
+	return minute, cronRange(from.Hour, to.Hour, 1), nil
+}
+
+// intervalMonths returns the months a repeat every n months runs in, when that is the same
+// set each year.
+func (a *cronApprox) intervalMonths(n int) []int {
+	first := 0
+	if anchor := alignmentAnchor(a.schedule); anchor != "" {
+		if d, err := parseISODate(anchor); err == nil {
+			first = int(d.Month()-1) % n
+		}
+	}
+	if 12%n != 0 && a.schedule.Alignment != AlignmentYearStart {
+		a.lose("every %d months restarts each year", n)
+	}
+	var months []int
+	for m := first + 1; m <= 12; m += n {
+		months = append(months, m)
+	}
+	return months
+}
+
+// cronHourWindow returns the minute and hour fields of an hourly step through a window
+// that does not wrap past midnight. Every occurrence keeps the start's minute, so they
+// are exact.
+func cronHourWindow(from, to TimeOfDay, n int) (string, string) {
+	last := from.Hour + (to.Hour-from.Hour)/n*n
+	if last == to.Hour && from.Minute > to.Minute {
+		last -= n
+	}
+	return fmt.Sprint(from.Minute), cronRange(from.Hour, last, n)
+}
+
+// cronRange formats the hours or minutes from first to last, stepping by step.
+func cronRange(first, last, step int) string {
+	switch {
+	case first == last:
+		return fmt.Sprint(first)
+	case first == 0 && last+step >= 24 && step == 1:
+		return "*"
+	case first == 0 && last+step >= 24:
+		return fmt.Sprintf("*/%d", step)
+	case step == 1:
+		return fmt.Sprintf("%d-%d", first, last)
+	}
+	return fmt.Sprintf("%d-%d/%d", first, last, step)
+}
+
+// cronTimeFields returns minute and hour fields running at every listed time. Cron runs
+// at every combination of the two, so it also returns the combinations not listed.
+func cronTimeFields(times []TimeOfDay) (string, string, []TimeOfDay) {
+	var minutes, hours []int
+	for _, t := range times {
+		minutes = append(minutes, t.Minute)
+		hours = append(hours, t.Hour)
+	}
+	slices.Sort(minutes)
+	slices.Sort(hours)
+	minutes, hours = slices.Compact(minutes), slices.Compact(hours)
+
+	var extra []TimeOfDay
+	for _, h := range hours {
+		for _, m := range minutes {
+			if t := (TimeOfDay{h, m}); !slices.Contains(times, t) {
+				extra = append(extra, t)
+			}
+		}
+	}
+	return formatIntList(minutes), formatIntList(hours), extra
+}
+
+// cronDuringMonths returns the month numbers of the during clause, or nil for every
+// month. Exact is false when the clause has date windows, week ranges, or years that
+// months cannot express; the months then cover every date the clause allows.
+func cronDuringMonths(schedule *ScheduleData) ([]int, bool) {
+	if !hasDuringClause(schedule) {
+		return nil, true
+	}
+	exact := len(schedule.DuringDates) == 0 && len(schedule.DuringWeeks) == 0 && len(schedule.DuringYears) == 0
+	if len(schedule.DuringWeeks) > 0 {
+		return nil, false
+	}
+
+	var months []int
+	for _, m := range duringMonths(schedule) {
+		months = append(months, m.Number())
+	}
+	for _, w := range schedule.DuringDates {
+		for m := w.FromMonth.Number(); ; m = m%12 + 1 {
+			months = append(months, m)
+			if m == w.ToMonth.Number() {
+				break
+			}
+		}
+	}
+	if len(months) == 0 {
+		return nil, exact
+	}
+	slices.Sort(months)
+	return slices.Compact(months), exact
+}
+
+// intersectCronMonths intersects two month sets, where nil means every month.
+// dateFields returns the day-of-month field and months for several (month, day) dates,
+// which cron runs on every pairing of a listed day and month.
+func (a *cronApprox) dateFields(dates [][2]int, months []int) (string, []int) {
+	var days, dateMonths []int
+	unique := map[[2]int]bool{}
+	for _, date := range dates {
+		dateMonths = append(dateMonths, date[0])
+		days = append(days, date[1])
+		unique[date] = true
+	}
+	days = slices.Compact(slices.Sorted(slices.Values(days)))
+	dateMonths = slices.Compact(slices.Sorted(slices.Values(dateMonths)))
+	if len(days)*len(dateMonths) > len(unique) {
+		a.lose("also runs on every other pairing of the listed days and months")
+	}
+	return formatIntList(days), intersectCronMonths(months, dateMonths)
+}
+
+func intersectCronMonths(a, b []int) []int {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	out := []int{}
+	for _, m := range a {
+		if slices.Contains(b, m) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func describeCronMonths(months []int) string {
+	if months == nil {
+		return "every month"
+	}
+	names := make([]string, len(months))
+	for i, m := range months {
+		names[i] = MonthName(m).String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// cronMonthTargetFields returns the day-of-month and day-of-week fields of a month target.
+func cronMonthTargetFields(target MonthTarget) (string, string, error) {
+	switch target.Kind {
+	case MonthTargetKindDays:
+		days := target.ExpandDays()
+		slices.Sort(days)
+		return formatIntList(slices.Compact(days)), "*", nil
+	case MonthTargetKindLastDay:
+		return "L", "*", nil
+	case MonthTargetKindLastWeekday:
+		return "LW", "*", nil
+	case MonthTargetKindNearestWeekday:
+		if target.Direction != NearestNone {
+			return "", "", CronError("not expressible as cron (directional nearest weekday not supported)")
+		}
+		return fmt.Sprintf("%dW", target.Day), "*", nil
+	case MonthTargetKindOrdinalWeekday:
+		pairs := target.OrdinalWeekdays()
+		if len(pairs) > 1 {
+			return "", "", CronError("not expressible as cron (cron allows one ordinal weekday)")
+		}
+		dow, err := cronOrdinalWeekday(pairs[0])
+		return "*", dow, err
+	case MonthTargetKindWeekOfMonth:
+		return "", "", CronError("not expressible as cron (week of month not supported)")
+	}
+	return "", "", CronError("not expressible as cron (business days not supported)")
+}
+
+// cronOrdinalWeekday formats an ordinal weekday with the # and L extensions.
+func cronOrdinalWeekday(pair OrdinalWeekday) (string, error) {
+	switch {
+	case pair.Ordinal == Last:
+		return fmt.Sprintf("%dL", pair.Weekday.CronDOW()), nil
+	case pair.Ordinal.FromEnd():
+		return "", CronError(fmt.Sprintf("not expressible as cron (%s %s not supported)", pair.Ordinal, pair.Weekday))
+	}
+	return fmt.Sprintf("%d#%d", pair.Weekday.CronDOW(), pair.Ordinal.ToN()), nil
+}
@@ -0,0 +1,77 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToCronApprox(t *testing.T) {
+	cases := []struct {
+		expr     string
+		cron     string
+		warnings []string // Substrings, one per expected warning
+	}{
+		// Exact conversions match ToCron
+		{"every weekday at 09:00", "0 9 * * 1-5", nil},
+		{"every day at 09:00 during jan, feb", "0 9 * 1,2 *", nil},
+		{"every day at 09:00, 12:00, 17:00", "0 9,12,17 * * *", nil},
+		{"every 2 hours from 09:30 to 17:00", "30 9-15/2 * * *", nil},
+		{"every 15 min from 09:00 to 16:59 on weekdays", "*/15 9-16 * * 1-5", nil},
+		{"every month on the last day at 18:00", "0 18 L * *", nil},
+		{"every month on the second tuesday at 10:00", "0 10 * * 2#2", nil},
+		{"every quarter on the 1st at 06:00", "0 6 1 1,4,7,10 *", nil},
+		{"every year on mar 15 at 08:00", "0 8 15 3 *", nil},
+
+		// Lossy conversions
+		{"every day at 09:00, 17:30", "0,30 9,17 * * *", []string{"also runs at 09:30, 17:00"}},
+		{"every 2 weeks on monday at 09:00", "0 9 * * 1", []string{"every 2 weeks"}},
+		{"every 3 days at 09:00", "0 9 */3 * *", []string{"restarts on the 1st"}},
+		{"every 30 min from 09:10 to 17:00", "10-59/30 9-17 * * *", []string{"after 17:00"}},
+		{"every 45 min from 00:00 to 23:59", "*/45 * * * *", []string{"restarts at the top"}},
+		{"every day at 09:00 except dec 25 until 2027-01-01", "0 9 * * *", []string{"except dec 25", "until 2027-01-01"}},
+		{"every day at 06:00 during jun 15 to aug 31", "0 6 * 6,7,8 *", []string{"approximated as jun, jul, aug"}},
+		{"every weekday at 09:00 during weeks 10 to 20", "0 9 * * 1-5", []string{"approximated as every month"}},
+		{"on 2026-03-01 at 09:00", "0 9 1 3 *", []string{"only in 2026"}},
+		{"on 2026-03-01, 2026-06-01 at 09:00", "0 9 1 3,6 *", []string{"only in 2026"}},
+		{"on 2026-03-01, 2027-03-15 at 09:00", "0 9 1,15 3 *", []string{"running once"}},
+		{"every 2 years on jul 4 at 12:00", "0 12 4 7 *", []string{"every 2 years"}},
+		{"every jan 15 and jul 15 at 09:00", "0 9 15 1,7 *", nil},
+		{"every mar 1 and sep 15 at 09:00", "0 9 1,15 3,9 *", []string{"every other pairing"}},
+		{"every 5 months on the 1st at 00:00", "0 0 1 1,6,11 *", []string{"restarts each year"}},
+	}
+	for _, tc := range cases {
+		cron, warnings, err := MustParse(tc.expr).ToCronApprox()
+		if err != nil {
+			t.Errorf("%q ToCronApprox(): %v", tc.expr, err)
+			continue
+		}
+		if cron != tc.cron {
+			t.Errorf("%q ToCronApprox() = %q, want %q", tc.expr, cron, tc.cron)
+		}
+		if len(warnings) != len(tc.warnings) {
+			t.Errorf("%q ToCronApprox() warnings = %q, want %d", tc.expr, warnings, len(tc.warnings))
+			continue
+		}
+		for i, want := range tc.warnings {
+			if !strings.Contains(warnings[i], want) {
+				t.Errorf("%q ToCronApprox() warning %q, want it to mention %q", tc.expr, warnings[i], want)
+			}
+		}
+	}
+}
+
+func TestToCronApproxErrors(t *testing.T) {
+	for _, expr := range []string{
+		"every 30 seconds from 09:00 to 10:00",
+		"every month on the 3rd business day at 09:00",
+		"on easter at 09:00",
+		"every 30 min from 22:00 to 02:00 next day",
+		"every month on the first monday, third friday at 09:00",
+		`one random weekday each week at 09:00 seeded by "team"`,
+		"every year on mar 1 at 09:00 during jun",
+	} {
+		if cron, _, err := MustParse(expr).ToCronApprox(); err == nil {
+			t.Errorf("%q ToCronApprox() = %q, want error", expr, cron)
+		}
+	}
+}
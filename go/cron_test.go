@@ -0,0 +1,238 @@
+package hron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// toCronExtensions are the spec's ToCron error cases that the Go ToCron converts, with
+// the cron each gives. The conversions are a Go-only extension: other implementations
+// reject these inputs as the spec says, so the conformance test skips them.
+var toCronExtensions = map[string]string{
+	"during_single":    "0 9 * 1 *",
+	"during_multiple":  "0 9 * 1,6 1-5",
+	"day_range_during": "0 9 1,2,3,4,5 1 *",
+
+	"multi_time_day":       "0 9,17 * * *",
+	"multi_time_weekday":   "0 9,17 * * 1-5",
+	"multi_time_month":     "0 9,17 1 * *",
+	"day_range_multi_time": "0 9,17 1,2,3,4,5 * *",
+}
+
+func TestToCronExtensions(t *testing.T) {
+	spec := loadSpec(t)
+	found := 0
+	for _, tc := range spec.Cron.ToCronErrors.Tests {
+		want, ok := toCronExtensions[tc.Name]
+		if !ok {
+			continue
+		}
+		found++
+		if got, err := MustParse(tc.Hron).ToCron(); err != nil || got != want {
+			t.Errorf("%s: ToCron(%q) = %q, %v, want %q", tc.Name, tc.Hron, got, err, want)
+		}
+	}
+	if found != len(toCronExtensions) {
+		t.Errorf("found %d of the %d extension cases among the spec's ToCron errors", found, len(toCronExtensions))
+	}
+}
+
+func TestToCronDuring(t *testing.T) {
+	cases := []struct {
+		expr string
+		cron string
+	}{
+		{"every day at 09:00 during jan, feb", "0 9 * 1,2 *"},
+		{"every weekday at 09:00 during dec, jan", "0 9 * 1,12 1-5"},
+		{"every 15 min from 00:00 to 23:59 during jun", "*/15 * * 6 *"},
+		{"every 2 hours from 00:00 to 23:59 during jul, aug", "0 */2 * 7,8 *"},
+		{"every month on the 15th at 12:00 during mar", "0 12 15 3 *"},
+		{"every month on the nearest weekday to 1st at 08:00 during sep", "0 8 1W 9 *"},
+	}
+	for _, tc := range cases {
+		s := MustParse(tc.expr)
+		cron, err := s.ToCron()
+		if err != nil {
+			t.Errorf("%q ToCron(): %v", tc.expr, err)
+			continue
+		}
+		if cron != tc.cron {
+			t.Errorf("%q ToCron() = %q, want %q", tc.expr, cron, tc.cron)
+			continue
+		}
+		back, err := FromCronExpr(cron)
+		if err != nil {
+			t.Errorf("FromCron(%q): %v", cron, err)
+			continue
+		}
+		if got, _ := back.ToCron(); got != cron {
+			t.Errorf("%q round trip: %q -> %q -> %q", tc.expr, cron, back, got)
+		}
+	}
+
+	for _, expr := range []string{
+		"every day at 09:00 during jun 15 to aug 31",
+		"every weekday at 09:00 during weeks 10 to 20",
+	} {
+		if cron, err := MustParse(expr).ToCron(); err == nil {
+			t.Errorf("%q ToCron() = %q, want error", expr, cron)
+		}
+	}
+}
+
+func TestToCronMultipleTimes(t *testing.T) {
+	cases := []struct {
+		expr string
+		cron string
+	}{
+		{"every day at 09:00, 12:00, 17:00", "0 9,12,17 * * *"},
+		{"every weekday at 09:00, 09:15, 09:45", "0,15,45 9 * * 1-5"},
+		{"every day at 17:00, 09:00", "0 9,17 * * *"},
+		{"every day at 08:00, 08:30, 20:00, 20:30", "0,30 8,20 * * *"},
+		{"every month on the 1st, 15th at 06:00, 18:00", "0 6,18 1,15 * *"},
+	}
+	for _, tc := range cases {
+		s := MustParse(tc.expr)
+		cron, err := s.ToCron()
+		if err != nil {
+			t.Errorf("%q ToCron(): %v", tc.expr, err)
+			continue
+		}
+		if cron != tc.cron {
+			t.Errorf("%q ToCron() = %q, want %q", tc.expr, cron, tc.cron)
+			continue
+		}
+		back, err := FromCronExpr(cron)
+		if err != nil {
+			t.Errorf("FromCron(%q): %v", cron, err)
+			continue
+		}
+		if got, _ := back.ToCron(); got != cron {
+			t.Errorf("%q round trip: %q -> %q -> %q", tc.expr, cron, back, got)
+		}
+	}
+
+	for _, expr := range []string{
+		"every day at 09:00, 17:30",
+		"every month on the 1st at 08:00, 08:30, 20:00",
+	} {
+		if cron, err := MustParse(expr).ToCron(); err == nil {
+			t.Errorf("%q ToCron() = %q, want error", expr, cron)
+		}
+	}
+}
+
+func TestFromCronTimeLists(t *testing.T) {
+	cases := []struct {
+		cron string
+		hron string
+	}{
+		{"0,30 9,10 * * *", "every day at 09:00, 09:30, 10:00, 10:30"},
+		{"0 17,9 * * 1-5", "every weekday at 09:00, 17:00"},
+		{"15 8,8,12 1,15 * *", "every month on the 1st, 15th at 08:15, 12:15"},
+		{"0 9,17 * * 1#1", "every month on the first monday at 09:00, 17:00"},
+		{"0 9,17 L * *", "every month on the last day at 09:00, 17:00"},
+	}
+	for _, tc := range cases {
+		s, err := FromCronExpr(tc.cron)
+		if err != nil {
+			t.Errorf("FromCron(%q): %v", tc.cron, err)
+			continue
+		}
+		if got := s.String(); got != tc.hron {
+			t.Errorf("FromCron(%q) = %q, want %q", tc.cron, got, tc.hron)
+		}
+	}
+
+	for _, cron := range []string{"0 9, * * *", "0,60 9 * * *", "0 9,24 * * *"} {
+		if s, err := FromCronExpr(cron); err == nil {
+			t.Errorf("FromCron(%q) = %q, want error", cron, s)
+		}
+	}
+}
+
+func TestCronNearestWeekday(t *testing.T) {
+	cases := []struct {
+		cron  string
+		hron  string
+		after time.Time
+		want  time.Time
+	}{
+		// 2026-04-15 is a Wednesday
+		{"0 9 15W * *", "every month on the nearest weekday to 15th at 09:00",
+			time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 15, 9, 0, 0, 0, time.UTC)},
+		// 2026-08-01 is a Saturday: Monday the 3rd, not Friday in July
+		{"0 9 1W * *", "every month on the nearest weekday to 1st at 09:00",
+			time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)},
+		// April has no 31st; 2026-05-31 is a Sunday: Friday the 29th, not Monday in June
+		{"0 17 31W * *", "every month on the nearest weekday to 31st at 17:00",
+			time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 29, 17, 0, 0, 0, time.UTC)},
+		// LW is the last weekday, not the nearest weekday to the last day
+		{"0 9 LW * *", "every month on the last weekday at 09:00",
+			time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 29, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		s, err := FromCronExpr(tc.cron)
+		if err != nil {
+			t.Errorf("FromCron(%q): %v", tc.cron, err)
+			continue
+		}
+		if got := s.String(); got != tc.hron {
+			t.Errorf("FromCron(%q) = %q, want %q", tc.cron, got, tc.hron)
+		}
+		if got := MustParse(tc.hron + " in UTC").NextFrom(tc.after); got == nil || !got.Equal(tc.want) {
+			t.Errorf("%q NextFrom(%v) = %v, want %v", tc.hron, tc.after, got, tc.want)
+		}
+		// ToCron has no L extension, so only W round-trips
+		if tc.cron == "0 9 LW * *" {
+			continue
+		}
+		if back, err := s.ToCron(); err != nil || back != tc.cron {
+			t.Errorf("FromCron(%q).ToCron() = %q, %v", tc.cron, back, err)
+		}
+	}
+
+	for _, cron := range []string{"0 9 0W * *", "0 9 32W * *", "0 9 15W * 1", "0 9 xW * *"} {
+		if _, err := FromCronExpr(cron); err == nil {
+			t.Errorf("FromCron(%q) should fail", cron)
+		}
+	}
+}
+
+func TestFromCronDays(t *testing.T) {
+	cases := []struct {
+		cron  string
+		match CronDayMatch
+		want  []string
+	}{
+		{"0 9 1 * 1", CronDayEither, []string{"every month on the 1st at 09:00", "every monday at 09:00"}},
+		{"30 8 1,15 1 mon-fri", CronDayEither, []string{"every month on the 1st, 15th at 08:30 during jan", "every weekday at 08:30 during jan"}},
+		{"0 9 1-7 * 1", CronDayBoth, []string{"every month on the first monday at 09:00"}},
+		{"0 17 22-28 * fri", CronDayBoth, []string{"every month on the fourth friday at 17:00"}},
+		{"0 9 15 * ?", CronDayBoth, []string{"every month on the 15th at 09:00"}},
+	}
+	for _, tc := range cases {
+		schedules, err := FromCronExprDays(tc.cron, tc.match)
+		if err != nil {
+			t.Errorf("FromCronDays(%q): %v", tc.cron, err)
+			continue
+		}
+		var got []string
+		for _, s := range schedules {
+			got = append(got, s.String())
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("FromCronDays(%q, %d) = %q, want %q", tc.cron, tc.match, got, tc.want)
+		}
+	}
+
+	for _, cron := range []string{"0 9 1-10 * 1", "0 9 2-8 * 1", "0 9 1-7 * 1,3", "0 9 25-31 * 5"} {
+		if _, err := FromCronDays(cron, CronDayBoth); err == nil {
+			t.Errorf("FromCronDays(%q, CronDayBoth) should fail", cron)
+		}
+	}
+	if _, err := FromCron("0 9 1 * 1"); err == nil {
+		t.Error("FromCron should reject a cron restricting both day fields")
+	}
+}
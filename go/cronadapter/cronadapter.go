@@ -0,0 +1,52 @@
+// Package cronadapter lets hron schedules drive github.com/robfig/cron. A *Schedule
+// implements that library's cron.Schedule interface, so an hron expression can replace a
+// cron spec without changing the scheduler:
+//
+//	s, err := cronadapter.Parse("every weekday at 09:00 except dec 25 in America/New_York")
+//	if err != nil { ... }
+//	c := cron.New()
+//	c.Schedule(s, cron.FuncJob(sendReport))
+//
+// The interface is satisfied structurally, so this package does not depend on robfig/cron.
+package cronadapter
+
+import (
+	"time"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// Schedule adapts an hron schedule to robfig/cron's cron.Schedule interface.
+type Schedule struct {
+	schedule *hron.Schedule
+}
+
+// New adapts schedule.
+func New(schedule *hron.Schedule) *Schedule {
+	return &Schedule{schedule: schedule}
+}
+
+// Parse parses an hron expression and adapts it.
+func Parse(expr string) (*Schedule, error) {
+	s, err := hron.ParseSchedule(expr)
+	if err != nil {
+		return nil, err
+	}
+	return New(s), nil
+}
+
+// Next returns the next occurrence strictly after t, in t's location as robfig/cron
+// expects. It returns the zero time when there is none, which robfig/cron treats as
+// never running again.
+func (s *Schedule) Next(t time.Time) time.Time {
+	next := s.schedule.NextFrom(t)
+	if next == nil {
+		return time.Time{}
+	}
+	return next.In(t.Location())
+}
+
+// Hron returns the adapted schedule.
+func (s *Schedule) Hron() *hron.Schedule {
+	return s.schedule
+}
@@ -0,0 +1,47 @@
+package cronadapter
+
+import (
+	"testing"
+	"time"
+)
+
+// cronSchedule mirrors robfig/cron's cron.Schedule interface.
+type cronSchedule interface {
+	Next(time.Time) time.Time
+}
+
+var _ cronSchedule = (*Schedule)(nil)
+
+func TestNext(t *testing.T) {
+	s, err := Parse("every weekday at 09:00 in America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+
+	// Friday evening in UTC; the next run is Monday morning in New York
+	from := time.Date(2026, 2, 6, 23, 0, 0, 0, time.UTC)
+	next := s.Next(from)
+	if want := time.Date(2026, 2, 9, 9, 0, 0, 0, ny); !next.Equal(want) {
+		t.Errorf("Next() = %v, want %v", next, want)
+	}
+	if next.Location() != time.UTC {
+		t.Errorf("Next() location = %v, want the argument's UTC", next.Location())
+	}
+}
+
+func TestNextAfterEnd(t *testing.T) {
+	s, err := Parse("on 2026-02-14 at 14:00 in UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := s.Next(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Errorf("Next() after the last occurrence = %v, want zero time", next)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	if _, err := Parse("every blursday"); err == nil {
+		t.Error("Parse() succeeded, want error")
+	}
+}
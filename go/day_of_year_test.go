@@ -0,0 +1,61 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayOfYear(t *testing.T) {
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"every year on the 256th day at 09:00 in UTC", []string{"2026-09-13", "2027-09-13", "2028-09-12"}},
+		{"every year on the 60th day at 09:00 in UTC", []string{"2026-03-01", "2027-03-01", "2028-02-29"}},
+		{"every year on the 366th day at 09:00 in UTC", []string{"2028-12-31", "2032-12-31", "2036-12-31"}},
+		{"every 2 years on the 1st day, jul 1 at 09:00 in UTC", []string{"2026-07-01", "2028-01-01", "2028-07-01"}},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr)
+		got := s.NextNFrom(from, 3)
+		if len(got) != len(tt.want) {
+			t.Errorf("%q NextNFrom = %v, want %v", tt.expr, got, tt.want)
+			continue
+		}
+		for i, want := range tt.want {
+			if d := got[i].Format(time.DateOnly); d != want {
+				t.Errorf("%q NextNFrom[%d] = %s, want %s", tt.expr, i, d, want)
+			}
+			if !s.Matches(got[i]) {
+				t.Errorf("%q does not match its occurrence %v", tt.expr, got[i])
+			}
+		}
+		if prev := s.PreviousFrom(got[2]); prev == nil || !prev.Equal(got[1]) {
+			t.Errorf("%q PreviousFrom(%v) = %v, want %v", tt.expr, got[2], prev, got[1])
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("%q: %v", tt.expr, err)
+		}
+	}
+
+	s := MustParse("every year on the 256th day at 09:00")
+	if got := s.String(); got != "every year on the 256th day at 09:00" {
+		t.Errorf("String() = %q", got)
+	}
+	if got, want := s.Describe(), "Runs at 9:00 AM on the 256th day of the year every year"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if _, _, err := s.ToCronApprox(); err == nil {
+		t.Error("ToCronApprox accepted a day of the year")
+	}
+	for _, input := range []string{
+		"every year on the 0th day at 09:00",
+		"every year on the 367th day at 09:00",
+		"every year on the 32nd of jan at 09:00",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", input)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayRangeParse(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every monday to thursday at 08:00", "every monday, tuesday, wednesday, thursday at 08:00"},
+		{"every 2 weeks on tue to fri at 10:00", "every 2 weeks on tuesday, wednesday, thursday, friday at 10:00"},
+		{"every friday to monday at 08:00", "every friday, saturday, sunday, monday at 08:00"},
+		{"every monday, wednesday to thursday at 08:00", "every monday, wednesday, thursday at 08:00"},
+		{"every 30 min from 09:00 to 17:00 on mon to wed", "every 30 min from 09:00 to 17:00 on monday, tuesday, wednesday"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDayRangeErrors(t *testing.T) {
+	for _, input := range []string{
+		"every monday to monday at 08:00",
+		"every monday to at 08:00",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestDayRangeEval(t *testing.T) {
+	s := MustParse("every monday to thursday at 08:00 in UTC")
+	// 2026-02-12 is a thursday
+	next := s.NextFrom(time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 2, 16, 8, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom thursday = %v, want %v", next, want)
+	}
+}
@@ -0,0 +1,114 @@
+package hron
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Warning is a non-fatal diagnostic produced while parsing an otherwise valid expression.
+type Warning struct {
+	Message    string
+	Span       Span
+	Input      string
+	Suggestion string // The full expression rewritten with the replacement form
+}
+
+// deprecations maps deprecated keyword spellings to their replacements, registered
+// with DeprecateKeyword. Deprecated forms keep parsing (so stored expressions stay valid
+// during the migration window) but every use is reported as a Warning.
+var deprecations = struct {
+	sync.RWMutex
+	forms map[string]string
+}{forms: map[string]string{}}
+
+// DeprecateKeyword marks word as a deprecated spelling of replacement: expressions using
+// it still parse, but ParseWithWarnings, Schedule.Warnings, and Lint report each use, and
+// MigrateExpressions rewrites it. Both must be keywords the lexer reads as the same
+// token, such as hrs and hours, so the rewrite never changes a schedule. It is safe for
+// concurrent use, and is meant to be called once at startup.
+func DeprecateKeyword(word, replacement string) error {
+	word, replacement = strings.ToLower(word), strings.ToLower(replacement)
+	tok, ok := keywordMap[word]
+	if !ok {
+		return fmt.Errorf("'%s' is not a keyword", word)
+	}
+	if tok != keywordMap[replacement] || word == replacement {
+		return fmt.Errorf("'%s' is not another spelling of '%s'", replacement, word)
+	}
+	deprecations.Lock()
+	defer deprecations.Unlock()
+	deprecations.forms[word] = replacement
+	return nil
+}
+
+// undeprecateKeyword removes the deprecation of word, for tests.
+func undeprecateKeyword(word string) {
+	deprecations.Lock()
+	defer deprecations.Unlock()
+	delete(deprecations.forms, word)
+}
+
+// WithDeprecationHook makes ParseSchedule and ParseWithWarnings call fn with every
+// deprecated form they find, so services can count or log deprecated usage without
+// threading warnings through callers.
+func WithDeprecationHook(fn func(Warning)) ParseOption {
+	return func(c *parseConfig) { c.onDeprecation = fn }
+}
+
+// ParseWithWarnings parses an hron expression and also returns warnings for deprecated forms.
+func ParseWithWarnings(input string, opts ...ParseOption) (*ScheduleData, []Warning, error) {
+	c := newParseConfig(opts)
+	data, err := Parse(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	warnings := deprecationWarnings(input)
+	if c.normalize {
+		data = Normalize(data)
+	}
+	if c.onDeprecation != nil {
+		for _, w := range warnings {
+			c.onDeprecation(w)
+		}
+	}
+	return data, warnings, nil
+}
+
+// Warnings returns the deprecation warnings collected when the schedule was parsed.
+func (s *Schedule) Warnings() []Warning {
+	return s.warnings
+}
+
+// deprecationWarnings scans the tokens of a successfully parsed input for deprecated forms.
+func deprecationWarnings(input string) []Warning {
+	deprecations.RLock()
+	defer deprecations.RUnlock()
+	if len(deprecations.forms) == 0 {
+		return nil
+	}
+	tokens, err := Tokenize(input)
+	if err != nil {
+		return nil
+	}
+
+	var warnings []Warning
+	for _, tok := range tokens {
+		if tok.Kind == TokenTimezone || tok.Kind == TokenString {
+			continue
+		}
+		word := strings.ToLower(input[tok.Span.Start:tok.Span.End])
+		replacement, ok := deprecations.forms[word]
+		if !ok {
+			continue
+		}
+		w := Warning{
+			Message:    fmt.Sprintf("'%s' is deprecated, use '%s' instead", word, replacement),
+			Span:       tok.Span,
+			Input:      input,
+			Suggestion: input[:tok.Span.Start] + replacement + input[tok.Span.End:],
+		}
+		warnings = append(warnings, w)
+	}
+	return warnings
+}
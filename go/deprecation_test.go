@@ -0,0 +1,66 @@
+package hron
+
+import (
+	"testing"
+)
+
+// deprecateHrs deprecates hrs for hours until the test ends.
+func deprecateHrs(t *testing.T) {
+	t.Helper()
+	if err := DeprecateKeyword("hrs", "hours"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { undeprecateKeyword("hrs") })
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	deprecateHrs(t)
+
+	var hooked []Warning
+	s, err := ParseSchedule("every 2 hrs from 09:00 to 17:00", WithDeprecationHook(func(w Warning) { hooked = append(hooked, w) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	warnings := s.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Warnings() = %v, want 1 warning", warnings)
+	}
+	if warnings[0].Span != (Span{8, 11}) {
+		t.Errorf("Span = %v, want {8 11}", warnings[0].Span)
+	}
+	if warnings[0].Suggestion != "every 2 hours from 09:00 to 17:00" {
+		t.Errorf("Suggestion = %q", warnings[0].Suggestion)
+	}
+	if len(hooked) != 1 {
+		t.Errorf("hook called %d times, want 1", len(hooked))
+	}
+
+	findings := Lint("every 2 hrs from 09:00 to 17:00 until 2026-12-31")
+	if len(findings) != 1 || findings[0].Rule != "deprecated" || findings[0].Fix != "every 2 hours from 09:00 to 17:00 until 2026-12-31" {
+		t.Errorf("Lint = %+v, want one deprecated finding", findings)
+	}
+}
+
+func TestDeprecateKeywordRejects(t *testing.T) {
+	for _, pair := range [][2]string{
+		{"hourz", "hours"},
+		{"hrs", "minutes"},
+		{"hrs", "hrs"},
+		{"hrs", "every"},
+	} {
+		if err := DeprecateKeyword(pair[0], pair[1]); err == nil {
+			undeprecateKeyword(pair[0])
+			t.Errorf("DeprecateKeyword(%q, %q) should fail", pair[0], pair[1])
+		}
+	}
+}
+
+func TestNoWarningsForCurrentForms(t *testing.T) {
+	_, warnings, err := ParseWithWarnings("every 2 hours from 09:00 to 17:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v, want none", warnings)
+	}
+}
@@ -0,0 +1,89 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// InTimezone returns a copy of the schedule whose in clause names the given IANA
+// timezone, or `local`. Unlike WithTimezone, which binds an `in local` schedule without
+// changing it, this changes the expression itself and so its canonical string.
+func (s *Schedule) InTimezone(name string) (*Schedule, error) {
+	return s.derive(func(data *ScheduleData) {
+		data.Timezone, data.Timezones = name, nil
+	})
+}
+
+// WithUntil returns a copy of the schedule whose until clause is until, replacing any
+// it had.
+func (s *Schedule) WithUntil(until UntilSpec) (*Schedule, error) {
+	return s.derive(func(data *ScheduleData) {
+		data.Until = &until
+	})
+}
+
+// WithExcept returns a copy of the schedule whose except clause lists exceptions,
+// replacing any it had; no exceptions drops the clause. To add a blackout date, pass
+// the schedule's Except with it appended.
+func (s *Schedule) WithExcept(exceptions ...ExceptionSpec) (*Schedule, error) {
+	return s.derive(func(data *ScheduleData) {
+		data.Except = slices.Clone(exceptions)
+	})
+}
+
+// WithTimes returns a copy of the schedule running at times instead of its own times or
+// solar event. Interval repeats, which have no times of day, return an EvalError.
+func (s *Schedule) WithTimes(times ...TimeOfDay) (*Schedule, error) {
+	if s.data.Expr.Kind == ScheduleExprKindInterval {
+		return nil, EvalError("WithTimes requires a schedule with times of day, not an interval repeat")
+	}
+	if len(times) == 0 {
+		return nil, EvalError("WithTimes requires at least one time of day")
+	}
+	return s.derive(func(data *ScheduleData) {
+		data.Expr.Times, data.Expr.Solar = slices.Clone(times), 0
+	})
+}
+
+// Shifted returns a copy of the schedule with every occurrence moved by d, earlier when
+// d is negative, for reminders ahead of a deadline. The shift is added to the schedule's
+// plus or minus clause; d must be a whole number of seconds.
+func (s *Schedule) Shifted(d time.Duration) (*Schedule, error) {
+	offset, ok := offsetOf(s.data.Offset.Duration() + d)
+	if !ok {
+		return nil, EvalError(fmt.Sprintf("cannot shift by %v: not a whole number of seconds", d))
+	}
+	return s.derive(func(data *ScheduleData) {
+		data.Offset = offset
+	})
+}
+
+// derive returns a copy of the schedule with change applied to a copy of its data. The
+// result is validated by parsing its canonical string, so a derived schedule is one an
+// expression could have written. It keeps the calendar, search bounds, reference time,
+// filter, and bound timezone of the original, but not its deprecation warnings.
+func (s *Schedule) derive(change func(*ScheduleData)) (*Schedule, error) {
+	data := *s.data
+	change(&data)
+	reparsed, err := Parse(Display(&data))
+	if err != nil {
+		return nil, err
+	}
+
+	c := *s
+	c.data = reparsed
+	c.warnings = nil
+	if !slices.Equal(reparsed.AllTimezones(), s.data.AllTimezones()) {
+		c.tzName, c.location = reparsed.Timezone, nil
+		if reparsed.Timezone != LocalTimezone {
+			if c.location, err = resolveTimezone(reparsed.Timezone); err != nil {
+				return nil, err
+			}
+		}
+		if c.zones, err = resolveZones(reparsed); err != nil {
+			return nil, err
+		}
+	}
+	return c.withPlan(), nil
+}
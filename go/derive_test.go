@@ -0,0 +1,68 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeriveSchedules(t *testing.T) {
+	base := MustParse("every weekday at 09:00 except dec 25 in UTC")
+
+	tests := []struct {
+		name   string
+		derive func(*Schedule) (*Schedule, error)
+		want   string
+	}{
+		{"timezone", func(s *Schedule) (*Schedule, error) { return s.InTimezone("Europe/Berlin") }, "every weekday at 09:00 except dec 25 in Europe/Berlin"},
+		{"local", func(s *Schedule) (*Schedule, error) { return s.InTimezone(LocalTimezone) }, "every weekday at 09:00 except dec 25 in local"},
+		{"until", func(s *Schedule) (*Schedule, error) { return s.WithUntil(NewISOUntil("2027-01-01")) }, "every weekday at 09:00 except dec 25 until 2027-01-01 in UTC"},
+		{"append exception", func(s *Schedule) (*Schedule, error) {
+			return s.WithExcept(append(s.Except(), NewISOException("2026-12-24"))...)
+		}, "every weekday at 09:00 except dec 25, 2026-12-24 in UTC"},
+		{"drop exceptions", func(s *Schedule) (*Schedule, error) { return s.WithExcept() }, "every weekday at 09:00 in UTC"},
+		{"times", func(s *Schedule) (*Schedule, error) { return s.WithTimes(TimeOfDay{8, 0}, TimeOfDay{17, 30}) }, "every weekday at 08:00, 17:30 except dec 25 in UTC"},
+	}
+	for _, tt := range tests {
+		got, err := tt.derive(base)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got.String(), tt.want)
+		}
+	}
+	if base.String() != "every weekday at 09:00 except dec 25 in UTC" {
+		t.Errorf("deriving changed the original schedule: %q", base.String())
+	}
+
+	// The derived schedule evaluates with its new data
+	berlin, _ := base.InTimezone("Europe/Berlin")
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	if next, _ := berlin.NextTime(now); !next.Equal(time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("InTimezone: NextTime = %v, want 08:00 UTC", next)
+	}
+	blackout, _ := base.WithExcept(NewISOException("2026-03-10"))
+	if next, _ := blackout.NextTime(now); !next.Equal(time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("WithExcept: NextTime = %v, want the day after the blackout", next)
+	}
+}
+
+func TestDeriveSchedulesErrors(t *testing.T) {
+	base := MustParse("every weekday at 09:00 in UTC")
+	if _, err := base.InTimezone("Not/AZone"); err == nil {
+		t.Error("InTimezone accepted an unknown timezone")
+	}
+	if _, err := base.WithExcept(NewISOException("2026-02-30")); err == nil {
+		t.Error("WithExcept accepted an invalid date")
+	}
+	if _, err := base.WithTimes(); err == nil {
+		t.Error("WithTimes accepted no times")
+	}
+	if _, err := base.WithTimes(TimeOfDay{25, 0}); err == nil {
+		t.Error("WithTimes accepted an invalid time")
+	}
+	if _, err := MustParse("every 30 min from 09:00 to 17:00").WithTimes(TimeOfDay{9, 0}); err == nil {
+		t.Error("WithTimes accepted an interval repeat")
+	}
+}
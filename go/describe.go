@@ -0,0 +1,414 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Describe renders the schedule as a verbose English sentence for confirming intent in
+// user interfaces, e.g. "Runs at 9:00 AM on the first Monday of each month, except
+// December 25, in New York time". Unlike Display, the result is not parseable.
+func Describe(schedule *ScheduleData) string {
+	return describer{englishCatalog}.describe(schedule)
+}
+
+// DescribeIn renders the schedule like Describe in the given language. Catalogs are
+// built in for "en", "es", "de", and "fr".
+func DescribeIn(schedule *ScheduleData, locale string) (string, error) {
+	c, ok := describeCatalogs[locale]
+	if !ok {
+		return "", EvalError(fmt.Sprintf("no description catalog for locale: %s", locale))
+	}
+	return describer{c}.describe(schedule), nil
+}
+
+// Describe renders the schedule as a verbose English sentence; see the Describe function.
+func (s *Schedule) Describe() string {
+	return Describe(s.data)
+}
+
+// DescribeIn renders the schedule as a sentence in the given language; see the DescribeIn function.
+func (s *Schedule) DescribeIn(locale string) (string, error) {
+	return DescribeIn(s.data, locale)
+}
+
+// describer builds descriptions from the messages of one catalog.
+type describer struct {
+	c *describeCatalog
+}
+
+func (d describer) describe(schedule *ScheduleData) string {
+	parts := []string{d.msg("runs", d.expr(schedule.Expr))}
+
+	if o := schedule.Offset; o.Amount < 0 {
+		parts = append(parts, d.msg("offset.earlier", d.count("span."+o.Unit.String(), -o.Amount)))
+	} else if o.Amount > 0 {
+		parts = append(parts, d.msg("offset.later", d.count("span."+o.Unit.String(), o.Amount)))
+	}
+
+	if schedule.Alignment != AlignmentDefault {
+		parts = append(parts, d.msg("aligned", d.msg("align."+schedule.Alignment.String())))
+	}
+	if len(schedule.Except) > 0 {
+		excepts := make([]string, len(schedule.Except))
+		for i, exc := range schedule.Except {
+			switch exc.Kind {
+			case ExceptionSpecKindNamed:
+				excepts[i] = d.monthDay(exc.Month, exc.Day)
+			case ExceptionSpecKindISO:
+				excepts[i] = d.isoDate(exc.Date)
+			case ExceptionSpecKindHolidays:
+				excepts[i] = d.msg("holidays")
+			}
+		}
+		parts = append(parts, d.msg("except", d.c.list(excepts)))
+	}
+	if until := schedule.Until; until != nil {
+		var part string
+		switch until.Kind {
+		case UntilSpecKindISO:
+			part = d.msg("until", d.isoDate(until.Date))
+		case UntilSpecKindRelative:
+			part = d.relative("until", until.Relative)
+		default:
+			part = d.msg("until", d.monthDay(until.Month, until.Day))
+		}
+		if until.Time != nil {
+			part += " " + d.msg("at", d.times([]TimeOfDay{*until.Time}))
+		}
+		parts = append(parts, part)
+	}
+	if schedule.AnchorRelative != nil {
+		parts = append(parts, d.relative("starting", *schedule.AnchorRelative))
+	} else if schedule.Anchor != "" {
+		parts = append(parts, d.msg("starting", d.isoDate(schedule.Anchor)))
+	}
+	if during := d.during(schedule); during != "" {
+		parts = append(parts, d.msg("during", during))
+	}
+	if len(schedule.DuringYears) > 0 {
+		parts = append(parts, d.years(schedule.DuringYears))
+	}
+	if zones := schedule.AllTimezones(); len(zones) > 0 {
+		names := make([]string, len(zones))
+		for i, tz := range zones {
+			names[i] = d.timezone(tz)
+		}
+		parts = append(parts, d.msg("in", d.c.list(names)))
+	}
+	out := strings.Join(parts, ", ")
+	if d.c.elide != nil {
+		out = d.c.elide(out)
+	}
+	return out
+}
+
+// msg formats a catalog message, falling back to English for messages a catalog lacks.
+func (d describer) msg(id string, args ...any) string {
+	format, ok := d.c.messages[id]
+	if !ok {
+		format = englishCatalog.messages[id]
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// count picks the ".one" or ".other" form of a message for n.
+func (d describer) count(id string, n int) string {
+	if n == 1 {
+		return d.msg(id + ".one")
+	}
+	return d.msg(id+".other", n)
+}
+
+func (d describer) expr(expr ScheduleExpr) string {
+	at := d.msg("at", d.times(expr.Times))
+	if expr.Solar != 0 {
+		at = d.msg("solar." + expr.Solar.String())
+	}
+	switch expr.Kind {
+	case ScheduleExprKindInterval:
+		out := d.msg("expr.interval", d.count("unit."+expr.Unit.String(), expr.Interval),
+			d.c.time(expr.FromTime), d.c.time(expr.ToTime))
+		if expr.DayFilter != nil {
+			out += " " + d.dayFilter(*expr.DayFilter)
+		}
+		return out
+	case ScheduleExprKindDay:
+		if expr.Interval > 1 {
+			return d.msg("expr.day", at, d.msg("day.every_n", expr.Interval))
+		}
+		return d.msg("expr.day", at, d.dayFilter(expr.Days))
+	case ScheduleExprKindWeek:
+		return d.msg("expr.week", at, d.c.list(d.weekdays(expr.WeekDays)), d.count("week", expr.Interval))
+	case ScheduleExprKindMonth:
+		period := d.count("month", expr.Interval)
+		switch expr.MonthPeriod {
+		case MonthPeriodQuarter:
+			period = d.count("quarter", expr.Interval/3)
+		case MonthPeriodHalfYear:
+			period = d.msg("half_year")
+		}
+		if expr.MonthTarget.Kind == MonthTargetKindWeekOfMonth {
+			return d.msg("expr.month_week", at, d.c.list(d.weekdays(expr.MonthTarget.WeekDays)),
+				d.c.ordinals[expr.MonthTarget.Ordinal], period)
+		}
+		return d.msg("expr.month", at, d.monthTarget(expr.MonthTarget), period)
+	case ScheduleExprKindSingleDate:
+		specs := expr.AllDateSpecs()
+		recurring := 0
+		for _, spec := range specs {
+			if dateRecurs(spec) {
+				recurring++
+			}
+		}
+		id := "expr.dates" // Some dates recur and some don't
+		switch recurring {
+		case 0:
+			id = "expr.once"
+		case len(specs):
+			id = "expr.yearly"
+		}
+		return d.msg(id, at, d.dates(specs))
+	case ScheduleExprKindYear:
+		var targets []string
+		for _, target := range expr.AllYearTargets() {
+			targets = append(targets, d.yearTarget(target))
+		}
+		return d.msg("expr.year", at, d.c.list(targets), d.count("year", expr.Interval))
+	case ScheduleExprKindRandom:
+		var pool string
+		switch expr.Days.Kind {
+		case DayFilterKindWeekday:
+			pool = d.msg("random.weekday")
+		case DayFilterKindWeekend:
+			pool = d.msg("random.weekend")
+		case DayFilterKindDays:
+			pool = d.msg("random.days", d.c.list(d.weekdays(expr.Days.Days)))
+		default:
+			pool = d.msg("random.day")
+		}
+		return d.msg("expr.random", at, pool, d.msg("period."+expr.Period.String()))
+	case ScheduleExprKindFiscal:
+		return d.msg("expr.month", at, d.monthTarget(expr.MonthTarget), d.msg("fiscal."+expr.Fiscal.String()))
+	default:
+		return Display(&ScheduleData{Expr: expr})
+	}
+}
+
+func (d describer) dayFilter(f DayFilter) string {
+	switch f.Kind {
+	case DayFilterKindWeekday:
+		return d.msg("day.weekdays")
+	case DayFilterKindWeekend:
+		return d.msg("day.weekends")
+	case DayFilterKindDays:
+		return d.msg("day.days", d.c.list(d.weekdays(f.Days)))
+	default:
+		return d.msg("day.every")
+	}
+}
+
+func (d describer) monthTarget(target MonthTarget) string {
+	switch target.Kind {
+	case MonthTargetKindDays:
+		specs := make([]string, len(target.Specs))
+		for i, spec := range target.Specs {
+			if spec.Kind == DayOfMonthSpecKindRange {
+				specs[i] = d.msg("range", d.c.dayOfMonth(spec.Start), d.c.dayOfMonth(spec.End))
+			} else {
+				specs[i] = d.c.dayOfMonth(spec.Day)
+			}
+		}
+		return d.msg("target.days", d.c.list(specs))
+	case MonthTargetKindLastDay:
+		return d.msg("target.last_day")
+	case MonthTargetKindLastWeekday:
+		return d.msg("target.last_weekday")
+	case MonthTargetKindNearestWeekday:
+		switch target.Direction {
+		case NearestNext:
+			return d.msg("target.nearest_next", d.c.dayOfMonth(target.Day))
+		case NearestPrevious:
+			return d.msg("target.nearest_previous", d.c.dayOfMonth(target.Day))
+		}
+		return d.msg("target.nearest", d.c.dayOfMonth(target.Day))
+	case MonthTargetKindOrdinalWeekday:
+		pairs := target.OrdinalWeekdays()
+		out := make([]string, len(pairs))
+		for i, pair := range pairs {
+			out[i] = d.msg("target.ordinal_weekday", d.c.ordinals[pair.Ordinal], d.c.weekdays[pair.Weekday.Number()-1])
+		}
+		return d.c.list(out)
+	case MonthTargetKindBusinessDay:
+		return d.msg("target.business_day", d.c.ordinalNumber(target.Day))
+	case MonthTargetKindLastBusinessDay:
+		return d.msg("target.last_business_day")
+	default:
+		return displayMonthTarget(target)
+	}
+}
+
+func (d describer) yearTarget(target YearTarget) string {
+	if target.Kind == YearTargetKindDayOfYear {
+		return d.msg("year.day_of_year", d.c.ordinalNumber(target.Day))
+	}
+	month := d.c.months[target.Month.Number()-1]
+	switch target.Kind {
+	case YearTargetKindOrdinalWeekday:
+		return d.msg("year.ordinal_weekday", d.c.ordinals[target.Ordinal], d.c.weekdays[target.Weekday.Number()-1], month)
+	case YearTargetKindLastWeekday:
+		return d.msg("year.last_weekday", month)
+	default:
+		return d.monthDay(target.Month, target.Day)
+	}
+}
+
+// dates lists the dates of a single-date schedule after "on" ("on March 1 and June 1").
+// Relative dates read without it, so when there are any, each calendar date takes its own.
+func (d describer) dates(specs []DateSpec) string {
+	items := make([]string, len(specs))
+	for i, spec := range specs {
+		items[i] = d.dateSpec(spec)
+	}
+	isRelative := func(spec DateSpec) bool { return spec.Kind == DateSpecKindRelative }
+	if !slices.ContainsFunc(specs, isRelative) {
+		return d.msg("date.on", d.c.list(items))
+	}
+	for i, spec := range specs {
+		if !isRelative(spec) {
+			items[i] = d.msg("date.on", items[i])
+		}
+	}
+	return d.c.list(items)
+}
+
+// dateRecurs reports whether a date spec falls again every year: named and event dates,
+// and dates relative to them. ISO dates happen once.
+func dateRecurs(spec DateSpec) bool {
+	switch spec.Kind {
+	case DateSpecKindNamed, DateSpecKindEvent:
+		return true
+	case DateSpecKindRelative:
+		return dateRecurs(*spec.Base)
+	}
+	return false
+}
+
+func (d describer) dateSpec(spec DateSpec) string {
+	switch spec.Kind {
+	case DateSpecKindNamed:
+		return d.monthDay(spec.Month, spec.Day)
+	case DateSpecKindISO:
+		return d.isoDate(spec.Date)
+	case DateSpecKindEvent:
+		return strings.ToUpper(spec.Event[:1]) + spec.Event[1:]
+	case DateSpecKindRelative:
+		id := "relative.day"
+		if spec.Unit == RelativeWeekdays {
+			id = "relative.weekday"
+		}
+		n := spec.Offset
+		if n < 0 {
+			id, n = id+"_before", -n
+		} else {
+			id += "_after"
+		}
+		base := d.dateSpec(*spec.Base)
+		if n == 1 {
+			return d.msg(id+".one", base)
+		}
+		return d.msg(id+".other", n, base)
+	default:
+		return displayDateSpec(spec)
+	}
+}
+
+func (d describer) during(schedule *ScheduleData) string {
+	var parts []string
+	for _, q := range schedule.DuringQuarters {
+		parts = append(parts, d.msg("during.quarter", q))
+	}
+	for _, h := range schedule.DuringHalves {
+		parts = append(parts, d.msg("during.half", h))
+	}
+	for _, m := range schedule.During {
+		parts = append(parts, d.c.months[m.Number()-1])
+	}
+	for _, w := range schedule.DuringDates {
+		parts = append(parts, d.msg("range", d.monthDay(w.FromMonth, w.FromDay), d.monthDay(w.ToMonth, w.ToDay)))
+	}
+	for _, r := range schedule.DuringWeeks {
+		if r.From == r.To {
+			parts = append(parts, d.msg("during.week", r.From))
+		} else {
+			parts = append(parts, d.msg("during.weeks", r.From, r.To))
+		}
+	}
+	return d.c.list(parts)
+}
+
+// years renders the calendar years of a during clause as their own phrase, so a range
+// reads "from 2026 through 2027" rather than joining the other during items.
+func (d describer) years(years []YearRange) string {
+	parts := make([]string, len(years))
+	for i, r := range years {
+		if r.From == r.To {
+			parts[i] = d.msg("year.in", r.From)
+		} else {
+			parts[i] = d.msg("year.range", r.From, r.To)
+		}
+	}
+	return d.c.list(parts)
+}
+
+// timezone names a zone by its city ("America/New_York" is "New York time").
+func (d describer) timezone(tz string) string {
+	if tz == LocalTimezone {
+		return d.msg("tz.local")
+	}
+	if _, ok := parseUTCOffset(tz); ok {
+		return tz
+	}
+	city := tz[strings.LastIndexByte(tz, '/')+1:]
+	if city == tz && strings.ToUpper(tz) == tz {
+		return tz // Abbreviations such as UTC
+	}
+	return d.msg("tz.city", strings.ReplaceAll(city, "_", " "))
+}
+
+func (d describer) times(times []TimeOfDay) string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = d.c.time(t)
+	}
+	return d.c.list(out)
+}
+
+func (d describer) weekdays(days []Weekday) []string {
+	out := make([]string, len(days))
+	for i, wd := range days {
+		out[i] = d.c.weekdays[wd.Number()-1]
+	}
+	return out
+}
+
+func (d describer) monthDay(m MonthName, day int) string {
+	return d.msg("month_day", day, d.c.months[m.Number()-1])
+}
+
+func (d describer) isoDate(date string) string {
+	t, err := parseISODate(date)
+	if err != nil {
+		return date
+	}
+	return d.msg("date", t.Day(), d.c.months[t.Month()-1], t.Year())
+}
+
+// relative renders a starting or until clause with a relative date.
+func (d describer) relative(clause string, r RelativeAnchor) string {
+	if r.Kind == RelativeAnchorNextWeekday {
+		return d.msg(clause+".next weekday", d.c.weekdays[r.Weekday.Number()-1])
+	}
+	return d.msg(clause + "." + r.String())
+}
@@ -0,0 +1,633 @@
+package hron
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// describeCatalog holds the messages and word lists Describe renders with for one
+// language. Messages are fmt formats keyed by id; plural messages come in ".one" and
+// ".other" forms, and formats use explicit argument indexes where languages reorder.
+type describeCatalog struct {
+	messages      map[string]string
+	weekdays      [7]string // Monday first
+	months        [12]string
+	ordinals      map[OrdinalPosition]string
+	dayOfMonth    func(day int) string // A day of the month in a target ("1st")
+	ordinalNumber func(n int) string   // A counted position ("3rd" business day)
+	time          func(t TimeOfDay) string
+	and           string
+	serialComma   bool
+	elide         func(s string) string // Contracts articles before vowels, if the language does
+}
+
+// list joins items with the catalog's conjunction: "a", "a and b", "a, b, and c".
+func (c *describeCatalog) list(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " " + c.and + " " + items[1]
+	}
+	sep := " "
+	if c.serialComma {
+		sep = ", "
+	}
+	return strings.Join(items[:len(items)-1], ", ") + sep + c.and + " " + items[len(items)-1]
+}
+
+var describeCatalogs = map[string]*describeCatalog{
+	"en": englishCatalog,
+	"es": spanishCatalog,
+	"de": germanCatalog,
+	"fr": frenchCatalog,
+}
+
+var englishCatalog = &describeCatalog{
+	messages: map[string]string{
+		"runs":              "Runs %s",
+		"aligned":           "aligned to %s",
+		"align.epoch":       "epoch",
+		"align.week start":  "week start",
+		"align.month start": "month start",
+		"align.year start":  "year start",
+		"align.iso weeks":   "iso weeks",
+		"except":            "except %s",
+		"holidays":          "holidays",
+		"until":             "until %s",
+		"starting":          "starting %s",
+		"during":            "during %s",
+		"in":                "in %s",
+		"tz.city":           "%s time",
+		"tz.local":          "local time",
+		"at":                "at %s",
+		"solar.sunrise":     "at sunrise",
+		"solar.sunset":      "at sunset",
+		"solar.dawn":        "at dawn",
+		"solar.dusk":        "at dusk",
+		"range":             "%[1]s through %[2]s",
+		"month_day":         "%[2]s %[1]d",
+		"date":              "%[2]s %[1]d, %[3]d",
+
+		"until.today":           "until today",
+		"until.tomorrow":        "until tomorrow",
+		"until.next weekday":    "until next %s",
+		"until.next week":       "until next week",
+		"until.next month":      "until next month",
+		"until.next year":       "until next year",
+		"until.end of week":     "until the end of the week",
+		"until.end of month":    "until the end of the month",
+		"until.end of year":     "until the end of the year",
+		"starting.today":        "starting today",
+		"starting.tomorrow":     "starting tomorrow",
+		"starting.next weekday": "starting next %s",
+		"starting.next week":    "starting next week",
+		"starting.next month":   "starting next month",
+		"starting.next year":    "starting next year",
+		"starting.end of week":  "starting at the end of the week",
+		"starting.end of month": "starting at the end of the month",
+		"starting.end of year":  "starting at the end of the year",
+
+		"expr.interval":      "%[1]s from %[2]s to %[3]s",
+		"unit.min.one":       "every minute",
+		"unit.min.other":     "every %d minutes",
+		"unit.hours.one":     "every hour",
+		"unit.hours.other":   "every %d hours",
+		"unit.seconds.one":   "every second",
+		"unit.seconds.other": "every %d seconds",
+
+		"offset.earlier":     "shifted %s earlier",
+		"offset.later":       "shifted %s later",
+		"span.min.one":       "1 minute",
+		"span.min.other":     "%d minutes",
+		"span.hours.one":     "1 hour",
+		"span.hours.other":   "%d hours",
+		"span.seconds.one":   "1 second",
+		"span.seconds.other": "%d seconds",
+
+		"expr.day":     "%[1]s %[2]s",
+		"day.every":    "every day",
+		"day.weekdays": "on weekdays",
+		"day.weekends": "on weekends",
+		"day.days":     "on %s",
+		"day.every_n":  "every %d days",
+
+		"expr.week":  "%[1]s on %[2]s %[3]s",
+		"week.one":   "every week",
+		"week.other": "every %d weeks",
+
+		"expr.month":                "%[1]s on %[2]s %[3]s",
+		"expr.month_week":           "%[1]s on %[2]s in the %[3]s week %[4]s",
+		"month.one":                 "of each month",
+		"month.other":               "of every %d months",
+		"quarter.one":               "of each quarter",
+		"quarter.other":             "of every %d quarters",
+		"fiscal.month":              "of each fiscal month",
+		"fiscal.quarter":            "of each fiscal quarter",
+		"fiscal.year":               "of each fiscal year",
+		"half_year":                 "of each half year",
+		"target.days":               "the %s",
+		"target.last_day":           "the last day",
+		"target.last_weekday":       "the last weekday",
+		"target.nearest":            "the weekday nearest the %s",
+		"target.nearest_next":       "the weekday on or after the %s",
+		"target.nearest_previous":   "the weekday on or before the %s",
+		"target.ordinal_weekday":    "the %[1]s %[2]s",
+		"target.business_day":       "the %s business day",
+		"target.last_business_day":  "the last business day",
+		"expr.once":                 "once %[1]s %[2]s",
+		"expr.yearly":               "%[1]s %[2]s every year",
+		"expr.dates":                "%[1]s %[2]s",
+		"date.on":                   "on %s",
+		"relative.day_before.one":   "the day before %s",
+		"relative.day_before.other": "%[1]d days before %[2]s",
+		"relative.day_after.one":    "the day after %s",
+		"relative.day_after.other":  "%[1]d days after %[2]s",
+
+		"relative.weekday_before.one":   "the weekday before %s",
+		"relative.weekday_before.other": "%[1]d weekdays before %[2]s",
+		"relative.weekday_after.one":    "the weekday after %s",
+		"relative.weekday_after.other":  "%[1]d weekdays after %[2]s",
+
+		"expr.year":            "%[1]s on %[2]s %[3]s",
+		"year.one":             "every year",
+		"year.other":           "every %d years",
+		"year.ordinal_weekday": "the %[1]s %[2]s of %[3]s",
+		"year.last_weekday":    "the last weekday of %s",
+		"year.day_of_year":     "the %s day of the year",
+		"expr.random":          "%[1]s on one random %[2]s each %[3]s",
+		"random.day":           "day",
+		"random.weekday":       "weekday",
+		"random.weekend":       "weekend day",
+		"random.days":          "day from %s",
+		"period.week":          "week",
+		"period.month":         "month",
+		"during.quarter":       "Q%d",
+		"during.half":          "H%d",
+		"during.week":          "ISO week %d",
+		"during.weeks":         "ISO weeks %[1]d through %[2]d",
+		"year.in":              "in %d",
+		"year.range":           "from %[1]d through %[2]d",
+	},
+	weekdays: [7]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+	months: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	ordinals: map[OrdinalPosition]string{
+		First: "first", Second: "second", Third: "third", Fourth: "fourth", Fifth: "fifth", Last: "last",
+		SecondToLast: "second to last", ThirdToLast: "third to last",
+		FourthToLast: "fourth to last", FifthToLast: "fifth to last",
+	},
+	dayOfMonth:    ordinalNumber,
+	ordinalNumber: ordinalNumber,
+	time: func(t TimeOfDay) string {
+		hour, meridiem := t.Hour%12, "AM"
+		if t.Hour >= 12 {
+			meridiem = "PM"
+		}
+		if hour == 0 {
+			hour = 12
+		}
+		return fmt.Sprintf("%d:%02d %s", hour, t.Minute, meridiem)
+	},
+	and:         "and",
+	serialComma: true,
+}
+
+var spanishCatalog = &describeCatalog{
+	messages: map[string]string{
+		"runs":              "Se ejecuta %s",
+		"aligned":           "alineado a %s",
+		"align.epoch":       "la época",
+		"align.week start":  "el inicio de semana",
+		"align.month start": "el inicio de mes",
+		"align.year start":  "el inicio de año",
+		"align.iso weeks":   "las semanas ISO",
+		"except":            "excepto %s",
+		"holidays":          "los festivos",
+		"until":             "hasta el %s",
+		"starting":          "a partir del %s",
+		"during":            "durante %s",
+		"in":                "%s",
+		"tz.city":           "hora de %s",
+		"tz.local":          "hora local",
+		"at":                "a las %s",
+		"solar.sunrise":     "al amanecer",
+		"solar.sunset":      "al atardecer",
+		"solar.dawn":        "al alba",
+		"solar.dusk":        "al anochecer",
+		"range":             "%[1]s al %[2]s",
+		"month_day":         "%[1]d de %[2]s",
+		"date":              "%[1]d de %[2]s de %[3]d",
+
+		"until.today":           "hasta hoy",
+		"until.tomorrow":        "hasta mañana",
+		"until.next weekday":    "hasta el próximo %s",
+		"until.next week":       "hasta la próxima semana",
+		"until.next month":      "hasta el próximo mes",
+		"until.next year":       "hasta el próximo año",
+		"until.end of week":     "hasta el final de la semana",
+		"until.end of month":    "hasta el final del mes",
+		"until.end of year":     "hasta el final del año",
+		"starting.today":        "a partir de hoy",
+		"starting.tomorrow":     "a partir de mañana",
+		"starting.next weekday": "a partir del próximo %s",
+		"starting.next week":    "a partir de la próxima semana",
+		"starting.next month":   "a partir del próximo mes",
+		"starting.next year":    "a partir del próximo año",
+		"starting.end of week":  "a partir del final de la semana",
+		"starting.end of month": "a partir del final del mes",
+		"starting.end of year":  "a partir del final del año",
+
+		"expr.interval":      "%[1]s de %[2]s a %[3]s",
+		"unit.min.one":       "cada minuto",
+		"unit.min.other":     "cada %d minutos",
+		"unit.hours.one":     "cada hora",
+		"unit.hours.other":   "cada %d horas",
+		"unit.seconds.one":   "cada segundo",
+		"unit.seconds.other": "cada %d segundos",
+
+		"offset.earlier":     "adelantado %s",
+		"offset.later":       "retrasado %s",
+		"span.min.one":       "1 minuto",
+		"span.min.other":     "%d minutos",
+		"span.hours.one":     "1 hora",
+		"span.hours.other":   "%d horas",
+		"span.seconds.one":   "1 segundo",
+		"span.seconds.other": "%d segundos",
+
+		"expr.day":     "%[1]s %[2]s",
+		"day.every":    "todos los días",
+		"day.weekdays": "los días laborables",
+		"day.weekends": "los fines de semana",
+		"day.days":     "los %s",
+		"day.every_n":  "cada %d días",
+
+		"expr.week":  "%[1]s los %[2]s %[3]s",
+		"week.one":   "cada semana",
+		"week.other": "cada %d semanas",
+
+		"expr.month":                "%[1]s %[2]s %[3]s",
+		"expr.month_week":           "%[1]s los %[2]s de la %[3]s semana %[4]s",
+		"month.one":                 "de cada mes",
+		"month.other":               "de cada %d meses",
+		"quarter.one":               "de cada trimestre",
+		"quarter.other":             "de cada %d trimestres",
+		"fiscal.month":              "de cada mes fiscal",
+		"fiscal.quarter":            "de cada trimestre fiscal",
+		"fiscal.year":               "de cada año fiscal",
+		"half_year":                 "de cada semestre",
+		"target.days":               "el día %s",
+		"target.last_day":           "el último día",
+		"target.last_weekday":       "el último día laborable",
+		"target.nearest":            "el día laborable más cercano al %s",
+		"target.nearest_next":       "el primer día laborable desde el %s",
+		"target.nearest_previous":   "el último día laborable hasta el %s",
+		"target.ordinal_weekday":    "el %[1]s %[2]s",
+		"target.business_day":       "el %s día hábil",
+		"target.last_business_day":  "el último día hábil",
+		"expr.once":                 "una vez %[1]s %[2]s",
+		"expr.yearly":               "cada año %[1]s %[2]s",
+		"expr.dates":                "%[1]s %[2]s",
+		"date.on":                   "el %s",
+		"relative.day_before.one":   "el día antes de %s",
+		"relative.day_before.other": "%[1]d días antes de %[2]s",
+		"relative.day_after.one":    "el día después de %s",
+		"relative.day_after.other":  "%[1]d días después de %[2]s",
+
+		"relative.weekday_before.one":   "el día laborable antes de %s",
+		"relative.weekday_before.other": "%[1]d días laborables antes de %[2]s",
+		"relative.weekday_after.one":    "el día laborable después de %s",
+		"relative.weekday_after.other":  "%[1]d días laborables después de %[2]s",
+
+		"expr.year":            "%[1]s el %[2]s %[3]s",
+		"year.one":             "cada año",
+		"year.other":           "cada %d años",
+		"year.ordinal_weekday": "%[1]s %[2]s de %[3]s",
+		"year.last_weekday":    "último día laborable de %s",
+		"year.day_of_year":     "%s día del año",
+		"expr.random":          "%[1]s un %[2]s al azar cada %[3]s",
+		"random.day":           "día",
+		"random.weekday":       "día laborable",
+		"random.weekend":       "día de fin de semana",
+		"random.days":          "día entre %s",
+		"period.week":          "semana",
+		"period.month":         "mes",
+		"during.quarter":       "T%d",
+		"during.half":          "S%d",
+		"during.week":          "la semana ISO %d",
+		"during.weeks":         "las semanas ISO %[1]d a %[2]d",
+		"year.in":              "en %d",
+		"year.range":           "de %[1]d a %[2]d",
+	},
+	weekdays: [7]string{"lunes", "martes", "miércoles", "jueves", "viernes", "sábado", "domingo"},
+	months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ordinals: map[OrdinalPosition]string{
+		First: "primer", Second: "segundo", Third: "tercer", Fourth: "cuarto", Fifth: "quinto", Last: "último",
+		SecondToLast: "penúltimo", ThirdToLast: "antepenúltimo",
+		FourthToLast: "cuarto desde el final", FifthToLast: "quinto desde el final",
+	},
+	dayOfMonth:    func(day int) string { return fmt.Sprintf("%d", day) },
+	ordinalNumber: func(n int) string { return fmt.Sprintf("%d.º", n) },
+	time:          func(t TimeOfDay) string { return fmt.Sprintf("%d:%02d", t.Hour, t.Minute) },
+	and:           "y",
+}
+
+var germanCatalog = &describeCatalog{
+	messages: map[string]string{
+		"runs":              "Läuft %s",
+		"aligned":           "ausgerichtet auf %s",
+		"align.epoch":       "die Epoche",
+		"align.week start":  "den Wochenbeginn",
+		"align.month start": "den Monatsbeginn",
+		"align.year start":  "den Jahresbeginn",
+		"align.iso weeks":   "ISO-Wochen",
+		"except":            "außer %s",
+		"holidays":          "an Feiertagen",
+		"until":             "bis zum %s",
+		"starting":          "ab dem %s",
+		"during":            "nur %s",
+		"in":                "nach %s",
+		"tz.city":           "Ortszeit %s",
+		"tz.local":          "Ortszeit",
+		"at":                "um %s",
+		"solar.sunrise":     "bei Sonnenaufgang",
+		"solar.sunset":      "bei Sonnenuntergang",
+		"solar.dawn":        "in der Morgendämmerung",
+		"solar.dusk":        "in der Abenddämmerung",
+		"range":             "%[1]s bis %[2]s",
+		"month_day":         "%[1]d. %[2]s",
+		"date":              "%[1]d. %[2]s %[3]d",
+
+		"until.today":           "bis heute",
+		"until.tomorrow":        "bis morgen",
+		"until.next weekday":    "bis nächsten %s",
+		"until.next week":       "bis nächste Woche",
+		"until.next month":      "bis nächsten Monat",
+		"until.next year":       "bis nächstes Jahr",
+		"until.end of week":     "bis Ende der Woche",
+		"until.end of month":    "bis Ende des Monats",
+		"until.end of year":     "bis Ende des Jahres",
+		"starting.today":        "ab heute",
+		"starting.tomorrow":     "ab morgen",
+		"starting.next weekday": "ab nächstem %s",
+		"starting.next week":    "ab nächster Woche",
+		"starting.next month":   "ab nächstem Monat",
+		"starting.next year":    "ab nächstem Jahr",
+		"starting.end of week":  "ab Ende der Woche",
+		"starting.end of month": "ab Ende des Monats",
+		"starting.end of year":  "ab Ende des Jahres",
+
+		"expr.interval":      "%[1]s von %[2]s bis %[3]s",
+		"unit.min.one":       "jede Minute",
+		"unit.min.other":     "alle %d Minuten",
+		"unit.hours.one":     "jede Stunde",
+		"unit.hours.other":   "alle %d Stunden",
+		"unit.seconds.one":   "jede Sekunde",
+		"unit.seconds.other": "alle %d Sekunden",
+
+		"offset.earlier":     "um %s vorverlegt",
+		"offset.later":       "um %s verschoben",
+		"span.min.one":       "1 Minute",
+		"span.min.other":     "%d Minuten",
+		"span.hours.one":     "1 Stunde",
+		"span.hours.other":   "%d Stunden",
+		"span.seconds.one":   "1 Sekunde",
+		"span.seconds.other": "%d Sekunden",
+
+		"expr.day":     "%[2]s %[1]s",
+		"day.every":    "täglich",
+		"day.weekdays": "werktags",
+		"day.weekends": "am Wochenende",
+		"day.days":     "am %s",
+		"day.every_n":  "alle %d Tage",
+
+		"expr.week":  "%[3]s am %[2]s %[1]s",
+		"week.one":   "jede Woche",
+		"week.other": "alle %d Wochen",
+
+		"expr.month":                "%[1]s %[2]s %[3]s",
+		"expr.month_week":           "%[1]s am %[2]s in der %[3]s Woche %[4]s",
+		"month.one":                 "jedes Monats",
+		"month.other":               "jedes %d. Monats",
+		"quarter.one":               "jedes Quartals",
+		"quarter.other":             "jedes %d. Quartals",
+		"fiscal.month":              "jedes Geschäftsmonats",
+		"fiscal.quarter":            "jedes Geschäftsquartals",
+		"fiscal.year":               "jedes Geschäftsjahres",
+		"half_year":                 "jedes Halbjahres",
+		"target.days":               "am %s",
+		"target.last_day":           "am letzten Tag",
+		"target.last_weekday":       "am letzten Werktag",
+		"target.nearest":            "am Werktag, der dem %s am nächsten liegt",
+		"target.nearest_next":       "am ersten Werktag ab dem %s",
+		"target.nearest_previous":   "am letzten Werktag bis zum %s",
+		"target.ordinal_weekday":    "am %[1]s %[2]s",
+		"target.business_day":       "am %s Geschäftstag",
+		"target.last_business_day":  "am letzten Geschäftstag",
+		"expr.once":                 "einmalig %[1]s %[2]s",
+		"expr.yearly":               "jedes Jahr %[1]s %[2]s",
+		"expr.dates":                "%[1]s %[2]s",
+		"date.on":                   "am %s",
+		"relative.day_before.one":   "am Tag vor %s",
+		"relative.day_before.other": "%[1]d Tage vor %[2]s",
+		"relative.day_after.one":    "am Tag nach %s",
+		"relative.day_after.other":  "%[1]d Tage nach %[2]s",
+
+		"relative.weekday_before.one":   "am Werktag vor %s",
+		"relative.weekday_before.other": "%[1]d Werktage vor %[2]s",
+		"relative.weekday_after.one":    "am Werktag nach %s",
+		"relative.weekday_after.other":  "%[1]d Werktage nach %[2]s",
+
+		"expr.year":            "%[1]s am %[2]s %[3]s",
+		"year.one":             "jedes Jahr",
+		"year.other":           "alle %d Jahre",
+		"year.ordinal_weekday": "%[1]s %[2]s im %[3]s",
+		"year.last_weekday":    "letzten Werktag im %s",
+		"year.day_of_year":     "%s Tag des Jahres",
+		"expr.random":          "%[1]s an einem zufälligen %[2]s pro %[3]s",
+		"random.day":           "Tag",
+		"random.weekday":       "Werktag",
+		"random.weekend":       "Wochenendtag",
+		"random.days":          "Tag aus %s",
+		"period.week":          "Woche",
+		"period.month":         "Monat",
+		"during.quarter":       "Q%d",
+		"during.half":          "H%d",
+		"during.week":          "in ISO-Woche %d",
+		"during.weeks":         "in ISO-Wochen %[1]d bis %[2]d",
+		"year.in":              "im Jahr %d",
+		"year.range":           "von %[1]d bis %[2]d",
+	},
+	weekdays: [7]string{"Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag", "Sonntag"},
+	months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember"},
+	// Dative forms, as in "am ersten Montag"
+	ordinals: map[OrdinalPosition]string{
+		First: "ersten", Second: "zweiten", Third: "dritten", Fourth: "vierten", Fifth: "fünften", Last: "letzten",
+		SecondToLast: "vorletzten", ThirdToLast: "drittletzten",
+		FourthToLast: "viertletzten", FifthToLast: "fünftletzten",
+	},
+	dayOfMonth:    func(day int) string { return fmt.Sprintf("%d.", day) },
+	ordinalNumber: func(n int) string { return fmt.Sprintf("%d.", n) },
+	time:          func(t TimeOfDay) string { return fmt.Sprintf("%d:%02d Uhr", t.Hour, t.Minute) },
+	and:           "und",
+}
+
+var frenchCatalog = &describeCatalog{
+	messages: map[string]string{
+		"runs":              "S'exécute %s",
+		"aligned":           "aligné sur %s",
+		"align.epoch":       "l'époque",
+		"align.week start":  "le début de semaine",
+		"align.month start": "le début de mois",
+		"align.year start":  "le début d'année",
+		"align.iso weeks":   "les semaines ISO",
+		"except":            "sauf %s",
+		"holidays":          "les jours fériés",
+		"until":             "jusqu'au %s",
+		"starting":          "à partir du %s",
+		"during":            "pendant %s",
+		"in":                "%s",
+		"tz.city":           "heure de %s",
+		"tz.local":          "heure locale",
+		"at":                "à %s",
+		"solar.sunrise":     "au lever du soleil",
+		"solar.sunset":      "au coucher du soleil",
+		"solar.dawn":        "à l'aube",
+		"solar.dusk":        "au crépuscule",
+		"range":             "%[1]s au %[2]s",
+		"month_day":         "%[1]d %[2]s",
+		"date":              "%[1]d %[2]s %[3]d",
+
+		"until.today":           "jusqu'à aujourd'hui",
+		"until.tomorrow":        "jusqu'à demain",
+		"until.next weekday":    "jusqu'à %s prochain",
+		"until.next week":       "jusqu'à la semaine prochaine",
+		"until.next month":      "jusqu'au mois prochain",
+		"until.next year":       "jusqu'à l'année prochaine",
+		"until.end of week":     "jusqu'à la fin de la semaine",
+		"until.end of month":    "jusqu'à la fin du mois",
+		"until.end of year":     "jusqu'à la fin de l'année",
+		"starting.today":        "à partir d'aujourd'hui",
+		"starting.tomorrow":     "à partir de demain",
+		"starting.next weekday": "à partir de %s prochain",
+		"starting.next week":    "à partir de la semaine prochaine",
+		"starting.next month":   "à partir du mois prochain",
+		"starting.next year":    "à partir de l'année prochaine",
+		"starting.end of week":  "à partir de la fin de la semaine",
+		"starting.end of month": "à partir de la fin du mois",
+		"starting.end of year":  "à partir de la fin de l'année",
+
+		"expr.interval":      "%[1]s de %[2]s à %[3]s",
+		"unit.min.one":       "chaque minute",
+		"unit.min.other":     "toutes les %d minutes",
+		"unit.hours.one":     "chaque heure",
+		"unit.hours.other":   "toutes les %d heures",
+		"unit.seconds.one":   "chaque seconde",
+		"unit.seconds.other": "toutes les %d secondes",
+
+		"offset.earlier":     "avancé de %s",
+		"offset.later":       "retardé de %s",
+		"span.min.one":       "1 minute",
+		"span.min.other":     "%d minutes",
+		"span.hours.one":     "1 heure",
+		"span.hours.other":   "%d heures",
+		"span.seconds.one":   "1 seconde",
+		"span.seconds.other": "%d secondes",
+
+		"expr.day":     "%[2]s %[1]s",
+		"day.every":    "tous les jours",
+		"day.weekdays": "en semaine",
+		"day.weekends": "le week-end",
+		"day.days":     "le %s",
+		"day.every_n":  "tous les %d jours",
+
+		"expr.week":  "%[3]s le %[2]s %[1]s",
+		"week.one":   "chaque semaine",
+		"week.other": "toutes les %d semaines",
+
+		"expr.month":                "%[1]s %[2]s %[3]s",
+		"expr.month_week":           "%[1]s le %[2]s de la %[3]s semaine %[4]s",
+		"month.one":                 "de chaque mois",
+		"month.other":               "tous les %d mois",
+		"quarter.one":               "de chaque trimestre",
+		"quarter.other":             "tous les %d trimestres",
+		"fiscal.month":              "de chaque mois fiscal",
+		"fiscal.quarter":            "de chaque trimestre fiscal",
+		"fiscal.year":               "de chaque exercice",
+		"half_year":                 "de chaque semestre",
+		"target.days":               "le %s",
+		"target.last_day":           "le dernier jour",
+		"target.last_weekday":       "le dernier jour de semaine",
+		"target.nearest":            "le jour de semaine le plus proche du %s",
+		"target.nearest_next":       "le premier jour de semaine à partir du %s",
+		"target.nearest_previous":   "le dernier jour de semaine jusqu'au %s",
+		"target.ordinal_weekday":    "le %[1]s %[2]s",
+		"target.business_day":       "le %s jour ouvré",
+		"target.last_business_day":  "le dernier jour ouvré",
+		"expr.once":                 "une fois %[1]s %[2]s",
+		"expr.yearly":               "chaque année %[1]s %[2]s",
+		"expr.dates":                "%[1]s %[2]s",
+		"date.on":                   "le %s",
+		"relative.day_before.one":   "le jour avant %s",
+		"relative.day_before.other": "%[1]d jours avant %[2]s",
+		"relative.day_after.one":    "le jour après %s",
+		"relative.day_after.other":  "%[1]d jours après %[2]s",
+
+		"relative.weekday_before.one":   "le jour de semaine avant %s",
+		"relative.weekday_before.other": "%[1]d jours de semaine avant %[2]s",
+		"relative.weekday_after.one":    "le jour de semaine après %s",
+		"relative.weekday_after.other":  "%[1]d jours de semaine après %[2]s",
+
+		"expr.year":            "%[1]s le %[2]s %[3]s",
+		"year.one":             "chaque année",
+		"year.other":           "tous les %d ans",
+		"year.ordinal_weekday": "%[1]s %[2]s de %[3]s",
+		"year.last_weekday":    "dernier jour de semaine de %s",
+		"year.day_of_year":     "%s jour de l'année",
+		"expr.random":          "%[1]s un %[2]s au hasard chaque %[3]s",
+		"random.day":           "jour",
+		"random.weekday":       "jour de semaine",
+		"random.weekend":       "jour de week-end",
+		"random.days":          "jour parmi %s",
+		"period.week":          "semaine",
+		"period.month":         "mois",
+		"during.quarter":       "T%d",
+		"during.half":          "S%d",
+		"during.week":          "la semaine ISO %d",
+		"during.weeks":         "les semaines ISO %[1]d à %[2]d",
+		"year.in":              "en %d",
+		"year.range":           "de %[1]d à %[2]d",
+	},
+	weekdays: [7]string{"lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi", "dimanche"},
+	months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ordinals: map[OrdinalPosition]string{
+		First: "premier", Second: "deuxième", Third: "troisième", Fourth: "quatrième", Fifth: "cinquième", Last: "dernier",
+		SecondToLast: "avant-dernier", ThirdToLast: "antépénultième",
+		FourthToLast: "quatrième en partant de la fin", FifthToLast: "cinquième en partant de la fin",
+	},
+	dayOfMonth: func(day int) string {
+		if day == 1 {
+			return "1er"
+		}
+		return fmt.Sprintf("%d", day)
+	},
+	ordinalNumber: func(n int) string {
+		if n == 1 {
+			return "1er"
+		}
+		return fmt.Sprintf("%de", n)
+	},
+	time:  func(t TimeOfDay) string { return fmt.Sprintf("%dh%02d", t.Hour, t.Minute) },
+	and:   "et",
+	elide: func(s string) string { return frenchElision.ReplaceAllString(s, "$1'$2") },
+}
+
+// frenchElision matches "le" and "de" before a vowel, which French contracts to "l'" and
+// "d'" ("l'avant-dernier vendredi", "d'avril", "heure d'Amsterdam").
+var frenchElision = regexp.MustCompile(`\b([ld])e ([aeiouâéèêîôûAEIOUÉ])`)
@@ -0,0 +1,108 @@
+package hron
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every month on the first monday at 09:00 except dec 25 in America/New_York",
+			"Runs at 9:00 AM on the first Monday of each month, except December 25, in New York time"},
+		{"every day at 00:00, 12:30, 17:45", "Runs at 12:00 AM, 12:30 PM, and 5:45 PM every day"},
+		{"every weekday at 09:00", "Runs at 9:00 AM on weekdays"},
+		{"every 3 days at 07:00 starting 2026-01-05", "Runs at 7:00 AM every 3 days, starting January 5, 2026"},
+		{"every 2 weeks on monday, friday at 10:00", "Runs at 10:00 AM on Monday and Friday every 2 weeks"},
+		{"every 30 min from 09:00 to 17:00 on weekday", "Runs every 30 minutes from 9:00 AM to 5:00 PM on weekdays"},
+		{"every hour from 00:00 to 23:59", "Runs every hour from 12:00 AM to 11:59 PM"},
+		{"every month on the 1st, 15th at 09:00", "Runs at 9:00 AM on the 1st and 15th of each month"},
+		{"every quarter on the last day at 17:00", "Runs at 5:00 PM on the last day of each quarter"},
+		{"every 3 months on the 3rd business day at 09:00", "Runs at 9:00 AM on the 3rd business day of every 3 months"},
+		{"every month in the second week on tuesday at 10:00", "Runs at 10:00 AM on Tuesday in the second week of each month"},
+		{"every year on the first monday of sep at 09:00", "Runs at 9:00 AM on the first Monday of September every year"},
+		{"every jan 15 and jul 15 at 09:00", "Runs at 9:00 AM on January 15 and July 15 every year"},
+		{"on 2026-03-01 at 09:00", "Runs once at 9:00 AM on March 1, 2026"},
+		{"on 2026-03-01, 2026-06-01 at 09:00", "Runs once at 9:00 AM on March 1, 2026 and June 1, 2026"},
+		{"on 2 days before easter at 09:00", "Runs at 9:00 AM 2 days before Easter every year"},
+		{"on feb 14 at 09:00", "Runs at 9:00 AM on February 14 every year"},
+		{"on easter, dec 25 at 09:00", "Runs at 9:00 AM on Easter and December 25 every year"},
+		{"on the day after 2026-03-01 at 09:00", "Runs once at 9:00 AM the day after March 1, 2026"},
+		{"on 2026-03-01, dec 25 at 09:00", "Runs at 9:00 AM on March 1, 2026 and December 25"},
+		{"every day at 09:00 during jun, 2026 to 2027", "Runs at 9:00 AM every day, during June, from 2026 through 2027"},
+		{"every day at 09:00 during 2026, 2028 to 2029", "Runs at 9:00 AM every day, in 2026 and from 2028 through 2029"},
+		{"every day at 09:00 until 2026-12-31 during jun, jul in UTC",
+			"Runs at 9:00 AM every day, until December 31, 2026, during June and July, in UTC"},
+		{"every weekday at 09:00 except holidays in local", "Runs at 9:00 AM on weekdays, except holidays, in local time"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.Describe(); got != tt.want {
+			t.Errorf("Describe(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeIn(t *testing.T) {
+	tests := []struct {
+		input, locale, want string
+	}{
+		{"every month on the first monday at 09:00 except dec 25 in America/New_York", "en",
+			"Runs at 9:00 AM on the first Monday of each month, except December 25, in New York time"},
+		{"every month on the first monday at 09:00 except dec 25 in America/New_York", "es",
+			"Se ejecuta a las 9:00 el primer lunes de cada mes, excepto 25 de diciembre, hora de New York"},
+		{"every 2 weeks on monday, friday at 10:00", "es", "Se ejecuta a las 10:00 los lunes y viernes cada 2 semanas"},
+		{"every 30 min from 09:00 to 17:00", "es", "Se ejecuta cada 30 minutos de 9:00 a 17:00"},
+		{"on 2026-03-01 at 09:00", "es", "Se ejecuta una vez a las 9:00 el 1 de marzo de 2026"},
+		{"every month on the first monday at 09:00 except dec 25 in America/New_York", "de",
+			"Läuft um 9:00 Uhr am ersten Montag jedes Monats, außer 25. Dezember, nach Ortszeit New York"},
+		{"every weekday at 09:00", "de", "Läuft werktags um 9:00 Uhr"},
+		{"every month on the 3rd business day at 09:00", "de", "Läuft um 9:00 Uhr am 3. Geschäftstag jedes Monats"},
+		{"every day at 09:00 until 2026-12-31", "de", "Läuft täglich um 9:00 Uhr, bis zum 31. Dezember 2026"},
+		{"every month on the first monday at 09:00 except dec 25 in America/New_York", "fr",
+			"S'exécute à 9h00 le premier lundi de chaque mois, sauf 25 décembre, heure de New York"},
+		{"every month on the 1st, 15th at 09:00", "fr", "S'exécute à 9h00 le 1er et 15 de chaque mois"},
+		{"every 2 weeks on monday, friday at 10:00", "fr", "S'exécute toutes les 2 semaines le lundi et vendredi à 10h00"},
+		{"every day at 09:00 during jun, jul in UTC", "fr", "S'exécute tous les jours à 9h00, pendant juin et juillet, UTC"},
+		{"every year on the second to last friday of oct at 09:00", "fr",
+			"S'exécute à 9h00 l'avant-dernier vendredi d'octobre chaque année"},
+		{"on feb 14 at 09:00", "fr", "S'exécute chaque année à 9h00 le 14 février"},
+		{"on the day before easter at 09:00", "de", "Läuft jedes Jahr um 9:00 Uhr am Tag vor Easter"},
+		{"on 2 days after easter at 09:00", "es", "Se ejecuta cada año a las 9:00 2 días después de Easter"},
+		{"every day at 09:00 during 2026 to 2027", "es", "Se ejecuta a las 9:00 todos los días, de 2026 a 2027"},
+	}
+	for _, tt := range tests {
+		got, err := MustParse(tt.input).DescribeIn(tt.locale)
+		if err != nil {
+			t.Errorf("DescribeIn(%q, %q) error: %v", tt.input, tt.locale, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("DescribeIn(%q, %q) = %q, want %q", tt.input, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeInUnknownLocale(t *testing.T) {
+	if _, err := MustParse("every day at 09:00").DescribeIn("xx"); err == nil {
+		t.Error("DescribeIn(\"xx\") should error")
+	}
+}
+
+// Every catalog must define every English message, so no description falls back to English.
+func TestDescribeCatalogsComplete(t *testing.T) {
+	for name, c := range describeCatalogs {
+		for id := range englishCatalog.messages {
+			if _, ok := c.messages[id]; !ok {
+				t.Errorf("catalog %s is missing message %q", name, id)
+			}
+		}
+		for ord := range englishCatalog.ordinals {
+			if c.ordinals[ord] == "" {
+				t.Errorf("catalog %s is missing ordinal %q", name, ord)
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ChangeKind says how a part of a schedule differs in Diff.
+type ChangeKind int
+
+const (
+	// ChangeAdded is a list entry or clause present only in the new schedule.
+	ChangeAdded ChangeKind = iota
+	// ChangeRemoved is a list entry or clause present only in the old schedule.
+	ChangeRemoved
+	// ChangeModified is a value present in both schedules that differs.
+	ChangeModified
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// Change is one difference between two schedules. Field names the part of the
+// expression: "repeat" (the expression before its times), "times", or a trailing clause:
+// "offset", "aligned", "except", "until", "starting", "during", or "in". Before and After are in
+// canonical syntax; Before is empty for additions and After for removals.
+type Change struct {
+	Field  string
+	Kind   ChangeKind
+	Before string
+	After  string
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s: added %s", c.Field, c.After)
+	case ChangeRemoved:
+		return fmt.Sprintf("%s: removed %s", c.Field, c.Before)
+	default:
+		return fmt.Sprintf("%s: changed %s to %s", c.Field, c.Before, c.After)
+	}
+}
+
+// Diff reports how schedule b differs from a, for audit logs of edited schedules. Both
+// are normalized first, so reordering a list is not a change. Times and except dates
+// are reported per entry; other parts are reported as a whole. Diff returns nil when
+// the schedules are Equal.
+func Diff(a, b *Schedule) []Change {
+	from, to := Normalize(a.data), Normalize(b.data)
+	var changes []Change
+
+	// Compare the expressions with the times held equal, so a time edit is not also a
+	// repeat change
+	fromRepeat := from.Expr
+	toRepeat := to.Expr
+	toRepeat.Times = fromRepeat.Times
+	if before, after := displayExpr(fromRepeat), displayExpr(toRepeat); before != after {
+		changes = append(changes, Change{"repeat", ChangeModified, before, displayExpr(to.Expr)})
+	}
+	changes = append(changes, diffList("times", from.Expr.Times, to.Expr.Times, TimeOfDay.String)...)
+
+	changes = appendClauseChange(changes, "offset", offsetClause(from), offsetClause(to))
+	changes = appendClauseChange(changes, "aligned", alignedClause(from), alignedClause(to))
+	changes = append(changes, diffList("except", from.Except, to.Except, func(e ExceptionSpec) string {
+		return displayExceptions([]ExceptionSpec{e})
+	})...)
+	changes = appendClauseChange(changes, "until", untilClause(from), untilClause(to))
+	changes = appendClauseChange(changes, "starting", displayStarting(from), displayStarting(to))
+	changes = appendClauseChange(changes, "during", duringClause(from), duringClause(to))
+	changes = appendClauseChange(changes, "in", strings.Join(from.AllTimezones(), ", "), strings.Join(to.AllTimezones(), ", "))
+	return changes
+}
+
+// diffList reports the entries of from missing in to, then the entries new in to.
+func diffList[T comparable](field string, from, to []T, format func(T) string) []Change {
+	var changes []Change
+	for _, v := range from {
+		if !slices.Contains(to, v) {
+			changes = append(changes, Change{Field: field, Kind: ChangeRemoved, Before: format(v)})
+		}
+	}
+	for _, v := range to {
+		if !slices.Contains(from, v) {
+			changes = append(changes, Change{Field: field, Kind: ChangeAdded, After: format(v)})
+		}
+	}
+	return changes
+}
+
+// appendClauseChange appends the change between two renderings of an optional clause,
+// where an empty string means the clause is absent.
+func appendClauseChange(changes []Change, field, before, after string) []Change {
+	switch {
+	case before == after:
+		return changes
+	case before == "":
+		return append(changes, Change{Field: field, Kind: ChangeAdded, After: after})
+	case after == "":
+		return append(changes, Change{Field: field, Kind: ChangeRemoved, Before: before})
+	}
+	return append(changes, Change{field, ChangeModified, before, after})
+}
+
+func offsetClause(schedule *ScheduleData) string {
+	if schedule.Offset == (Offset{}) {
+		return ""
+	}
+	return displayOffset(schedule.Offset)
+}
+
+func alignedClause(schedule *ScheduleData) string {
+	if schedule.Alignment == AlignmentDefault {
+		return ""
+	}
+	return schedule.Alignment.String()
+}
+
+func untilClause(schedule *ScheduleData) string {
+	if schedule.Until == nil {
+		return ""
+	}
+	return displayUntil(*schedule.Until)
+}
+
+func duringClause(schedule *ScheduleData) string {
+	if !hasDuringClause(schedule) {
+		return ""
+	}
+	return displayDuring(schedule)
+}
@@ -0,0 +1,38 @@
+package hron
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want []string
+	}{
+		{"every weekday at 09:00", "every monday to friday at 9:00", nil},
+		{"every weekday at 09:00, 12:00", "every weekday at 12:00, 17:00",
+			[]string{"times: removed 09:00", "times: added 17:00"}},
+		{"every weekday at 09:00 in UTC", "every weekday at 09:00 in America/New_York",
+			[]string{"in: changed UTC to America/New_York"}},
+		{"every weekday at 09:00", "every weekday at 09:00 in UTC", []string{"in: added UTC"}},
+		{"every day at 09:00 except dec 25, jan 1", "every day at 09:00 except jan 1, 2026-07-03",
+			[]string{"except: removed dec 25", "except: added 2026-07-03"}},
+		{"every weekday at 09:00", "every weekend at 10:00",
+			[]string{"repeat: changed every weekday at 09:00 to every weekend at 10:00", "times: removed 09:00", "times: added 10:00"}},
+		{"every day at 09:00 until 2026-12-31 during jan", "every day at 09:00 starting 2026-01-05 during jan, feb", []string{
+			"until: removed 2026-12-31",
+			"starting: added 2026-01-05",
+			"during: changed jan to jan, feb",
+		}},
+	}
+	for _, tt := range tests {
+		changes := Diff(MustParse(tt.a), MustParse(tt.b))
+		if len(changes) != len(tt.want) {
+			t.Errorf("Diff(%q, %q) = %v, want %v", tt.a, tt.b, changes, tt.want)
+			continue
+		}
+		for i, c := range changes {
+			if c.String() != tt.want[i] {
+				t.Errorf("Diff(%q, %q)[%d] = %q, want %q", tt.a, tt.b, i, c, tt.want[i])
+			}
+		}
+	}
+}
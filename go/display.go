@@ -11,6 +11,16 @@ func Display(schedule *ScheduleData) string {
 
 	sb.WriteString(displayExpr(schedule.Expr))
 
+	if schedule.Offset != (Offset{}) {
+		sb.WriteString(" ")
+		sb.WriteString(displayOffset(schedule.Offset))
+	}
+
+	if schedule.Alignment != AlignmentDefault {
+		sb.WriteString(" aligned to ")
+		sb.WriteString(schedule.Alignment.String())
+	}
+
 	if len(schedule.Except) > 0 {
 		sb.WriteString(" except ")
 		sb.WriteString(displayExceptions(schedule.Except))
@@ -21,24 +31,31 @@ func Display(schedule *ScheduleData) string {
 		sb.WriteString(displayUntil(*schedule.Until))
 	}
 
-	if schedule.Anchor != "" {
+	if starting := displayStarting(schedule); starting != "" {
 		sb.WriteString(" starting ")
-		sb.WriteString(schedule.Anchor)
+		sb.WriteString(starting)
 	}
 
-	if len(schedule.During) > 0 {
+	if hasDuringClause(schedule) {
 		sb.WriteString(" during ")
-		sb.WriteString(displayMonthList(schedule.During))
+		sb.WriteString(displayDuring(schedule))
 	}
 
 	if schedule.Timezone != "" {
 		sb.WriteString(" in ")
-		sb.WriteString(schedule.Timezone)
+		sb.WriteString(strings.Join(schedule.AllTimezones(), ", "))
 	}
 
 	return sb.String()
 }
 
+func displayOffset(o Offset) string {
+	if o.Amount < 0 {
+		return fmt.Sprintf("minus %d %s", -o.Amount, unitDisplay(-o.Amount, o.Unit))
+	}
+	return fmt.Sprintf("plus %d %s", o.Amount, unitDisplay(o.Amount, o.Unit))
+}
+
 func displayExpr(expr ScheduleExpr) string {
 	switch expr.Kind {
 	case ScheduleExprKindInterval:
@@ -53,6 +70,11 @@ func displayExpr(expr ScheduleExpr) string {
 		return displaySingleDate(expr)
 	case ScheduleExprKindYear:
 		return displayYearRepeat(expr)
+	case ScheduleExprKindRandom:
+		return fmt.Sprintf("one random %s each %s at %s seeded by \"%s\"",
+			displayDayFilter(expr.Days), expr.Period, displayTimes(expr), expr.Seed)
+	case ScheduleExprKindFiscal:
+		return fmt.Sprintf("every fiscal %s on the %s at %s", expr.Fiscal, displayMonthTarget(expr.MonthTarget), displayTimes(expr))
 	default:
 		panic(fmt.Sprintf("unknown expression kind: %d", expr.Kind))
 	}
@@ -62,6 +84,9 @@ func displayIntervalRepeat(expr ScheduleExpr) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("every %d %s", expr.Interval, unitDisplay(expr.Interval, expr.Unit)))
 	sb.WriteString(fmt.Sprintf(" from %s to %s", expr.FromTime.String(), expr.ToTime.String()))
+	if expr.Overnight {
+		sb.WriteString(" next day")
+	}
 	if expr.DayFilter != nil {
 		sb.WriteString(" on ")
 		sb.WriteString(displayDayFilter(*expr.DayFilter))
@@ -71,38 +96,57 @@ func displayIntervalRepeat(expr ScheduleExpr) string {
 
 func displayDayRepeat(expr ScheduleExpr) string {
 	if expr.Interval > 1 {
-		return fmt.Sprintf("every %d days at %s", expr.Interval, formatTimeList(expr.Times))
+		return fmt.Sprintf("every %d days at %s", expr.Interval, displayTimes(expr))
 	}
-	return fmt.Sprintf("every %s at %s", displayDayFilter(expr.Days), formatTimeList(expr.Times))
+	return fmt.Sprintf("every %s at %s", displayDayFilter(expr.Days), displayTimes(expr))
 }
 
 func displayWeekRepeat(expr ScheduleExpr) string {
 	dayStr := formatDayList(expr.WeekDays)
 	if expr.Interval > 1 {
-		return fmt.Sprintf("every %d weeks on %s at %s", expr.Interval, dayStr, formatTimeList(expr.Times))
+		return fmt.Sprintf("every %d weeks on %s at %s", expr.Interval, dayStr, displayTimes(expr))
 	}
-	return fmt.Sprintf("every week on %s at %s", dayStr, formatTimeList(expr.Times))
+	return fmt.Sprintf("every week on %s at %s", dayStr, displayTimes(expr))
 }
 
 func displayMonthRepeat(expr ScheduleExpr) string {
-	targetStr := displayMonthTarget(expr.MonthTarget)
-	if expr.Interval > 1 {
-		return fmt.Sprintf("every %d months on the %s at %s", expr.Interval, targetStr, formatTimeList(expr.Times))
+	repeater := "every month"
+	switch n := expr.Interval / expr.MonthPeriod.Months(); {
+	case expr.MonthPeriod == MonthPeriodHalfYear:
+		repeater = "every half year"
+	case expr.MonthPeriod == MonthPeriodQuarter && n > 1:
+		repeater = fmt.Sprintf("every %d quarters", n)
+	case expr.MonthPeriod == MonthPeriodQuarter:
+		repeater = "every quarter"
+	case expr.Interval > 1:
+		repeater = fmt.Sprintf("every %d months", expr.Interval)
 	}
-	return fmt.Sprintf("every month on the %s at %s", targetStr, formatTimeList(expr.Times))
+	if expr.MonthTarget.Kind == MonthTargetKindWeekOfMonth {
+		return fmt.Sprintf("%s in the %s week on %s at %s", repeater, expr.MonthTarget.Ordinal.String(),
+			formatDayList(expr.MonthTarget.WeekDays), displayTimes(expr))
+	}
+	return fmt.Sprintf("%s on the %s at %s", repeater, displayMonthTarget(expr.MonthTarget), displayTimes(expr))
 }
 
 func displaySingleDate(expr ScheduleExpr) string {
-	dateStr := displayDateSpec(expr.DateSpec)
-	return fmt.Sprintf("on %s at %s", dateStr, formatTimeList(expr.Times))
+	var dates []string
+	for _, date := range expr.AllDateSpecs() {
+		dates = append(dates, displayDateSpec(date))
+	}
+	dateStr := strings.Join(dates, ", ")
+	return fmt.Sprintf("on %s at %s", dateStr, displayTimes(expr))
 }
 
 func displayYearRepeat(expr ScheduleExpr) string {
-	targetStr := displayYearTarget(expr.YearTarget)
+	var targets []string
+	for _, target := range expr.AllYearTargets() {
+		targets = append(targets, displayYearTarget(target))
+	}
+	targetStr := strings.Join(targets, ", ")
 	if expr.Interval > 1 {
-		return fmt.Sprintf("every %d years on %s at %s", expr.Interval, targetStr, formatTimeList(expr.Times))
+		return fmt.Sprintf("every %d years on %s at %s", expr.Interval, targetStr, displayTimes(expr))
 	}
-	return fmt.Sprintf("every year on %s at %s", targetStr, formatTimeList(expr.Times))
+	return fmt.Sprintf("every year on %s at %s", targetStr, displayTimes(expr))
 }
 
 func displayDayFilter(f DayFilter) string {
@@ -139,7 +183,11 @@ func displayMonthTarget(target MonthTarget) string {
 		sb.WriteString(fmt.Sprintf("nearest weekday to %s", ordinalNumber(target.Day)))
 		return sb.String()
 	case MonthTargetKindOrdinalWeekday:
-		return fmt.Sprintf("%s %s", target.Ordinal.String(), target.Weekday.String())
+		return formatOrdinalWeekdays(target.OrdinalWeekdays())
+	case MonthTargetKindBusinessDay:
+		return fmt.Sprintf("%s business day", ordinalNumber(target.Day))
+	case MonthTargetKindLastBusinessDay:
+		return "last business day"
 	default:
 		panic(fmt.Sprintf("unknown month target kind: %d", target.Kind))
 	}
@@ -155,6 +203,8 @@ func displayYearTarget(target YearTarget) string {
 		return fmt.Sprintf("the %s of %s", ordinalNumber(target.Day), target.Month.String())
 	case YearTargetKindLastWeekday:
 		return fmt.Sprintf("the last weekday of %s", target.Month.String())
+	case YearTargetKindDayOfYear:
+		return fmt.Sprintf("the %s day", ordinalNumber(target.Day))
 	default:
 		panic(fmt.Sprintf("unknown year target kind: %d", target.Kind))
 	}
@@ -166,6 +216,23 @@ func displayDateSpec(spec DateSpec) string {
 		return fmt.Sprintf("%s %d", spec.Month.String(), spec.Day)
 	case DateSpecKindISO:
 		return spec.Date
+	case DateSpecKindEvent:
+		return spec.Event
+	case DateSpecKindRelative:
+		unit := "day"
+		if spec.Unit == RelativeWeekdays {
+			unit = "weekday"
+		}
+		direction := "after"
+		n := spec.Offset
+		if n < 0 {
+			direction = "before"
+			n = -n
+		}
+		if n == 1 {
+			return fmt.Sprintf("the %s %s %s", unit, direction, displayDateSpec(*spec.Base))
+		}
+		return fmt.Sprintf("%d %ss %s %s", n, unit, direction, displayDateSpec(*spec.Base))
 	default:
 		panic(fmt.Sprintf("unknown date spec kind: %d", spec.Kind))
 	}
@@ -179,6 +246,8 @@ func displayExceptions(exceptions []ExceptionSpec) string {
 			parts[i] = fmt.Sprintf("%s %d", exc.Month.String(), exc.Day)
 		case ExceptionSpecKindISO:
 			parts[i] = exc.Date
+		case ExceptionSpecKindHolidays:
+			parts[i] = "holidays"
 		default:
 			panic(fmt.Sprintf("unknown exception spec kind: %d", exc.Kind))
 		}
@@ -186,25 +255,79 @@ func displayExceptions(exceptions []ExceptionSpec) string {
 	return strings.Join(parts, ", ")
 }
 
+// displayStarting returns the date of the starting clause, ISO or relative, or "" if
+// there is none.
+func displayStarting(schedule *ScheduleData) string {
+	if schedule.AnchorRelative != nil {
+		return schedule.AnchorRelative.String()
+	}
+	return schedule.Anchor
+}
+
 func displayUntil(until UntilSpec) string {
+	var date string
 	switch until.Kind {
 	case UntilSpecKindISO:
-		return until.Date
+		date = until.Date
 	case UntilSpecKindNamed:
-		return fmt.Sprintf("%s %d", until.Month.String(), until.Day)
+		date = fmt.Sprintf("%s %d", until.Month.String(), until.Day)
+	case UntilSpecKindRelative:
+		date = until.Relative.String()
 	default:
 		panic(fmt.Sprintf("unknown until spec kind: %d", until.Kind))
 	}
+	if until.Time != nil {
+		return date + " " + until.Time.String()
+	}
+	return date
 }
 
-func displayMonthList(months []MonthName) string {
-	parts := make([]string, len(months))
-	for i, m := range months {
-		parts[i] = m.String()
+// displayDuring lists quarters and half years first, then whole months, date windows,
+// week ranges, and year ranges.
+func displayDuring(schedule *ScheduleData) string {
+	months, windows, weeks := schedule.During, schedule.DuringDates, schedule.DuringWeeks
+	parts := make([]string, 0, len(schedule.DuringQuarters)+len(schedule.DuringHalves)+len(months)+len(windows)+1+len(schedule.DuringYears))
+	for _, q := range schedule.DuringQuarters {
+		parts = append(parts, fmt.Sprintf("q%d", q))
+	}
+	for _, h := range schedule.DuringHalves {
+		parts = append(parts, fmt.Sprintf("h%d", h))
+	}
+	for _, m := range months {
+		parts = append(parts, m.String())
+	}
+	for _, w := range windows {
+		parts = append(parts, fmt.Sprintf("%s %d to %s %d", w.FromMonth.String(), w.FromDay, w.ToMonth.String(), w.ToDay))
+	}
+	if len(weeks) > 0 {
+		ranges := make([]string, len(weeks))
+		for i, r := range weeks {
+			if r.From == r.To {
+				ranges[i] = fmt.Sprintf("%d", r.From)
+			} else {
+				ranges[i] = fmt.Sprintf("%d to %d", r.From, r.To)
+			}
+		}
+		parts = append(parts, "weeks "+strings.Join(ranges, ", "))
+	}
+	for _, r := range schedule.DuringYears {
+		if r.From == r.To {
+			parts = append(parts, fmt.Sprintf("%d", r.From))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d to %d", r.From, r.To))
+		}
 	}
 	return strings.Join(parts, ", ")
 }
 
+// displayTimes renders the `at` list of an expression: its times, or its solar event.
+func displayTimes(expr ScheduleExpr) string {
+	if expr.Solar != 0 {
+		return expr.Solar.String()
+	}
+	return formatTimeList(expr.Times)
+}
+
 func formatTimeList(times []TimeOfDay) string {
 	parts := make([]string, len(times))
 	for i, t := range times {
@@ -234,6 +357,19 @@ func formatOrdinalDaySpecs(specs []DayOfMonthSpec) string {
 	return strings.Join(parts, ", ")
 }
 
+// formatOrdinalWeekdays groups consecutive ordinals sharing a weekday: "first, third monday, last friday".
+func formatOrdinalWeekdays(pairs []OrdinalWeekday) string {
+	var parts []string
+	for i, pair := range pairs {
+		if i+1 < len(pairs) && pairs[i+1].Weekday == pair.Weekday {
+			parts = append(parts, pair.Ordinal.String())
+		} else {
+			parts = append(parts, fmt.Sprintf("%s %s", pair.Ordinal.String(), pair.Weekday.String()))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 func ordinalNumber(n int) string {
 	return fmt.Sprintf("%d%s", n, ordinalSuffix(n))
 }
@@ -255,15 +391,17 @@ func ordinalSuffix(n int) string {
 	}
 }
 
+// unitDisplay writes an interval unit as the spec's canonical forms do: singular in
+// full (every 1 minute) and plural as IntervalUnit.String spells it (every 30 min).
 func unitDisplay(interval int, unit IntervalUnit) string {
-	if unit == IntervalMin {
-		if interval == 1 {
-			return "minute"
-		}
-		return "min"
+	if interval != 1 {
+		return unit.String()
 	}
-	if interval == 1 {
-		return "hour"
+	switch unit {
+	case IntervalMin:
+		return "minute"
+	case IntervalSeconds:
+		return "second"
 	}
-	return "hours"
+	return "hour"
 }
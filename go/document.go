@@ -0,0 +1,138 @@
+package hron
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Document is a file of hron expressions parsed by ParseDocument.
+type Document struct {
+	Entries []DocumentEntry // The schedules that parsed, in file order
+	Errors  []DocumentError // One per line that did not, in file order
+}
+
+// DocumentEntry is one schedule of a Document.
+type DocumentEntry struct {
+	Name     string // The label before the expression, or "" for none
+	Line     int    // 1-based
+	Column   int    // 1-based byte column where the expression starts
+	Input    string // The expression, without label or comment
+	Schedule *Schedule
+}
+
+// DocumentError is a line of a Document that is not a schedule. Err is usually a
+// *HronError whose span is within the line's expression, which starts at Column; label
+// errors span the label within the line, and have Column 1.
+type DocumentError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *DocumentError) Unwrap() error {
+	return e.Err
+}
+
+// ParseDocument parses text holding one hron expression per line, such as an hrontab
+// file. A `#` outside a quoted string starts a comment running to the end of the line,
+// and blank and comment-only lines are skipped. An expression may be labelled with a
+// name and a colon, as in
+//
+//	# Reports go out before the standup
+//	reports: every weekday at 08:30 in America/New_York
+//	backup:  every day at 02:00 in UTC  # after the nightly batch
+//
+// Names start with a letter and hold letters, digits, `_`, `-`, and `.`; each may label
+// one line. A line that fails to parse is reported in Errors and the others still parse.
+func ParseDocument(text string) *Document {
+	doc := &Document{}
+	names := map[string]int{}
+	for i, line := range strings.Split(text, "\n") {
+		n := i + 1
+		line = strings.TrimSuffix(line, "\r")
+		body := stripComment(line)
+		if strings.TrimSpace(body) == "" {
+			continue
+		}
+
+		name, label := documentLabel(body)
+		start := label.End
+		if name != "" {
+			if first, ok := names[name]; ok {
+				msg := fmt.Sprintf("schedule name '%s' is already used on line %d", name, first)
+				doc.Errors = append(doc.Errors, DocumentError{n, 1, ParseError(msg, label, line, "")})
+				continue
+			}
+			names[name] = n
+		}
+		expr := strings.TrimSpace(body[start:])
+		column := start + strings.Index(body[start:], expr) + 1
+		if expr == "" {
+			msg := fmt.Sprintf("expected an expression after '%s:'", name)
+			doc.Errors = append(doc.Errors, DocumentError{n, 1, ParseError(msg, label, line, "")})
+			continue
+		}
+		s, err := ParseSchedule(expr)
+		if err != nil {
+			doc.Errors = append(doc.Errors, DocumentError{n, column, err})
+			continue
+		}
+		doc.Entries = append(doc.Entries, DocumentEntry{name, n, column, expr, s})
+	}
+	return doc
+}
+
+// Lookup returns the schedule labelled name, or nil if no parsed line has that name.
+func (d *Document) Lookup(name string) *Schedule {
+	for _, e := range d.Entries {
+		if e.Name == name && name != "" {
+			return e.Schedule
+		}
+	}
+	return nil
+}
+
+// Err returns the errors of the document joined with errors.Join, or nil if every line
+// parsed.
+func (d *Document) Err() error {
+	errs := make([]error, len(d.Errors))
+	for i := range d.Errors {
+		errs[i] = &d.Errors[i]
+	}
+	return errors.Join(errs...)
+}
+
+// stripComment returns line up to a `#` outside a quoted string.
+func stripComment(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case '#':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// documentLabel returns the name labelling line, if any, and the span of the name and
+// its colon.
+func documentLabel(line string) (string, Span) {
+	start := len(line) - len(strings.TrimLeft(line, " \t"))
+	end := start
+	for end < len(line) && (isAlphanumeric(line[end]) || strings.IndexByte("_-.", line[end]) >= 0) {
+		end++
+	}
+	if end == start || !isAlpha(line[start]) || end >= len(line) || line[end] != ':' {
+		return "", Span{}
+	}
+	return line[start:end], Span{start, end + 1}
+}
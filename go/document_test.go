@@ -0,0 +1,90 @@
+package hron
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDocument(t *testing.T) {
+	text := strings.Join([]string{
+		"# Team schedules",
+		"",
+		"reports: every weekday at 08:30 in America/New_York",
+		"  backup:  every day at 02:00 in UTC  # after the nightly batch",
+		"every monday at 09:00",
+		"broken: every mondy at 09:00",
+		"reports: every day at 10:00",
+		"empty:   # nothing here",
+		`holidays: every day at 09:00 except "new year # eve"`,
+		"every day at 12:00\r",
+	}, "\n")
+	doc := ParseDocument(text)
+
+	want := []struct {
+		name, input  string
+		line, column int
+	}{
+		{"reports", "every weekday at 08:30 in America/New_York", 3, 10},
+		{"backup", "every day at 02:00 in UTC", 4, 12},
+		{"", "every monday at 09:00", 5, 1},
+		{"", "every day at 12:00", 10, 1},
+	}
+	if len(doc.Entries) != len(want) {
+		t.Fatalf("entries = %+v, want %d", doc.Entries, len(want))
+	}
+	for i, w := range want {
+		e := doc.Entries[i]
+		if e.Name != w.name || e.Input != w.input || e.Line != w.line || e.Column != w.column {
+			t.Errorf("entry %d = %q %q line %d column %d, want %q %q line %d column %d",
+				i, e.Name, e.Input, e.Line, e.Column, w.name, w.input, w.line, w.column)
+		}
+	}
+	if s := doc.Lookup("backup"); s == nil || s.String() != "every day at 02:00 in UTC" {
+		t.Errorf("Lookup(backup) = %v", s)
+	}
+	if doc.Lookup("broken") != nil || doc.Lookup("") != nil {
+		t.Errorf("Lookup found a schedule for a failed line or no name")
+	}
+
+	wantErrs := []struct {
+		line    int
+		message string
+	}{
+		{6, "unknown keyword 'mondy'"},
+		{7, "schedule name 'reports' is already used on line 3"},
+		{8, "expected an expression after 'empty:'"},
+		{9, ""}, // The quoted # is not a comment; the except clause takes no string
+	}
+	if len(doc.Errors) != len(wantErrs) {
+		t.Fatalf("errors = %v, want %d", doc.Errors, len(wantErrs))
+	}
+	for i, w := range wantErrs {
+		e := doc.Errors[i]
+		var herr *HronError
+		if e.Line != w.line || !errors.As(&e, &herr) || (w.message != "" && herr.Message != w.message) {
+			t.Errorf("error %d = %v, want line %d: %s", i, &e, w.line, w.message)
+		}
+	}
+	if err := doc.Err(); err == nil || !strings.Contains(err.Error(), "line 6: unknown keyword 'mondy'") {
+		t.Errorf("Err() = %v", err)
+	}
+	if err := ParseDocument("# only comments\n\n").Err(); err != nil {
+		t.Errorf("Err() of a document without errors = %v", err)
+	}
+}
+
+func TestParseDocumentErrorSpans(t *testing.T) {
+	line := "nightly: every day at 25:00"
+	doc := ParseDocument(line)
+	if len(doc.Errors) != 1 {
+		t.Fatalf("errors = %v, want one", doc.Errors)
+	}
+	e := doc.Errors[0]
+	herr := e.Err.(*HronError)
+	// The span is within the expression, which starts at Column
+	got := line[e.Column-1+herr.Span.Start : e.Column-1+herr.Span.End]
+	if !strings.HasPrefix(got, "25") {
+		t.Errorf("error span covers %q, want the time", got)
+	}
+}
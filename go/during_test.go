@@ -0,0 +1,229 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuringDateWindowRoundtrip(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every day at 06:00 during jun 15 to aug 31", "every day at 06:00 during jun 15 to aug 31"},
+		{"every weekday at 08:00 during sep 1 to jun 20 in UTC", "every weekday at 08:00 during sep 1 to jun 20 in UTC"},
+		{"every day at 06:00 during jun 15th to aug 31st, dec", "every day at 06:00 during dec, jun 15 to aug 31"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDuringDateWindowErrors(t *testing.T) {
+	for _, input := range []string{
+		"every day at 06:00 during jun 31 to aug 31",
+		"every day at 06:00 during jun 15 aug 31",
+		"every day at 06:00 during jun 15 to aug",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestDuringDateWindowEval(t *testing.T) {
+	s := MustParse("every day at 06:00 during jun 15 to aug 31 in UTC")
+
+	next := s.NextFrom(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 6, 15, 6, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom before season = %v, want %v", next, want)
+	}
+	next = s.NextFrom(time.Date(2026, 8, 31, 7, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 6, 15, 6, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom after season = %v, want %v", next, want)
+	}
+	prev := s.PreviousFrom(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2025, 8, 31, 6, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom before season = %v, want %v", prev, want)
+	}
+	if s.Matches(time.Date(2026, 6, 14, 6, 0, 0, 0, time.UTC)) {
+		t.Error("jun 14 should not match")
+	}
+	if !s.Matches(time.Date(2026, 7, 4, 6, 0, 0, 0, time.UTC)) {
+		t.Error("jul 4 should match")
+	}
+}
+
+func TestDuringDateWindowWrapsYear(t *testing.T) {
+	s := MustParse("every day at 09:00 during dec 20 to jan 5 in UTC")
+
+	next := s.NextFrom(time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 12, 20, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+	got := s.NextNFrom(time.Date(2026, 12, 30, 10, 0, 0, 0, time.UTC), 7)
+	if last := got[len(got)-1]; !last.Equal(time.Date(2027, 12, 20, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextNFrom across new year ended at %v, want 2027-12-20", last)
+	}
+	prev := s.PreviousFrom(time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, want)
+	}
+}
+
+func TestDuringMonthsAndWindows(t *testing.T) {
+	s := MustParse("every day at 09:00 during feb, jun 15 to jun 20 in UTC")
+
+	next := s.NextFrom(time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+	next = s.NextFrom(time.Date(2026, 6, 20, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 2, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+}
+
+func TestDuringWeeksRoundtrip(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every weekday at 09:00 during weeks 10 to 20", "every weekday at 09:00 during weeks 10 to 20"},
+		{"every day at 09:00 during week 5", "every day at 09:00 during weeks 5"},
+		{"every day at 09:00 during weeks 1 to 10, 40, 50 to 2 in UTC", "every day at 09:00 during weeks 1 to 10, 40, 50 to 2 in UTC"},
+		{"every day at 09:00 during weeks 30, dec", "every day at 09:00 during dec, weeks 30"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDuringWeeksErrors(t *testing.T) {
+	for _, input := range []string{
+		"every day at 09:00 during weeks 0 to 10",
+		"every day at 09:00 during weeks 10 to 54",
+		"every day at 09:00 during weeks",
+		"every day at 09:00 during weeks 10 to",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestDuringWeeksEval(t *testing.T) {
+	// ISO week 10 of 2026 starts on monday mar 2; week 20 ends on sunday may 17
+	s := MustParse("every day at 09:00 during weeks 10 to 20 in UTC")
+
+	next := s.NextFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom before range = %v, want %v", next, want)
+	}
+	next = s.NextFrom(time.Date(2026, 5, 17, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 3, 8, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom after range = %v, want %v", next, want)
+	}
+	prev := s.PreviousFrom(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 5, 17, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom after range = %v, want %v", prev, want)
+	}
+	if !s.Matches(time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches inside range = false, want true")
+	}
+	if s.Matches(time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches in week 21 = true, want false")
+	}
+}
+
+func TestDuringWeek53(t *testing.T) {
+	// 2026 has 53 ISO weeks; the next such year is 2032
+	s := MustParse("every monday at 09:00 during weeks 53 in UTC")
+	next := s.NextFrom(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2032, 12, 27, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+}
+
+func TestDuringYearsRoundtrip(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every day at 09:00 during 2026 to 2028", "every day at 09:00 during 2026 to 2028"},
+		{"every day at 09:00 during 2027, jun in UTC", "every day at 09:00 during jun, 2027 in UTC"},
+		{"every day at 09:00 during weeks 10, 20, 2026", "every day at 09:00 during weeks 10, 20, 2026"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDuringYearsErrors(t *testing.T) {
+	for _, input := range []string{
+		"every day at 09:00 during 2028 to 2026",
+		"every day at 09:00 during 26 to 28",
+		"every day at 09:00 during 2026 to",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestDuringYearsEval(t *testing.T) {
+	s := MustParse("every day at 09:00 during jun, 2026 to 2027 in UTC")
+
+	next := s.NextFrom(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom before years = %v, want %v", next, want)
+	}
+	next = s.NextFrom(time.Date(2026, 6, 30, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 6, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom between seasons = %v, want %v", next, want)
+	}
+	if next := s.NextFrom(time.Date(2027, 6, 30, 10, 0, 0, 0, time.UTC)); next != nil {
+		t.Errorf("NextFrom after last year = %v, want nil", next)
+	}
+
+	prev := s.PreviousFrom(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 6, 30, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom after years = %v, want %v", prev, want)
+	}
+	if prev := s.PreviousFrom(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)); prev != nil {
+		t.Errorf("PreviousFrom before first year = %v, want nil", prev)
+	}
+
+	if !s.Matches(time.Date(2027, 6, 15, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches in bounded june = false, want true")
+	}
+	if s.Matches(time.Date(2028, 6, 15, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches after last year = true, want false")
+	}
+}
+
+func TestDuringYearsMonthTarget(t *testing.T) {
+	// Month targets that can cross month boundaries still honour the year bound
+	s := MustParse("every month on the last weekday at 17:00 during 2026 in UTC")
+	if next := s.NextFrom(time.Date(2026, 12, 31, 18, 0, 0, 0, time.UTC)); next != nil {
+		t.Errorf("NextFrom after bounded year = %v, want nil", next)
+	}
+}
@@ -0,0 +1,46 @@
+package hron
+
+// binaryVersion is the first byte of the binary form, so the encoding can change later.
+const binaryVersion = 1
+
+// MarshalText implements encoding.TextMarshaler with the canonical expression, so a
+// Schedule field encodes as a string in JSON, YAML, TOML, and similar formats. The
+// timezone bound with WithTimezone, the holiday calendar, and the evaluation options are
+// not part of the expression and are not encoded. It has a value receiver so a Schedule
+// field encodes too, not only a *Schedule. The zero Schedule has no expression and is an
+// error; tag an optional field omitzero or use a pointer.
+func (s Schedule) MarshalText() ([]byte, error) {
+	if s.data == nil {
+		return nil, EvalError("cannot encode a zero Schedule")
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by parsing an expression, replacing
+// the schedule.
+func (s *Schedule) UnmarshalText(text []byte) error {
+	parsed, err := ParseSchedule(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for gob and similar encoders: a
+// version byte followed by the canonical expression. It encodes what MarshalText does.
+func (s Schedule) MarshalBinary() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{binaryVersion}, text...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the output of MarshalBinary.
+func (s *Schedule) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return EvalError("unsupported binary schedule encoding")
+	}
+	return s.UnmarshalText(data[1:])
+}
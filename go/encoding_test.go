@@ -0,0 +1,119 @@
+package hron
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler     = Schedule{}
+	_ encoding.BinaryMarshaler   = Schedule{}
+	_ encoding.TextMarshaler     = (*Schedule)(nil)
+	_ encoding.TextUnmarshaler   = (*Schedule)(nil)
+	_ encoding.BinaryMarshaler   = (*Schedule)(nil)
+	_ encoding.BinaryUnmarshaler = (*Schedule)(nil)
+)
+
+type encodedJob struct {
+	Name     string
+	Schedule *Schedule
+}
+
+func TestScheduleJSON(t *testing.T) {
+	in := encodedJob{"report", MustParse("every weekday at 9:00 except dec 25 in America/New_York")}
+	payload, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Name":"report","Schedule":"every weekday at 09:00 except dec 25 in America/New_York"}`
+	if string(payload) != want {
+		t.Errorf("json.Marshal = %s, want %s", payload, want)
+	}
+
+	var out encodedJob
+	if err := json.Unmarshal(payload, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Schedule.String() != in.Schedule.String() {
+		t.Errorf("json round trip = %q, want %q", out.Schedule, in.Schedule)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Schedule":"every blue moon"}`), &out); err == nil {
+		t.Error("json.Unmarshal of an invalid expression succeeded")
+	}
+}
+
+func TestScheduleJSONValueField(t *testing.T) {
+	type job struct {
+		Name     string
+		Schedule Schedule
+	}
+	in := job{"report", *MustParse("every weekday at 9:00 in UTC")}
+	payload, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Name":"report","Schedule":"every weekday at 09:00 in UTC"}`
+	if string(payload) != want {
+		t.Errorf("json.Marshal = %s, want %s", payload, want)
+	}
+
+	var out job
+	if err := json.Unmarshal(payload, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Schedule.String() != in.Schedule.String() {
+		t.Errorf("json round trip = %q, want %q", out.Schedule.String(), in.Schedule.String())
+	}
+}
+
+func TestScheduleMarshalZero(t *testing.T) {
+	var zero Schedule
+	if _, err := zero.MarshalText(); err == nil {
+		t.Error("MarshalText of the zero Schedule succeeded, want error")
+	}
+	if _, err := zero.MarshalBinary(); err == nil {
+		t.Error("MarshalBinary of the zero Schedule succeeded, want error")
+	}
+	if _, err := json.Marshal(struct{ Schedule Schedule }{}); err == nil {
+		t.Error("json.Marshal of a zero Schedule field succeeded, want error")
+	}
+
+	payload, err := json.Marshal(struct {
+		Name     string
+		Schedule Schedule `json:",omitzero"`
+	}{Name: "idle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Name":"idle"}`; string(payload) != want {
+		t.Errorf("json.Marshal with omitzero = %s, want %s", payload, want)
+	}
+}
+
+func TestScheduleGob(t *testing.T) {
+	in := encodedJob{"sync", MustParse("every 15 min from 09:00 to 17:00 on weekdays in UTC")}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out encodedJob
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Schedule.String() != in.Schedule.String() {
+		t.Errorf("gob round trip = %q, want %q", out.Schedule, in.Schedule)
+	}
+}
+
+func TestScheduleUnmarshalBinaryErrors(t *testing.T) {
+	var s Schedule
+	for _, data := range [][]byte{nil, []byte("every day at 09:00"), append([]byte{binaryVersion}, "nonsense"...)} {
+		if err := s.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%q) succeeded, want error", data)
+		}
+	}
+}
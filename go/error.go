@@ -28,6 +28,8 @@ type HronError struct {
 	Span       *Span
 	Input      string
 	Suggestion string
+
+	cause error
 }
 
 // Error implements the error interface.
@@ -35,6 +37,11 @@ func (e *HronError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the sentinel error this error wraps, such as ErrLimitExceeded, or nil.
+func (e *HronError) Unwrap() error {
+	return e.cause
+}
+
 // LexError creates a new lexer error.
 func LexError(message string, span Span, input string) *HronError {
 	return &HronError{
@@ -1,15 +1,18 @@
 package hron
 
 import (
+	"context"
 	"iter"
+	"slices"
 	"time"
 )
 
 // =============================================================================
 // Iteration Safety Limits
 // =============================================================================
-// maxIterations (1000): Maximum iterations for nextFrom/previousFrom loops.
-// Prevents infinite loops when searching for valid occurrences.
+// maxIterations (1000): Default maximum iterations for nextFrom/previousFrom loops,
+// overridden by EvalOptions.MaxIterations. Prevents infinite loops when searching
+// for valid occurrences; running out returns an ErrLimitExceeded error.
 //
 // Expression-specific limits:
 // - Day repeat: 8 days (covers one week + margin)
@@ -21,6 +24,14 @@ import (
 // find occurrences within the first few iterations.
 // =============================================================================
 
+// =============================================================================
+// During Pruning
+// =============================================================================
+// Before generating a candidate, nextFrom/previousFrom move the search cursor
+// into the next (or previous) allowed month or date window, so no expression
+// kind produces candidates in excluded months only to have them rejected.
+// =============================================================================
+
 // =============================================================================
 // DST (Daylight Saving Time) Handling
 // =============================================================================
@@ -57,128 +68,210 @@ import (
 //
 // For week repeats, we use epoch Monday (1970-01-05) as the reference
 // point to align week boundaries correctly.
+//
+// The "aligned to" clause overrides the reference point:
+//   - epoch: ignore the starting anchor for alignment
+//   - week/month/year start: restart day counting at each period boundary
+//   - iso weeks: fire in ISO weeks 1, 1+n, 1+2n, ... of each ISO year
 // =============================================================================
 
 const maxIterations = 1000
 
 // nextFrom computes the next occurrence after now.
-func nextFrom(schedule *ScheduleData, loc *time.Location, now time.Time) *time.Time {
-	var untilDate *time.Time
-	if schedule.Until != nil {
-		ud := resolveUntil(*schedule.Until, now)
-		untilDate = &ud
+func nextFrom(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) *time.Time {
+	next, _ := nextFromCtx(context.Background(), p, cal, opts, now)
+	return next
+}
+
+// nextFromCtx computes the next occurrence after now, giving up with ctx.Err() once ctx
+// is done. It is checked before each candidate is generated. A search that runs out of
+// iterations or passes the horizon returns an ErrLimitExceeded error.
+func nextFromCtx(ctx context.Context, p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (*time.Time, error) {
+	next, ok, err := nextTimeCtx(ctx, p, cal, opts, now)
+	if !ok {
+		return nil, err
 	}
+	return &next, nil
+}
 
-	hasExceptions := len(schedule.Except) > 0
-	hasDuring := len(schedule.During) > 0
+// nextTimeCtx is nextFromCtx returning the occurrence by value, so that finding it
+// allocates nothing for day, week, and interval repeats.
+func nextTimeCtx(ctx context.Context, p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (time.Time, bool, error) {
+	if p.zones != nil {
+		return nextAcrossZones(ctx, p, cal, opts, now)
+	}
+	schedule, loc := p.data, p.loc
+	now = now.Add(-p.offset)
+	if p.solarDays != nil {
+		return nextSolar(ctx, p, cal, opts, now)
+	}
+	var until untilBound
+	hasUntil := schedule.Until != nil
+	if hasUntil {
+		until = resolveUntilBound(*schedule.Until, now, loc)
+	}
 
-	// Check if expression is NearestWeekday with direction (can cross month boundaries)
-	handlesDuringInternally := schedule.Expr.Kind == ScheduleExprKindMonth &&
-		schedule.Expr.MonthTarget.Kind == MonthTargetKindNearestWeekday &&
-		schedule.Expr.MonthTarget.Direction != NearestNone
+	hasExceptions, hasDuring := p.hasExceptions, p.hasDuring
+	handlesDuringInternally := p.duringInternally
 
 	current := now
 
-	for i := 0; i < maxIterations; i++ {
-		var candidate *time.Time
+	// A starting date that does not anchor the interval is a floor. Otherwise it only
+	// anchors the interval, as in the spec.
+	if p.floorAtStart && current.Before(p.start) {
+		current = p.start.Add(-time.Second)
+	}
+
+	horizon, hasHorizon := opts.horizon(now, 1)
+	for range opts.maxIterations() {
+		if err := ctx.Err(); err != nil {
+			return time.Time{}, false, err
+		}
+
+		// Start the scan in an allowed month rather than generating candidates that will be rejected
+		if hasDuring && !handlesDuringInternally {
+			if cur := current.In(loc); !matchesDuringClause(cur, schedule) {
+				skipTo := nextDuringDate(cur, schedule)
+				if skipTo.IsZero() {
+					return time.Time{}, false, nil
+				}
+				current = dayStart(schedule.Expr, skipTo, loc).Add(-time.Second)
+			}
+		}
+
+		var candidate time.Time
+		var found bool
 		if handlesDuringInternally {
-			candidate = nextExprWithDuring(schedule.Expr, loc, schedule.Anchor, current, schedule.During)
+			candidate, found = nextExprWithDuring(schedule.Expr, loc, cal, p.fiscal, p.events, p.anchor, schedule.Alignment, current, p.duringMonths)
 		} else {
-			candidate = nextExpr(schedule.Expr, loc, schedule.Anchor, current)
+			candidate, found = nextExpr(schedule.Expr, loc, cal, p.fiscal, p.events, p.anchor, schedule.Alignment, current)
 		}
-		if candidate == nil {
-			return nil
+		if !found {
+			return time.Time{}, false, nil
 		}
 
-		cDate := candidate.In(loc)
+		cDate := occurrenceDay(schedule.Expr, candidate.In(loc))
 
 		// Apply until filter
-		if untilDate != nil && dateOnly(cDate).After(dateOnly(*untilDate)) {
-			return nil
+		if hasUntil && until.excludes(cDate, candidate) {
+			return time.Time{}, false, nil
+		}
+		if hasHorizon && candidate.After(horizon) {
+			return time.Time{}, false, horizonError(opts)
 		}
 
 		// Apply during filter
-		// Skip this check for expressions that handle during internally (NearestWeekday with direction)
-		if hasDuring && !handlesDuringInternally && !matchesDuring(cDate, schedule.During) {
-			skipTo := nextDuringMonth(cDate, schedule.During)
-			midnight := atTimeOnDate(skipTo, TimeOfDay{0, 0}, loc)
-			current = midnight.Add(-time.Second)
+		// Skip this check for expressions that handle during internally
+		if hasDuring && !handlesDuringInternally && !matchesDuringClause(cDate, schedule) {
+			skipTo := nextDuringDate(cDate, schedule)
+			if skipTo.IsZero() {
+				return time.Time{}, false, nil
+			}
+			current = dayStart(schedule.Expr, skipTo, loc).Add(-time.Second)
 			continue
 		}
 
 		// Apply except filter
-		if hasExceptions && isExcepted(cDate, schedule.Except) {
-			nextDay := cDate.AddDate(0, 0, 1)
-			midnight := atTimeOnDate(nextDay, TimeOfDay{0, 0}, loc)
-			current = midnight.Add(-time.Second)
+		if hasExceptions && isExcepted(cDate, schedule.Except, cal) {
+			current = dayStart(schedule.Expr, cDate.AddDate(0, 0, 1), loc).Add(-time.Second)
+			continue
+		}
+
+		shifted := candidate.Add(p.offset)
+		if p.filter != nil && !p.filter(shifted) {
+			current = candidate
 			continue
 		}
 
-		return candidate
+		return shifted, true, nil
 	}
 
-	return nil
+	return time.Time{}, false, iterationsError(opts)
 }
 
 // nextExpr dispatches to the appropriate next function based on expression type.
-func nextExpr(expr ScheduleExpr, loc *time.Location, anchor string, now time.Time) *time.Time {
-	return nextExprWithDuring(expr, loc, anchor, now, nil)
+func nextExpr(expr ScheduleExpr, loc *time.Location, cal HolidayCalendar, fiscal *FiscalCalendar, events EventSource, anchor time.Time, alignment AlignmentKind, now time.Time) (time.Time, bool) {
+	return nextExprWithDuring(expr, loc, cal, fiscal, events, anchor, alignment, now, nil)
 }
 
 // nextExprWithDuring dispatches to the appropriate next function, passing during filter for special handling.
-func nextExprWithDuring(expr ScheduleExpr, loc *time.Location, anchor string, now time.Time, during []MonthName) *time.Time {
+func nextExprWithDuring(expr ScheduleExpr, loc *time.Location, cal HolidayCalendar, fiscal *FiscalCalendar, events EventSource, anchor time.Time, alignment AlignmentKind, now time.Time, during []MonthName) (time.Time, bool) {
 	switch expr.Kind {
 	case ScheduleExprKindDay:
-		return nextDayRepeat(expr.Interval, expr.Days, expr.Times, loc, anchor, now)
+		return nextDayRepeat(expr.Interval, expr.Days, expr.Times, loc, anchor, alignment, now)
 	case ScheduleExprKindInterval:
-		return nextIntervalRepeat(expr.Interval, expr.Unit, expr.FromTime, expr.ToTime, expr.DayFilter, loc, now)
+		return nextIntervalRepeat(expr.Interval, expr.Unit, expr.FromTime, expr.ToTime, expr.Overnight, expr.DayFilter, loc, now)
 	case ScheduleExprKindWeek:
-		return nextWeekRepeat(expr.Interval, expr.WeekDays, expr.Times, loc, anchor, now)
+		return nextWeekRepeat(expr.Interval, expr.WeekDays, expr.Times, loc, anchor, alignment, now)
 	case ScheduleExprKindMonth:
-		return nextMonthRepeatWithDuring(expr.Interval, expr.MonthTarget, expr.Times, loc, anchor, now, during)
+		return derefTime(nextMonthRepeatWithDuring(expr.Interval, expr.MonthTarget, expr.Times, loc, cal, anchor, alignment, now, during))
 	case ScheduleExprKindSingleDate:
-		return nextSingleDate(expr.DateSpec, expr.Times, loc, now)
+		return derefTime(nextSingleDates(expr.AllDateSpecs(), expr.Times, loc, events, now))
 	case ScheduleExprKindYear:
-		return nextYearRepeat(expr.Interval, expr.YearTarget, expr.Times, loc, anchor, now)
+		return derefTime(nextYearRepeat(expr.Interval, expr.AllYearTargets(), expr.Times, loc, anchor, now))
+	case ScheduleExprKindRandom:
+		return derefTime(nextRandomPick(expr, loc, now))
+	case ScheduleExprKindFiscal:
+		return derefTime(nextFiscalRepeat(expr, fiscal, loc, cal, now))
 	default:
-		return nil
+		return time.Time{}, false
+	}
+}
+
+// derefTime converts an optional occurrence to the value form.
+func derefTime(t *time.Time) (time.Time, bool) {
+	if t == nil {
+		return time.Time{}, false
 	}
+	return *t, true
 }
 
 // nextNFrom computes the next n occurrences after now.
-func nextNFrom(schedule *ScheduleData, loc *time.Location, now time.Time, n int) []time.Time {
+func nextNFrom(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time, n int) []time.Time {
 	var results []time.Time
 	current := now
 
 	for len(results) < n {
-		next := nextFrom(schedule, loc, current)
+		next := nextFrom(p, cal, opts, current)
 		if next == nil {
 			break
 		}
 		results = append(results, *next)
-		current = next.Add(time.Minute)
+		current = *next
 	}
 
 	return results
 }
 
 // matches checks if a datetime matches this schedule.
-func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
+func matches(p *evalPlan, cal HolidayCalendar, dt time.Time) bool {
+	if p.zones != nil {
+		return matchesAcrossZones(p, cal, dt)
+	}
+	if p.filter != nil && !p.filter(dt) {
+		return false
+	}
+	dt = dt.Add(-p.offset)
+	if p.solarDays != nil {
+		return matchesSolar(p, cal, dt)
+	}
+	schedule, loc := p.data, p.loc
 	zdt := dt.In(loc)
 	d := dateOnly(zdt)
+	day := occurrenceDay(schedule.Expr, zdt)
 
-	if !matchesDuring(d, schedule.During) {
+	if !matchesDuringClause(day, schedule) {
 		return false
 	}
-	if isExcepted(d, schedule.Except) {
+	if isExcepted(day, schedule.Except, cal) {
+		return false
+	}
+	if p.floorAtStart && dt.Before(p.start) {
 		return false
 	}
 
-	if schedule.Until != nil {
-		untilDate := resolveUntil(*schedule.Until, dt)
-		if d.After(dateOnly(untilDate)) {
-			return false
-		}
+	if schedule.Until != nil && resolveUntilBound(*schedule.Until, dt, loc).excludes(day, dt) {
+		return false
 	}
 
 	timeMatchesWithDST := func(times []TimeOfDay) bool {
@@ -195,6 +288,8 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		return false
 	}
 
+	anchor := p.anchor
+
 	switch schedule.Expr.Kind {
 	case ScheduleExprKindDay:
 		if !matchesDayFilter(d, schedule.Expr.Days) {
@@ -203,10 +298,13 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		if !timeMatchesWithDST(schedule.Expr.Times) {
 			return false
 		}
+		if schedule.Expr.Interval > 1 && isPeriodAlignment(schedule.Alignment) {
+			return dayAlignedToPeriod(d, schedule.Expr.Interval, schedule.Alignment)
+		}
 		if schedule.Expr.Interval > 1 {
 			anchorDate := epochDate
-			if schedule.Anchor != "" {
-				anchorDate, _ = parseISODate(schedule.Anchor)
+			if !anchor.IsZero() {
+				anchorDate = anchor
 			}
 			dayOffset := daysBetween(dateOnly(anchorDate), d)
 			return dayOffset >= 0 && dayOffset%schedule.Expr.Interval == 0
@@ -214,21 +312,22 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		return true
 
 	case ScheduleExprKindInterval:
-		if schedule.Expr.DayFilter != nil && !matchesDayFilter(d, *schedule.Expr.DayFilter) {
-			return false
-		}
-		fromMinutes := schedule.Expr.FromTime.TotalMinutes()
-		toMinutes := schedule.Expr.ToTime.TotalMinutes()
-		currentMinutes := zdt.Hour()*60 + zdt.Minute()
-		if currentMinutes < fromMinutes || currentMinutes > toMinutes {
+		fromSeconds, endSeconds := intervalWindowSeconds(schedule.Expr.FromTime, schedule.Expr.ToTime, schedule.Expr.Overnight)
+		current := secondOfDay(zdt)
+		if schedule.Expr.Unit != IntervalSeconds {
+			current -= zdt.Second()
+		}
+		// After midnight, a wrapping window belongs to the day it started
+		owner := d
+		if current < fromSeconds {
+			owner = d.AddDate(0, 0, -1)
+			current += secondsPerDay
+		}
+		if schedule.Expr.DayFilter != nil && !matchesDayFilter(owner, *schedule.Expr.DayFilter) {
 			return false
 		}
-		diff := currentMinutes - fromMinutes
-		step := schedule.Expr.Interval
-		if schedule.Expr.Unit == IntervalHours {
-			step = schedule.Expr.Interval * 60
-		}
-		return diff >= 0 && diff%step == 0
+		diff := current - fromSeconds
+		return diff >= 0 && current <= endSeconds && diff%schedule.Expr.Unit.Seconds(schedule.Expr.Interval) == 0
 
 	case ScheduleExprKindWeek:
 		dow := isoWeekday(d)
@@ -245,9 +344,12 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		if !timeMatchesWithDST(schedule.Expr.Times) {
 			return false
 		}
+		if schedule.Alignment == AlignmentISOWeeks {
+			return isoWeekOffset(d)%schedule.Expr.Interval == 0
+		}
 		anchorDate := epochMonday
-		if schedule.Anchor != "" {
-			anchorDate, _ = parseISODate(schedule.Anchor)
+		if !anchor.IsZero() {
+			anchorDate = anchor
 		}
 		weeks := weeksBetween(dateOnly(anchorDate), d)
 		return weeks >= 0 && weeks%schedule.Expr.Interval == 0
@@ -258,11 +360,14 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		}
 		if schedule.Expr.Interval > 1 {
 			anchorDate := epochDate
-			if schedule.Anchor != "" {
-				anchorDate, _ = parseISODate(schedule.Anchor)
+			if !anchor.IsZero() {
+				anchorDate = anchor
+			}
+			monthStart := d
+			if schedule.Expr.MonthTarget.Kind == MonthTargetKindWeekOfMonth {
+				monthStart = weekOfMonthOwner(d)
 			}
-			monthOffset := monthsBetweenYM(dateOnly(anchorDate), d)
-			if monthOffset < 0 || monthOffset%schedule.Expr.Interval != 0 {
+			if !monthOnGrid(monthStart, anchorDate, schedule.Expr.Interval, schedule.Alignment) {
 				return false
 			}
 		}
@@ -288,18 +393,20 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 			}
 			return d.Year() == nwd.Year() && d.Month() == nwd.Month() && d.Day() == nwd.Day()
 		case MonthTargetKindOrdinalWeekday:
-			var ordinalTarget time.Time
-			var ok bool
-			if schedule.Expr.MonthTarget.Ordinal == Last {
-				ordinalTarget = lastWeekdayInMonth(d.Year(), d.Month(), schedule.Expr.MonthTarget.Weekday)
-				ok = true
-			} else {
-				ordinalTarget, ok = nthWeekdayOfMonth(d.Year(), d.Month(), schedule.Expr.MonthTarget.Weekday, schedule.Expr.MonthTarget.Ordinal.ToN())
-			}
-			if !ok {
-				return false
+			for _, pair := range schedule.Expr.MonthTarget.OrdinalWeekdays() {
+				if od, ok := ordinalWeekdayOfMonth(d.Year(), d.Month(), pair); ok && d.Day() == od.Day() {
+					return true
+				}
 			}
-			return d.Day() == ordinalTarget.Day()
+			return false
+		case MonthTargetKindWeekOfMonth:
+			return matchesWeekOfMonth(d, schedule.Expr.MonthTarget.Ordinal, schedule.Expr.MonthTarget.WeekDays)
+		case MonthTargetKindBusinessDay:
+			bd, ok := businessDayOfMonth(d.Year(), d.Month(), schedule.Expr.MonthTarget.Day, cal)
+			return ok && d.Day() == bd.Day()
+		case MonthTargetKindLastBusinessDay:
+			bd, ok := lastBusinessDayOfMonth(d.Year(), d.Month(), cal)
+			return ok && d.Day() == bd.Day()
 		}
 		return false
 
@@ -307,14 +414,9 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		if !timeMatchesWithDST(schedule.Expr.Times) {
 			return false
 		}
-		switch schedule.Expr.DateSpec.Kind {
-		case DateSpecKindISO:
-			isoTarget, _ := parseISODate(schedule.Expr.DateSpec.Date)
-			return d.Year() == isoTarget.Year() && d.Month() == isoTarget.Month() && d.Day() == isoTarget.Day()
-		case DateSpecKindNamed:
-			return int(d.Month()) == schedule.Expr.DateSpec.Month.Number() && d.Day() == schedule.Expr.DateSpec.Day
-		}
-		return false
+		return slices.ContainsFunc(schedule.Expr.AllDateSpecs(), func(spec DateSpec) bool {
+			return matchesDateSpec(spec, d, p.events)
+		})
 
 	case ScheduleExprKindYear:
 		if !timeMatchesWithDST(schedule.Expr.Times) {
@@ -322,21 +424,54 @@ func matches(schedule *ScheduleData, loc *time.Location, dt time.Time) bool {
 		}
 		if schedule.Expr.Interval > 1 {
 			anchorYear := epochDate.Year()
-			if schedule.Anchor != "" {
-				anchorDate, _ := parseISODate(schedule.Anchor)
-				anchorYear = anchorDate.Year()
+			if !anchor.IsZero() {
+				anchorYear = anchor.Year()
 			}
 			yearOffset := d.Year() - anchorYear
 			if yearOffset < 0 || yearOffset%schedule.Expr.Interval != 0 {
 				return false
 			}
 		}
-		return matchesYearTarget(schedule.Expr.YearTarget, d)
+		return slices.ContainsFunc(schedule.Expr.AllYearTargets(), func(target YearTarget) bool {
+			return matchesYearTarget(target, d)
+		})
+
+	case ScheduleExprKindRandom:
+		if !timeMatchesWithDST(schedule.Expr.Times) {
+			return false
+		}
+		pick, ok := randomPick(schedule.Expr, d)
+		return ok && pick.Equal(d)
+
+	case ScheduleExprKindFiscal:
+		if !timeMatchesWithDST(schedule.Expr.Times) {
+			return false
+		}
+		return matchesFiscalTarget(schedule.Expr, p.fiscal, cal, d)
 	}
 
 	return false
 }
 
+// matchesDateSpec checks if a date is the one spec names.
+func matchesDateSpec(spec DateSpec, d time.Time, events EventSource) bool {
+	switch spec.Kind {
+	case DateSpecKindISO:
+		isoTarget, _ := parseISODate(spec.Date)
+		return d.Year() == isoTarget.Year() && d.Month() == isoTarget.Month() && d.Day() == isoTarget.Day()
+	case DateSpecKindNamed:
+		return int(d.Month()) == spec.Month.Number() && d.Day() == spec.Day
+	case DateSpecKindEvent, DateSpecKindRelative:
+		// Relative dates can cross into a neighbouring year
+		for year := d.Year() - 1; year <= d.Year()+1; year++ {
+			if rd, ok := resolveDateSpec(spec, year, events); ok && rd.Equal(d) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // matchesYearTarget checks if a date matches a year target.
 func matchesYearTarget(target YearTarget, d time.Time) bool {
 	switch target.Kind {
@@ -366,42 +501,54 @@ func matchesYearTarget(target YearTarget, d time.Time) bool {
 		}
 		lwd := lastWeekdayOfMonth(d.Year(), d.Month())
 		return d.Day() == lwd.Day()
+	case YearTargetKindDayOfYear:
+		return d.YearDay() == target.Day
 	}
 	return false
 }
 
 // --- Per-variant next functions ---
 
-func nextDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func nextDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Location, anchor time.Time, alignment AlignmentKind, now time.Time) (time.Time, bool) {
 	nowInTz := now.In(loc)
 	d := dateOnly(nowInTz)
 
 	if interval <= 1 {
 		// Original behavior for interval=1
 		if matchesDayFilter(d, days) {
-			candidate := earliestFutureAtTimes(d, times, loc, now)
-			if candidate != nil {
-				return candidate
+			if candidate, ok := earliestFutureAt(d, times, loc, now); ok {
+				return candidate, true
 			}
 		}
 
 		for i := 0; i < 8; i++ {
 			d = d.AddDate(0, 0, 1)
 			if matchesDayFilter(d, days) {
-				candidate := earliestFutureAtTimes(d, times, loc, now)
-				if candidate != nil {
-					return candidate
+				if candidate, ok := earliestFutureAt(d, times, loc, now); ok {
+					return candidate, true
 				}
 			}
 		}
 
-		return nil
+		return time.Time{}, false
 	}
 
 	// Interval > 1: day intervals only apply to DayFilter::Every
+	if isPeriodAlignment(alignment) {
+		for i := 0; i < 400; i++ {
+			if dayAlignedToPeriod(d, interval, alignment) {
+				if candidate, ok := earliestFutureAt(d, times, loc, now); ok {
+					return candidate, true
+				}
+			}
+			d = d.AddDate(0, 0, 1)
+		}
+		return time.Time{}, false
+	}
+
 	anchorDate := epochDate
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 
 	// Find the next aligned day >= today
@@ -416,26 +563,26 @@ func nextDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Lo
 	}
 
 	for i := 0; i < 400; i++ {
-		candidate := earliestFutureAtTimes(alignedDate, times, loc, now)
-		if candidate != nil {
-			return candidate
+		if candidate, ok := earliestFutureAt(alignedDate, times, loc, now); ok {
+			return candidate, true
 		}
 		alignedDate = alignedDate.AddDate(0, 0, interval)
 	}
 
-	return nil
+	return time.Time{}, false
 }
 
-func nextIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOfDay, dayFilter *DayFilter, loc *time.Location, now time.Time) *time.Time {
+func nextIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOfDay, overnight bool, dayFilter *DayFilter, loc *time.Location, now time.Time) (time.Time, bool) {
 	nowInTz := now.In(loc)
-	stepMinutes := interval
-	if unit == IntervalHours {
-		stepMinutes = interval * 60
-	}
-	fromMinutes := fromTime.TotalMinutes()
-	toMinutes := toTime.TotalMinutes()
+	stepSeconds := unit.Seconds(interval)
+	fromSeconds, endSeconds := intervalWindowSeconds(fromTime, toTime, overnight)
 
-	d := dateOnly(nowInTz)
+	today := dateOnly(nowInTz)
+	d := today
+	// A window that wraps past midnight may have started yesterday
+	if endSeconds > secondsPerDay {
+		d = d.AddDate(0, 0, -1)
+	}
 
 	for i := 0; i < 400; i++ {
 		if dayFilter != nil && !matchesDayFilter(d, *dayFilter) {
@@ -443,55 +590,43 @@ func nextIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOf
 			continue
 		}
 
-		sameDay := d.Year() == nowInTz.Year() && d.Month() == nowInTz.Month() && d.Day() == nowInTz.Day()
-		nowMinutes := -1
-		if sameDay {
-			nowMinutes = nowInTz.Hour()*60 + nowInTz.Minute()
+		// Seconds from the start of d to now, on the wall clock
+		nowSeconds := -1
+		if !d.After(today) {
+			nowSeconds = daysBetween(d, today)*secondsPerDay + secondOfDay(nowInTz)
 		}
 
 		var nextSlot int
-		if nowMinutes < fromMinutes {
-			nextSlot = fromMinutes
+		if nowSeconds < fromSeconds {
+			nextSlot = fromSeconds
 		} else {
-			elapsed := nowMinutes - fromMinutes
-			nextSlot = fromMinutes + (elapsed/stepMinutes+1)*stepMinutes
+			elapsed := nowSeconds - fromSeconds
+			nextSlot = fromSeconds + (elapsed/stepSeconds+1)*stepSeconds
 		}
 
-		if nextSlot <= toMinutes {
-			h := nextSlot / 60
-			m := nextSlot % 60
-			candidate := atTimeOnDate(d, TimeOfDay{h, m}, loc)
+		if nextSlot <= endSeconds {
+			candidate := atSecondOnDate(d.AddDate(0, 0, nextSlot/secondsPerDay), nextSlot%secondsPerDay, loc)
 			if candidate.After(now) {
-				return &candidate
+				return candidate, true
 			}
 		}
 
 		d = d.AddDate(0, 0, 1)
 	}
 
-	return nil
+	return time.Time{}, false
 }
 
-func nextWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func nextWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.Location, anchor time.Time, alignment AlignmentKind, now time.Time) (time.Time, bool) {
 	nowInTz := now.In(loc)
 	anchorDate := epochMonday
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 
 	d := dateOnly(nowInTz)
 
-	// Sort target DOWs for earliest-first matching
-	sortedDays := make([]Weekday, len(days))
-	copy(sortedDays, days)
-	// Simple bubble sort
-	for i := 0; i < len(sortedDays)-1; i++ {
-		for j := i + 1; j < len(sortedDays); j++ {
-			if sortedDays[i].Number() > sortedDays[j].Number() {
-				sortedDays[i], sortedDays[j] = sortedDays[j], sortedDays[i]
-			}
-		}
-	}
+	targetDays := newWeekdaySet(days)
 
 	// Find Monday of current week and Monday of anchor week
 	dowOffset := (isoWeekday(d) - 1)
@@ -509,14 +644,30 @@ func nextWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.L
 			continue
 		}
 
+		// ISO week numbers restart every year, so step one week at a time
+		if alignment == AlignmentISOWeeks {
+			if isoWeekOffset(currentMonday)%interval == 0 {
+				for dayOffset := range 7 {
+					if !targetDays.has(dayOffset + 1) {
+						continue
+					}
+					if candidate, ok := earliestFutureAt(currentMonday.AddDate(0, 0, dayOffset), times, loc, now); ok {
+						return candidate, true
+					}
+				}
+			}
+			currentMonday = currentMonday.AddDate(0, 0, 7)
+			continue
+		}
+
 		if weeks%interval == 0 {
-			// Aligned week — try each target DOW
-			for _, wd := range sortedDays {
-				dayOffset := wd.Number() - 1
-				targetDate := currentMonday.AddDate(0, 0, dayOffset)
-				candidate := earliestFutureAtTimes(targetDate, times, loc, now)
-				if candidate != nil {
-					return candidate
+			// Aligned week — try each target DOW, earliest first
+			for dayOffset := range 7 {
+				if !targetDays.has(dayOffset + 1) {
+					continue
+				}
+				if candidate, ok := earliestFutureAt(currentMonday.AddDate(0, 0, dayOffset), times, loc, now); ok {
+					return candidate, true
 				}
 			}
 		}
@@ -530,35 +681,41 @@ func nextWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.L
 		currentMonday = currentMonday.AddDate(0, 0, skipWeeks*7)
 	}
 
-	return nil
+	return time.Time{}, false
 }
 
-func nextMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
-	return nextMonthRepeatWithDuring(interval, target, times, loc, anchor, now, nil)
+func nextMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, cal HolidayCalendar, anchor time.Time, alignment AlignmentKind, now time.Time) *time.Time {
+	return nextMonthRepeatWithDuring(interval, target, times, loc, cal, anchor, alignment, now, nil)
 }
 
-func nextMonthRepeatWithDuring(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, anchor string, now time.Time, during []MonthName) *time.Time {
+func nextMonthRepeatWithDuring(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, cal HolidayCalendar, anchor time.Time, alignment AlignmentKind, now time.Time, during []MonthName) *time.Time {
 	nowInTz := now.In(loc)
 	year := nowInTz.Year()
 	month := int(nowInTz.Month())
 
 	anchorDate := epochDate
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 	maxIter := 24 * interval
 	if interval <= 1 {
 		maxIter = 24
 	}
 
-	// For NearestWeekday with direction, we need to apply the during filter here
-	// because the result can cross month boundaries
-	applyDuringFilter := len(during) > 0 &&
-		target.Kind == MonthTargetKindNearestWeekday &&
-		target.Direction != NearestNone
+	// Targets that can cross month boundaries need the during filter applied here
+	applyDuringFilter := len(during) > 0 && crossesMonthBoundary(target)
+
+	// A week of the month can start in the previous month
+	if target.Kind == MonthTargetKindWeekOfMonth {
+		month--
+		if month < 1 {
+			month = 12
+			year--
+		}
+	}
 
 	for i := 0; i < maxIter; i++ {
-		// Check during filter for NearestWeekday with direction
+		// Check during filter for targets that cross month boundaries
 		if applyDuringFilter {
 			found := false
 			for _, mn := range during {
@@ -578,17 +735,13 @@ func nextMonthRepeatWithDuring(interval int, target MonthTarget, times []TimeOfD
 		}
 
 		// Check interval alignment
-		if interval > 1 {
-			cur := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-			monthOffset := monthsBetweenYM(dateOnly(anchorDate), cur)
-			if monthOffset < 0 || monthOffset%interval != 0 {
-				month++
-				if month > 12 {
-					month = 1
-					year++
-				}
-				continue
+		if interval > 1 && !monthOnGrid(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), anchorDate, interval, alignment) {
+			month++
+			if month > 12 {
+				month = 1
+				year++
 			}
+			continue
 		}
 
 		var dateCandidates []time.Time
@@ -611,13 +764,21 @@ func nextMonthRepeatWithDuring(interval int, target MonthTarget, times []TimeOfD
 				dateCandidates = append(dateCandidates, nwd)
 			}
 		case MonthTargetKindOrdinalWeekday:
-			if target.Ordinal == Last {
-				dateCandidates = append(dateCandidates, lastWeekdayInMonth(year, time.Month(month), target.Weekday))
-			} else {
-				if od, ok := nthWeekdayOfMonth(year, time.Month(month), target.Weekday, target.Ordinal.ToN()); ok {
+			for _, pair := range target.OrdinalWeekdays() {
+				if od, ok := ordinalWeekdayOfMonth(year, time.Month(month), pair); ok {
 					dateCandidates = append(dateCandidates, od)
 				}
 			}
+		case MonthTargetKindWeekOfMonth:
+			dateCandidates = append(dateCandidates, weekOfMonthDates(year, time.Month(month), target.Ordinal, target.WeekDays)...)
+		case MonthTargetKindBusinessDay:
+			if bd, ok := businessDayOfMonth(year, time.Month(month), target.Day, cal); ok {
+				dateCandidates = append(dateCandidates, bd)
+			}
+		case MonthTargetKindLastBusinessDay:
+			if bd, ok := lastBusinessDayOfMonth(year, time.Month(month), cal); ok {
+				dateCandidates = append(dateCandidates, bd)
+			}
 		}
 
 		var best *time.Time
@@ -641,7 +802,18 @@ func nextMonthRepeatWithDuring(interval int, target MonthTarget, times []TimeOfD
 	return nil
 }
 
-func nextSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, now time.Time) *time.Time {
+// nextSingleDates returns the earliest next occurrence among several dates.
+func nextSingleDates(dates []DateSpec, times []TimeOfDay, loc *time.Location, events EventSource, now time.Time) *time.Time {
+	var best *time.Time
+	for _, date := range dates {
+		if candidate := nextSingleDate(date, times, loc, events, now); candidate != nil && (best == nil || candidate.Before(*best)) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func nextSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, events EventSource, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 
 	switch dateSpec.Kind {
@@ -663,18 +835,29 @@ func nextSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, no
 			}
 		}
 		return nil
+	case DateSpecKindEvent, DateSpecKindRelative:
+		// Start a year early: "the weekday before jan 1" falls in the previous year
+		for year := nowInTz.Year() - 1; year < nowInTz.Year()+8; year++ {
+			d, ok := resolveDateSpec(dateSpec, year, events)
+			if !ok {
+				continue
+			}
+			if candidate := earliestFutureAtTimes(d, times, loc, now); candidate != nil {
+				return candidate
+			}
+		}
+		return nil
 	}
 
 	return nil
 }
 
-func nextYearRepeat(interval int, target YearTarget, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func nextYearRepeat(interval int, targets []YearTarget, times []TimeOfDay, loc *time.Location, anchor time.Time, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	startYear := nowInTz.Year()
 	anchorYear := epochDate.Year()
-	if anchor != "" {
-		anchorDate, _ := parseISODate(anchor)
-		anchorYear = anchorDate.Year()
+	if !anchor.IsZero() {
+		anchorYear = anchor.Year()
 	}
 
 	maxIter := 8 * interval
@@ -693,40 +876,47 @@ func nextYearRepeat(interval int, target YearTarget, times []TimeOfDay, loc *tim
 			}
 		}
 
-		var targetDate time.Time
-		var valid bool
-
-		switch target.Kind {
-		case YearTargetKindDate:
-			targetDate = time.Date(year, time.Month(target.Month.Number()), target.Day, 0, 0, 0, 0, time.UTC)
-			// Validate the date
-			valid = targetDate.Month() == time.Month(target.Month.Number()) && targetDate.Day() == target.Day
-		case YearTargetKindOrdinalWeekday:
-			if target.Ordinal == Last {
-				targetDate = lastWeekdayInMonth(year, time.Month(target.Month.Number()), target.Weekday)
-				valid = true
-			} else {
-				targetDate, valid = nthWeekdayOfMonth(year, time.Month(target.Month.Number()), target.Weekday, target.Ordinal.ToN())
+		// The targets of a year can come in any order; take the earliest
+		var best *time.Time
+		for _, target := range targets {
+			targetDate, valid := yearTargetDate(target, year)
+			if !valid {
+				continue
 			}
-		case YearTargetKindDayOfMonth:
-			targetDate = time.Date(year, time.Month(target.Month.Number()), target.Day, 0, 0, 0, 0, time.UTC)
-			valid = targetDate.Month() == time.Month(target.Month.Number()) && targetDate.Day() == target.Day
-		case YearTargetKindLastWeekday:
-			targetDate = lastWeekdayOfMonth(year, time.Month(target.Month.Number()))
-			valid = true
-		}
-
-		if valid {
-			candidate := earliestFutureAtTimes(targetDate, times, loc, now)
-			if candidate != nil {
-				return candidate
+			if candidate := earliestFutureAtTimes(targetDate, times, loc, now); candidate != nil && (best == nil || candidate.Before(*best)) {
+				best = candidate
 			}
 		}
+		if best != nil {
+			return best
+		}
 	}
 
 	return nil
 }
 
+// yearTargetDate returns the day target falls on in year, or false if it has none that
+// year (feb 29, the 366th day).
+func yearTargetDate(target YearTarget, year int) (time.Time, bool) {
+	month := time.Month(target.Month.Number())
+	switch target.Kind {
+	case YearTargetKindDate, YearTargetKindDayOfMonth:
+		d := time.Date(year, month, target.Day, 0, 0, 0, 0, time.UTC)
+		return d, d.Month() == month && d.Day() == target.Day
+	case YearTargetKindOrdinalWeekday:
+		if target.Ordinal == Last {
+			return lastWeekdayInMonth(year, month, target.Weekday), true
+		}
+		return nthWeekdayOfMonth(year, month, target.Weekday, target.Ordinal.ToN())
+	case YearTargetKindLastWeekday:
+		return lastWeekdayOfMonth(year, month), true
+	case YearTargetKindDayOfYear:
+		d := time.Date(year, time.January, target.Day, 0, 0, 0, 0, time.UTC)
+		return d, d.Year() == year
+	}
+	return time.Time{}, false
+}
+
 // --- Iterator functions ---
 
 // Occurrences returns a lazy iterator of occurrences starting after `from`.
@@ -740,8 +930,27 @@ func Occurrences(schedule *Schedule, from time.Time) iter.Seq[time.Time] {
 			if next == nil {
 				return
 			}
-			// Advance cursor by 1 minute to avoid returning same occurrence
-			current = next.Add(time.Minute)
+			// NextFrom is strictly after its argument, so the occurrence itself is the cursor
+			current = *next
+			if !yield(*next) {
+				return
+			}
+		}
+	}
+}
+
+// OccurrencesCtx returns a lazy iterator of occurrences starting after `from` that
+// stops once ctx is done, including in the middle of a long search. Check ctx.Err()
+// after the loop to tell cancellation from the end of the schedule.
+func OccurrencesCtx(ctx context.Context, schedule *Schedule, from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		current := from
+		for {
+			next, err := schedule.NextFromCtx(ctx, current)
+			if err != nil || next == nil {
+				return
+			}
+			current = *next
 			if !yield(*next) {
 				return
 			}
@@ -764,77 +973,159 @@ func Between(schedule *Schedule, from, to time.Time) iter.Seq[time.Time] {
 	}
 }
 
+// OccurrencesBefore returns a lazy iterator of occurrences strictly before `from`, most
+// recent first. It ends at the schedule's starting anchor or first single date, and is
+// otherwise unbounded.
+func OccurrencesBefore(schedule *Schedule, from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		current := from
+		for {
+			prev := schedule.PreviousFrom(current)
+			if prev == nil {
+				return
+			}
+			// PreviousFrom is strictly before its argument, so the occurrence itself is the cursor
+			current = *prev
+			if !yield(*prev) {
+				return
+			}
+		}
+	}
+}
+
+// BetweenReverse returns a bounded iterator of the occurrences Between yields, where
+// `from < occurrence <= to`, most recent first.
+func BetweenReverse(schedule *Schedule, from, to time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		// Start just after `to` so an occurrence at `to` is included
+		for dt := range OccurrencesBefore(schedule, to.Add(time.Nanosecond)) {
+			if !dt.After(from) {
+				return
+			}
+			if !yield(dt) {
+				return
+			}
+		}
+	}
+}
+
+// BetweenPrevious returns the occurrences where `from < occurrence <= to`, most recent
+// first. To page further back, pass the oldest returned occurrence less a nanosecond as
+// the next `to`.
+func BetweenPrevious(schedule *Schedule, from, to time.Time) []time.Time {
+	return slices.Collect(BetweenReverse(schedule, from, to))
+}
+
 // --- Previous From ---
 
 // previousFrom computes the most recent occurrence strictly before now.
-func previousFrom(schedule *ScheduleData, loc *time.Location, now time.Time) *time.Time {
-	hasExceptions := len(schedule.Except) > 0
-	hasDuring := len(schedule.During) > 0
+func previousFrom(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) *time.Time {
+	prev, _ := previousFromErr(p, cal, opts, now)
+	return prev
+}
+
+// previousFromErr computes the most recent occurrence strictly before now. A search that
+// runs out of iterations or passes the horizon returns an ErrLimitExceeded error.
+func previousFromErr(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (*time.Time, error) {
+	if p.zones != nil {
+		return prevAcrossZones(p, cal, opts, now)
+	}
+	schedule, loc := p.data, p.loc
+	now = now.Add(-p.offset)
+	if p.solarDays != nil {
+		return prevSolar(p, cal, opts, now)
+	}
+	hasExceptions, hasDuring := p.hasExceptions, p.hasDuring
 
 	current := now
 
-	for i := 0; i < maxIterations; i++ {
-		candidate := prevExpr(schedule.Expr, loc, schedule.Anchor, current)
+	horizon, hasHorizon := opts.horizon(now, -1)
+	for range opts.maxIterations() {
+		if hasDuring {
+			if cur := current.In(loc); !matchesDuringClause(cur, schedule) {
+				skipTo := prevDuringDate(cur, schedule)
+				if skipTo.IsZero() {
+					return nil, nil
+				}
+				current = dayEnd(schedule.Expr, skipTo, loc).Add(time.Second)
+			}
+		}
+
+		candidate := prevExpr(schedule.Expr, loc, cal, p.fiscal, p.events, p.anchor, schedule.Alignment, current)
 		if candidate == nil {
-			return nil
+			return nil, nil
 		}
 
-		cDate := candidate.In(loc)
+		cDate := occurrenceDay(schedule.Expr, candidate.In(loc))
 
 		// Check starting anchor - if before anchor, no previous occurrence
-		if schedule.Anchor != "" {
-			anchorDate, _ := parseISODate(schedule.Anchor)
-			if dateOnly(cDate).Before(dateOnly(anchorDate)) {
-				return nil
-			}
+		if !p.startDay.IsZero() && cDate.Before(p.startDay) {
+			return nil, nil
+		}
+		if hasHorizon && candidate.Before(horizon) {
+			return nil, horizonError(opts)
 		}
 
 		// Apply until filter for previousFrom:
 		// If candidate is after until, search earlier
 		if schedule.Until != nil {
-			untilDate := resolveUntil(*schedule.Until, now)
-			if dateOnly(cDate).After(dateOnly(untilDate)) {
-				endOfDay := atTimeOnDate(dateOnly(untilDate), TimeOfDay{23, 59}, loc)
-				current = endOfDay.Add(time.Second)
+			if until := resolveUntilBound(*schedule.Until, now, loc); until.excludes(cDate, *candidate) {
+				if until.last.IsZero() {
+					current = dayEnd(schedule.Expr, until.day, loc).Add(time.Second)
+				} else {
+					current = until.last.Add(time.Second)
+				}
 				continue
 			}
 		}
 
 		// Apply during filter
-		if hasDuring && !matchesDuring(cDate, schedule.During) {
-			skipTo := prevDuringMonth(cDate, schedule.During)
-			current = atTimeOnDate(skipTo, TimeOfDay{23, 59}, loc).Add(time.Second)
+		if hasDuring && !matchesDuringClause(cDate, schedule) {
+			skipTo := prevDuringDate(cDate, schedule)
+			if skipTo.IsZero() {
+				return nil, nil
+			}
+			current = dayEnd(schedule.Expr, skipTo, loc).Add(time.Second)
 			continue
 		}
 
 		// Apply except filter
-		if hasExceptions && isExcepted(cDate, schedule.Except) {
-			prevDay := dateOnly(cDate).AddDate(0, 0, -1)
-			current = atTimeOnDate(prevDay, TimeOfDay{23, 59}, loc).Add(time.Second)
+		if hasExceptions && isExcepted(cDate, schedule.Except, cal) {
+			current = dayEnd(schedule.Expr, cDate.AddDate(0, 0, -1), loc).Add(time.Second)
 			continue
 		}
 
-		return candidate
+		shifted := candidate.Add(p.offset)
+		if p.filter != nil && !p.filter(shifted) {
+			current = *candidate
+			continue
+		}
+
+		return &shifted, nil
 	}
 
-	return nil
+	return nil, iterationsError(opts)
 }
 
 // prevExpr dispatches to the appropriate prev function based on expression type.
-func prevExpr(expr ScheduleExpr, loc *time.Location, anchor string, now time.Time) *time.Time {
+func prevExpr(expr ScheduleExpr, loc *time.Location, cal HolidayCalendar, fiscal *FiscalCalendar, events EventSource, anchor time.Time, alignment AlignmentKind, now time.Time) *time.Time {
 	switch expr.Kind {
 	case ScheduleExprKindDay:
-		return prevDayRepeat(expr.Interval, expr.Days, expr.Times, loc, anchor, now)
+		return prevDayRepeat(expr.Interval, expr.Days, expr.Times, loc, anchor, alignment, now)
 	case ScheduleExprKindInterval:
-		return prevIntervalRepeat(expr.Interval, expr.Unit, expr.FromTime, expr.ToTime, expr.DayFilter, loc, now)
+		return prevIntervalRepeat(expr.Interval, expr.Unit, expr.FromTime, expr.ToTime, expr.Overnight, expr.DayFilter, loc, now)
 	case ScheduleExprKindWeek:
-		return prevWeekRepeat(expr.Interval, expr.WeekDays, expr.Times, loc, anchor, now)
+		return prevWeekRepeat(expr.Interval, expr.WeekDays, expr.Times, loc, anchor, alignment, now)
 	case ScheduleExprKindMonth:
-		return prevMonthRepeat(expr.Interval, expr.MonthTarget, expr.Times, loc, anchor, now)
+		return prevMonthRepeat(expr.Interval, expr.MonthTarget, expr.Times, loc, cal, anchor, alignment, now)
 	case ScheduleExprKindSingleDate:
-		return prevSingleDate(expr.DateSpec, expr.Times, loc, now)
+		return prevSingleDates(expr.AllDateSpecs(), expr.Times, loc, events, now)
 	case ScheduleExprKindYear:
-		return prevYearRepeat(expr.Interval, expr.YearTarget, expr.Times, loc, anchor, now)
+		return prevYearRepeat(expr.Interval, expr.AllYearTargets(), expr.Times, loc, anchor, now)
+	case ScheduleExprKindRandom:
+		return prevRandomPick(expr, loc, now)
+	case ScheduleExprKindFiscal:
+		return prevFiscalRepeat(expr, fiscal, loc, cal, now)
 	default:
 		return nil
 	}
@@ -870,24 +1161,18 @@ func prevDuringMonth(d time.Time, during []MonthName) time.Time {
 
 // latestPastAtTimes finds the latest time on date d that is strictly before now.
 func latestPastAtTimes(d time.Time, times []TimeOfDay, loc *time.Location, now time.Time) *time.Time {
-	// Sort times in descending order
-	sortedTimes := make([]TimeOfDay, len(times))
-	copy(sortedTimes, times)
-	for i := 0; i < len(sortedTimes)-1; i++ {
-		for j := i + 1; j < len(sortedTimes); j++ {
-			if sortedTimes[i].TotalMinutes() < sortedTimes[j].TotalMinutes() {
-				sortedTimes[i], sortedTimes[j] = sortedTimes[j], sortedTimes[i]
-			}
-		}
-	}
-
-	for _, tod := range sortedTimes {
+	var best time.Time
+	found := false
+	for _, tod := range times {
 		candidate := atTimeOnDate(d, tod, loc)
-		if candidate.Before(now) {
-			return &candidate
+		if candidate.Before(now) && (!found || candidate.After(best)) {
+			best, found = candidate, true
 		}
 	}
-	return nil
+	if !found {
+		return nil
+	}
+	return &best
 }
 
 // latestAtTimes finds the latest time on date d.
@@ -908,7 +1193,7 @@ func latestAtTimes(d time.Time, times []TimeOfDay, loc *time.Location) *time.Tim
 	return &result
 }
 
-func prevDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func prevDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Location, anchor time.Time, alignment AlignmentKind, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	d := dateOnly(nowInTz)
 
@@ -936,9 +1221,22 @@ func prevDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Lo
 	}
 
 	// Interval > 1
+	if isPeriodAlignment(alignment) {
+		for i := 0; i < 400; i++ {
+			if dayAlignedToPeriod(d, interval, alignment) {
+				candidate := latestPastAtTimes(d, times, loc, now)
+				if candidate != nil {
+					return candidate
+				}
+			}
+			d = d.AddDate(0, 0, -1)
+		}
+		return nil
+	}
+
 	anchorDate := epochDate
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 
 	offset := daysBetween(dateOnly(anchorDate), d)
@@ -966,45 +1264,31 @@ func prevDayRepeat(interval int, days DayFilter, times []TimeOfDay, loc *time.Lo
 	return nil
 }
 
-func prevIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOfDay, dayFilter *DayFilter, loc *time.Location, now time.Time) *time.Time {
+func prevIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOfDay, overnight bool, dayFilter *DayFilter, loc *time.Location, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	d := dateOnly(nowInTz)
 
-	stepMinutes := interval
-	if unit == IntervalHours {
-		stepMinutes = interval * 60
-	}
-	fromMinutes := fromTime.TotalMinutes()
-	toMinutes := toTime.TotalMinutes()
+	stepSeconds := unit.Seconds(interval)
+	fromSeconds, endSeconds := intervalWindowSeconds(fromTime, toTime, overnight)
 
-	for dayOffset := 0; dayOffset < 8; dayOffset++ {
+	// Same horizon as nextIntervalRepeat, so sparse day filters are found going back too
+	for dayOffset := 0; dayOffset < 400; dayOffset++ {
 		if dayFilter != nil && !matchesDayFilter(d, *dayFilter) {
 			d = d.AddDate(0, 0, -1)
 			continue
 		}
 
-		nowMinutes := toMinutes + 1
-		if dayOffset == 0 {
-			nowMinutes = nowInTz.Hour()*60 + nowInTz.Minute()
-		}
-		searchUntil := nowMinutes
-		if searchUntil > toMinutes {
-			searchUntil = toMinutes
-		}
-
-		if searchUntil >= fromMinutes {
-			slotsInRange := (searchUntil - fromMinutes) / stepMinutes
-			lastSlotMinutes := fromMinutes + slotsInRange*stepMinutes
-
-			if dayOffset == 0 && lastSlotMinutes >= nowMinutes {
-				lastSlotMinutes -= stepMinutes
-			}
+		// A window that wraps past midnight can still be open from yesterday
+		searchUntil := min(dayOffset*secondsPerDay+secondOfDay(nowInTz), endSeconds)
 
-			if lastSlotMinutes >= fromMinutes {
-				h := lastSlotMinutes / 60
-				m := lastSlotMinutes % 60
-				result := atTimeOnDate(d, TimeOfDay{h, m}, loc)
-				return &result
+		if searchUntil >= fromSeconds {
+			lastSlot := fromSeconds + (searchUntil-fromSeconds)/stepSeconds*stepSeconds
+			for ; lastSlot >= fromSeconds; lastSlot -= stepSeconds {
+				// Compare instants so a slot earlier in the current second still counts
+				result := atSecondOnDate(d.AddDate(0, 0, lastSlot/secondsPerDay), lastSlot%secondsPerDay, loc)
+				if result.Before(now) {
+					return &result
+				}
 			}
 		}
 
@@ -1014,24 +1298,15 @@ func prevIntervalRepeat(interval int, unit IntervalUnit, fromTime, toTime TimeOf
 	return nil
 }
 
-func prevWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func prevWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.Location, anchor time.Time, alignment AlignmentKind, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	d := dateOnly(nowInTz)
 	anchorDate := epochMonday
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 
-	// Sort target DOWs in reverse order for latest-first matching
-	sortedDays := make([]Weekday, len(days))
-	copy(sortedDays, days)
-	for i := 0; i < len(sortedDays)-1; i++ {
-		for j := i + 1; j < len(sortedDays); j++ {
-			if sortedDays[i].Number() < sortedDays[j].Number() {
-				sortedDays[i], sortedDays[j] = sortedDays[j], sortedDays[i]
-			}
-		}
-	}
+	targetDays := newWeekdaySet(days)
 
 	// Find Monday of current week and Monday of anchor week
 	dowOffset := isoWeekday(d) - 1
@@ -1047,10 +1322,17 @@ func prevWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.L
 			return nil
 		}
 
-		if weeks%interval == 0 {
+		aligned := weeks%interval == 0
+		if alignment == AlignmentISOWeeks {
+			aligned = isoWeekOffset(currentMonday)%interval == 0
+		}
+
+		if aligned {
 			// Aligned week — try each target DOW in reverse order
-			for _, wd := range sortedDays {
-				dayOff := wd.Number() - 1
+			for dayOff := 6; dayOff >= 0; dayOff-- {
+				if !targetDays.has(dayOff + 1) {
+					continue
+				}
 				targetDate := currentMonday.AddDate(0, 0, dayOff)
 				if targetDate.After(d) {
 					continue
@@ -1075,40 +1357,48 @@ func prevWeekRepeat(interval int, days []Weekday, times []TimeOfDay, loc *time.L
 		if remainder != 0 {
 			skipWeeks = remainder
 		}
+		if alignment == AlignmentISOWeeks {
+			skipWeeks = 1
+		}
 		currentMonday = currentMonday.AddDate(0, 0, -skipWeeks*7)
 	}
 
 	return nil
 }
 
-func prevMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func prevMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *time.Location, cal HolidayCalendar, anchor time.Time, alignment AlignmentKind, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	startDate := dateOnly(nowInTz)
 	year := nowInTz.Year()
 	month := int(nowInTz.Month())
 
 	anchorDate := epochDate
-	if anchor != "" {
-		anchorDate, _ = parseISODate(anchor)
+	if !anchor.IsZero() {
+		anchorDate = anchor
 	}
 	maxIter := 24 * interval
 	if interval <= 1 {
 		maxIter = 24
 	}
 
+	// A week of the month can end in the following month
+	if target.Kind == MonthTargetKindWeekOfMonth {
+		month++
+		if month > 12 {
+			month = 1
+			year++
+		}
+	}
+
 	for i := 0; i < maxIter; i++ {
 		// Check interval alignment
-		if interval > 1 {
-			cur := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-			monthOffset := monthsBetweenYM(dateOnly(anchorDate), cur)
-			if monthOffset < 0 || monthOffset%interval != 0 {
-				month--
-				if month < 1 {
-					month = 12
-					year--
-				}
-				continue
+		if interval > 1 && !monthOnGrid(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), anchorDate, interval, alignment) {
+			month--
+			if month < 1 {
+				month = 12
+				year--
 			}
+			continue
 		}
 
 		var dateCandidates []time.Time
@@ -1131,13 +1421,21 @@ func prevMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *t
 				dateCandidates = append(dateCandidates, nwd)
 			}
 		case MonthTargetKindOrdinalWeekday:
-			if target.Ordinal == Last {
-				dateCandidates = append(dateCandidates, lastWeekdayInMonth(year, time.Month(month), target.Weekday))
-			} else {
-				if od, ok := nthWeekdayOfMonth(year, time.Month(month), target.Weekday, target.Ordinal.ToN()); ok {
+			for _, pair := range target.OrdinalWeekdays() {
+				if od, ok := ordinalWeekdayOfMonth(year, time.Month(month), pair); ok {
 					dateCandidates = append(dateCandidates, od)
 				}
 			}
+		case MonthTargetKindWeekOfMonth:
+			dateCandidates = append(dateCandidates, weekOfMonthDates(year, time.Month(month), target.Ordinal, target.WeekDays)...)
+		case MonthTargetKindBusinessDay:
+			if bd, ok := businessDayOfMonth(year, time.Month(month), target.Day, cal); ok {
+				dateCandidates = append(dateCandidates, bd)
+			}
+		case MonthTargetKindLastBusinessDay:
+			if bd, ok := lastBusinessDayOfMonth(year, time.Month(month), cal); ok {
+				dateCandidates = append(dateCandidates, bd)
+			}
 		}
 
 		// Sort in reverse order for latest first
@@ -1176,7 +1474,18 @@ func prevMonthRepeat(interval int, target MonthTarget, times []TimeOfDay, loc *t
 	return nil
 }
 
-func prevSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, now time.Time) *time.Time {
+// prevSingleDates returns the latest previous occurrence among several dates.
+func prevSingleDates(dates []DateSpec, times []TimeOfDay, loc *time.Location, events EventSource, now time.Time) *time.Time {
+	var best *time.Time
+	for _, date := range dates {
+		if candidate := prevSingleDate(date, times, loc, events, now); candidate != nil && (best == nil || candidate.After(*best)) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func prevSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, events EventSource, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	nowDate := dateOnly(nowInTz)
 
@@ -1216,19 +1525,34 @@ func prevSingleDate(dateSpec DateSpec, times []TimeOfDay, loc *time.Location, no
 			return latestAtTimes(lastYear, times, loc)
 		}
 		return nil
+	case DateSpecKindEvent, DateSpecKindRelative:
+		// Start a year late: "the day after dec 31" falls in the following year
+		for year := nowDate.Year() + 1; year > nowDate.Year()-8; year-- {
+			d, ok := resolveDateSpec(dateSpec, year, events)
+			if !ok || d.After(nowDate) {
+				continue
+			}
+			if d.Equal(nowDate) {
+				if candidate := latestPastAtTimes(d, times, loc, now); candidate != nil {
+					return candidate
+				}
+				continue
+			}
+			return latestAtTimes(d, times, loc)
+		}
+		return nil
 	}
 
 	return nil
 }
 
-func prevYearRepeat(interval int, target YearTarget, times []TimeOfDay, loc *time.Location, anchor string, now time.Time) *time.Time {
+func prevYearRepeat(interval int, targets []YearTarget, times []TimeOfDay, loc *time.Location, anchor time.Time, now time.Time) *time.Time {
 	nowInTz := now.In(loc)
 	startDate := dateOnly(nowInTz)
 	startYear := nowInTz.Year()
 	anchorYear := epochDate.Year()
-	if anchor != "" {
-		anchorDate, _ := parseISODate(anchor)
-		anchorYear = anchorDate.Year()
+	if !anchor.IsZero() {
+		anchorYear = anchor.Year()
 	}
 
 	maxIter := 8 * interval
@@ -1247,43 +1571,25 @@ func prevYearRepeat(interval int, target YearTarget, times []TimeOfDay, loc *tim
 			}
 		}
 
-		var targetDate time.Time
-		var valid bool
-
-		switch target.Kind {
-		case YearTargetKindDate:
-			targetDate = time.Date(year, time.Month(target.Month.Number()), target.Day, 0, 0, 0, 0, time.UTC)
-			valid = targetDate.Month() == time.Month(target.Month.Number()) && targetDate.Day() == target.Day
-		case YearTargetKindOrdinalWeekday:
-			if target.Ordinal == Last {
-				targetDate = lastWeekdayInMonth(year, time.Month(target.Month.Number()), target.Weekday)
-				valid = true
-			} else {
-				targetDate, valid = nthWeekdayOfMonth(year, time.Month(target.Month.Number()), target.Weekday, target.Ordinal.ToN())
-			}
-		case YearTargetKindDayOfMonth:
-			targetDate = time.Date(year, time.Month(target.Month.Number()), target.Day, 0, 0, 0, 0, time.UTC)
-			valid = targetDate.Month() == time.Month(target.Month.Number()) && targetDate.Day() == target.Day
-		case YearTargetKindLastWeekday:
-			targetDate = lastWeekdayOfMonth(year, time.Month(target.Month.Number()))
-			valid = true
-		}
-
-		if valid {
-			if targetDate.After(startDate) {
+		// The targets of a year can come in any order; take the latest
+		var best *time.Time
+		for _, target := range targets {
+			targetDate, valid := yearTargetDate(target, year)
+			if !valid || targetDate.After(startDate) {
 				continue // Future
 			}
-			if targetDate.Year() == startDate.Year() && targetDate.Month() == startDate.Month() && targetDate.Day() == startDate.Day() {
-				candidate := latestPastAtTimes(targetDate, times, loc, now)
-				if candidate != nil {
-					return candidate
-				}
+			var candidate *time.Time
+			if targetDate.Equal(startDate) {
+				candidate = latestPastAtTimes(targetDate, times, loc, now)
 			} else {
-				candidate := latestAtTimes(targetDate, times, loc)
-				if candidate != nil {
-					return candidate
-				}
+				candidate = latestAtTimes(targetDate, times, loc)
 			}
+			if candidate != nil && (best == nil || candidate.After(*best)) {
+				best = candidate
+			}
+		}
+		if best != nil {
+			return best
 		}
 	}
 
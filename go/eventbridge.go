@@ -0,0 +1,231 @@
+package hron
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventBridgeCron is an AWS EventBridge schedule expression, `cron(...)` with six fields,
+// and the time zone EventBridge Scheduler evaluates it in. An empty Timezone means UTC,
+// the default of both EventBridge and hron.
+type EventBridgeCron struct {
+	Expression string
+	Timezone   string
+}
+
+// eventBridgeDays are the day-of-week names, indexed by cron day number (0 is Sunday).
+var eventBridgeDays = [7]string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// ToEventBridgeCron converts the schedule to an EventBridge cron expression and time zone.
+// The day-of-month and day-of-week fields are never both set: the unused one is `?`.
+// Days of the week count from 1 (Sunday), and a single ISO date fills in the year field.
+// Except and until clauses are dropped with a warning, as in ToKubernetesCron; other
+// schedules ToCron rejects return a CronError.
+func (s *Schedule) ToEventBridgeCron() (EventBridgeCron, []string, error) {
+	if s.tzName == LocalTimezone {
+		return EventBridgeCron{}, nil, CronError("not expressible as EventBridge cron (bind `in local` with WithTimezone first)")
+	}
+
+	data := *s.data
+	var warnings []string
+	if len(data.Except) > 0 {
+		warnings = append(warnings, fmt.Sprintf("except clause dropped: the rule also runs on %s", displayExceptions(data.Except)))
+		data.Except = nil
+	}
+	if data.Until != nil {
+		warnings = append(warnings, fmt.Sprintf("until clause dropped: the rule keeps running after %s", displayUntil(*data.Until)))
+		data.Until = nil
+	}
+
+	expr, err := toEventBridgeExpression(&data)
+	if err != nil {
+		return EventBridgeCron{}, nil, err
+	}
+	tz, ok := ianaTimezone(s.tzName)
+	if !ok {
+		return EventBridgeCron{}, nil, CronError(fmt.Sprintf("not expressible as EventBridge cron (the offset %s has no IANA timezone)", s.tzName))
+	}
+	return EventBridgeCron{Expression: expr, Timezone: tz}, warnings, nil
+}
+
+func toEventBridgeExpression(data *ScheduleData) (string, error) {
+	expr := data.Expr
+	if expr.Kind == ScheduleExprKindSingleDate && len(expr.DateSpecs) == 0 && expr.DateSpec.Kind == DateSpecKindISO && !hasDuringClause(data) && !usesSolar(data) && len(data.Timezones) == 0 {
+		d, err := parseISODate(expr.DateSpec.Date)
+		if err != nil {
+			return "", CronError(fmt.Sprintf("invalid date: %s", expr.DateSpec.Date))
+		}
+		minute, hour, err := cronExactTimeFields(expr.Times)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("cron(%s %s %d %d ? %d)", minute, hour, d.Day(), int(d.Month()), d.Year()), nil
+	}
+
+	cron, err := ToCron(data)
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(cron)
+	for i := range 2 {
+		if rest, ok := strings.CutPrefix(fields[i], "*/"); ok {
+			fields[i] = "0/" + rest
+		}
+	}
+	if fields[4] == "*" {
+		fields[4] = "?"
+	} else {
+		if fields[2] != "*" {
+			return "", CronError(fmt.Sprintf("not expressible as EventBridge cron (%q sets both day fields)", cron))
+		}
+		fields[2] = "?"
+		fields[4] = eventBridgeDOW(fields[4])
+	}
+	return "cron(" + strings.Join(fields, " ") + " *)", nil
+}
+
+// eventBridgeDOW converts a cron day-of-week field, counting from 0, to EventBridge's,
+// counting from 1. Plain days become names; days with the # and L extensions stay numbers,
+// as in the EventBridge documentation (`3#2`, `6L`).
+func eventBridgeDOW(field string) string {
+	parts := strings.Split(field, ",")
+	for i, part := range parts {
+		if n, ok := strings.CutSuffix(part, "L"); ok {
+			parts[i] = eventBridgeDay(n) + "L"
+		} else if n, nth, ok := strings.Cut(part, "#"); ok {
+			parts[i] = eventBridgeDay(n) + "#" + nth
+		} else if from, to, ok := strings.Cut(part, "-"); ok {
+			parts[i] = eventBridgeDayName(from) + "-" + eventBridgeDayName(to)
+		} else {
+			parts[i] = eventBridgeDayName(part)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func eventBridgeDayName(day string) string {
+	n, err := strconv.Atoi(day)
+	if err != nil || n < 0 || n > 6 {
+		return day
+	}
+	return eventBridgeDays[n]
+}
+
+// eventBridgeDay converts a numeric cron day of week to EventBridge's.
+func eventBridgeDay(day string) string {
+	n, _ := strconv.Atoi(day)
+	return strconv.Itoa(n + 1)
+}
+
+// FromEventBridgeCron converts an EventBridge cron expression and time zone to a Schedule.
+// The `cron(...)` wrapper is optional. Exactly one of the day-of-month and day-of-week
+// fields must be `?`, as EventBridge requires. The year field must be `*`, or a single
+// year when the expression names a single date and time. An empty timeZone gives a
+// schedule without a timezone, which like EventBridge evaluates in UTC. Rate expressions have no hron equivalent and return a CronError.
+func FromEventBridgeCron(expression, timeZone string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "rate(") || strings.HasPrefix(expression, "at(") {
+		return nil, CronError(fmt.Sprintf("only cron expressions are supported, got %q", expression))
+	}
+	if inner, ok := strings.CutPrefix(expression, "cron("); ok {
+		var closed bool
+		if expression, closed = strings.CutSuffix(inner, ")"); !closed {
+			return nil, CronError(fmt.Sprintf("unterminated cron expression: %q", expression))
+		}
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 6 {
+		return nil, CronError(fmt.Sprintf("expected 6 EventBridge cron fields, got %d", len(fields)))
+	}
+	if (fields[2] == "?") == (fields[4] == "?") {
+		return nil, CronError(fmt.Sprintf("exactly one of day of month and day of week must be ?: %q", expression))
+	}
+	if fields[4] != "?" {
+		dow, err := eventBridgeToCronDOW(fields[4])
+		if err != nil {
+			return nil, err
+		}
+		fields[4] = dow
+	}
+
+	data, err := FromCron(strings.Join(fields[:5], " "))
+	if err != nil {
+		return nil, err
+	}
+	if year := fields[5]; year != "*" {
+		if data, err = eventBridgeSingleDate(data, year); err != nil {
+			return nil, err
+		}
+	}
+
+	data.Timezone = timeZone
+	return NewSchedule(data)
+}
+
+// eventBridgeToCronDOW converts an EventBridge day-of-week field, counting from 1, to a
+// cron one, counting from 0.
+func eventBridgeToCronDOW(field string) (string, error) {
+	parts := strings.Split(field, ",")
+	for i, part := range parts {
+		part, step, hasStep := strings.Cut(part, "/")
+		var err error
+		if n, ok := strings.CutSuffix(part, "L"); ok {
+			n, err = cronDayFromEventBridge(n)
+			part = n + "L"
+		} else if n, nth, ok := strings.Cut(part, "#"); ok {
+			n, err = cronDayFromEventBridge(n)
+			part = n + "#" + nth
+		} else if from, to, ok := strings.Cut(part, "-"); ok {
+			var err2 error
+			from, err = cronDayFromEventBridge(from)
+			to, err2 = cronDayFromEventBridge(to)
+			err = cmp.Or(err, err2)
+			part = from + "-" + to
+		} else {
+			part, err = cronDayFromEventBridge(part)
+		}
+		if err != nil {
+			return "", err
+		}
+		if hasStep {
+			part += "/" + step
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// cronDayFromEventBridge converts a numeric EventBridge day of week to cron's, leaving
+// names as they are.
+func cronDayFromEventBridge(day string) (string, error) {
+	n, err := strconv.Atoi(day)
+	if err != nil {
+		return day, nil
+	}
+	if n < 1 || n > 7 {
+		return "", CronError(fmt.Sprintf("EventBridge day of week must be 1-7, got %d", n))
+	}
+	return strconv.Itoa(n - 1), nil
+}
+
+// eventBridgeSingleDate turns a schedule on one day of one month into the single date of
+// that day in year.
+func eventBridgeSingleDate(data *ScheduleData, year string) (*ScheduleData, error) {
+	y, err := strconv.Atoi(year)
+	expr := data.Expr
+	if err != nil || expr.Kind != ScheduleExprKindMonth || expr.MonthTarget.Kind != MonthTargetKindDays ||
+		len(expr.MonthTarget.ExpandDays()) != 1 || len(data.During) != 1 {
+		return nil, CronError(fmt.Sprintf("the year field must be * unless the expression names a single date, got %q", year))
+	}
+
+	month, day := time.Month(data.During[0].Number()), expr.MonthTarget.ExpandDays()[0]
+	d := time.Date(y, month, day, 0, 0, 0, 0, time.UTC)
+	if d.Month() != month {
+		return nil, CronError(fmt.Sprintf("invalid date: %d-%02d-%02d", y, int(month), day))
+	}
+	return NewScheduleData(NewSingleDateExpr(NewISODate(d.Format(time.DateOnly)), expr.Times)), nil
+}
@@ -0,0 +1,114 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToEventBridgeCron(t *testing.T) {
+	cases := []struct {
+		expr     string
+		want     EventBridgeCron
+		warnings int
+	}{
+		{"every weekday at 09:00 in America/New_York", EventBridgeCron{"cron(0 9 ? * MON-FRI *)", "America/New_York"}, 0},
+		{"every day at 06:30", EventBridgeCron{"cron(30 6 * * ? *)", ""}, 0},
+		{"every weekend at 10:00 during jan, feb in UTC", EventBridgeCron{"cron(0 10 ? 1,2 SUN,SAT *)", "UTC"}, 0},
+		{"every 15 min from 00:00 to 23:59", EventBridgeCron{"cron(0/15 * * * ? *)", ""}, 0},
+		{"every 2 hours from 00:00 to 23:59", EventBridgeCron{"cron(0 0/2 * * ? *)", ""}, 0},
+		{"every month on the nearest weekday to 15th at 09:00", EventBridgeCron{"cron(0 9 15W * ? *)", ""}, 0},
+		{"on 2026-03-01 at 09:00, 17:00 in Europe/Berlin", EventBridgeCron{"cron(0 9,17 1 3 ? 2026)", "Europe/Berlin"}, 0},
+		{"every day at 02:00 except dec 25 until 2027-01-01", EventBridgeCron{"cron(0 2 * * ? *)", ""}, 2},
+	}
+	for _, tc := range cases {
+		got, warnings, err := MustParse(tc.expr).ToEventBridgeCron()
+		if err != nil {
+			t.Errorf("%q ToEventBridgeCron(): %v", tc.expr, err)
+			continue
+		}
+		if got != tc.want || len(warnings) != tc.warnings {
+			t.Errorf("%q ToEventBridgeCron() = %+v, %q, want %+v with %d warnings", tc.expr, got, warnings, tc.want, tc.warnings)
+		}
+	}
+
+	for _, expr := range []string{
+		"every 2 weeks on monday at 09:00",
+		"every day at 09:00 in local",
+		"on easter at 09:00",
+	} {
+		if got, _, err := MustParse(expr).ToEventBridgeCron(); err == nil {
+			t.Errorf("%q ToEventBridgeCron() = %+v, want error", expr, got)
+		}
+	}
+}
+
+func TestFromEventBridgeCron(t *testing.T) {
+	cases := []struct{ expression, timeZone, want string }{
+		{"cron(0 9 ? * MON-FRI *)", "America/New_York", "every weekday at 09:00 in America/New_York"},
+		{"cron(0 9 ? * 2-6 *)", "", "every weekday at 09:00"},
+		{"cron(30 6 ? * 1,7 *)", "", "every weekend at 06:30"},
+		{"0 10 ? * 6L *", "", "every month on the last friday at 10:00"},
+		{"cron(0 10 ? * 3#2 *)", "", "every month on the second tuesday at 10:00"},
+		{"cron(0/15 * * * ? *)", "", "every 15 min from 00:00 to 23:59"},
+		{"cron(0 8 L * ? *)", "UTC", "every month on the last day at 08:00 in UTC"},
+		{"cron(0 9 1 3 ? 2026)", "", "on 2026-03-01 at 09:00"},
+	}
+	for _, tc := range cases {
+		s, err := FromEventBridgeCron(tc.expression, tc.timeZone)
+		if err != nil {
+			t.Errorf("FromEventBridgeCron(%q, %q): %v", tc.expression, tc.timeZone, err)
+			continue
+		}
+		if got := s.String(); got != tc.want {
+			t.Errorf("FromEventBridgeCron(%q, %q) = %q, want %q", tc.expression, tc.timeZone, got, tc.want)
+		}
+	}
+
+	for _, expression := range []string{
+		"rate(5 minutes)",
+		"cron(0 9 * * *)",
+		"cron(0 9 * * * *)",
+		"cron(0 9 ? * ? *)",
+		"cron(0 9 ? * 0 *)",
+		"cron(0 9 ? * MON 2026)",
+		"cron(0 9 30 2 ? 2026)",
+		"cron(0 9 1 3 ? *",
+	} {
+		if _, err := FromEventBridgeCron(expression, ""); err == nil {
+			t.Errorf("FromEventBridgeCron(%q) succeeded, want error", expression)
+		}
+	}
+}
+
+func TestEventBridgeCronRoundTrip(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, expr := range []string{
+		"every weekday at 09:00 in America/New_York",
+		"every mon, wed, fri at 07:45 during jun in UTC",
+		"every month on the 1st, 15th at 12:00 in UTC",
+		"every 30 min from 00:00 to 23:59 in UTC",
+	} {
+		s := MustParse(expr)
+		eb, _, err := s.ToEventBridgeCron()
+		if err != nil {
+			t.Errorf("%q ToEventBridgeCron(): %v", expr, err)
+			continue
+		}
+		back, err := FromEventBridgeCron(eb.Expression, eb.Timezone)
+		if err != nil {
+			t.Errorf("FromEventBridgeCron(%q, %q): %v", eb.Expression, eb.Timezone, err)
+			continue
+		}
+		want, got := s.NextNFrom(from, 20), back.NextNFrom(from, 20)
+		if len(want) != len(got) {
+			t.Errorf("%q via %q: %d occurrences, want %d", expr, eb.Expression, len(got), len(want))
+			continue
+		}
+		for i := range want {
+			if !want[i].Equal(got[i]) {
+				t.Errorf("%q via %q: occurrence %d = %v, want %v", expr, eb.Expression, i, got[i], want[i])
+				break
+			}
+		}
+	}
+}
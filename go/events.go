@@ -0,0 +1,188 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventFunc returns the date of a named event in the given year.
+type EventFunc func(year int) time.Time
+
+var (
+	eventsMu sync.RWMutex
+	events   = map[string]EventFunc{
+		"easter": easterSunday,
+	}
+)
+
+// EventSource looks up the named events that dates like "2 days after easter" refer to.
+// Expressions name events freely; a schedule resolves them against its source when
+// evaluated, and Validate reports names the source does not know.
+type EventSource interface {
+	// Event returns the date of the named event in each year, or false if the source has
+	// no event of that lowercase name.
+	Event(name string) (EventFunc, bool)
+}
+
+// EventMap is an EventSource backed by a map of lowercase names.
+type EventMap map[string]EventFunc
+
+// Event implements EventSource.
+func (m EventMap) Event(name string) (EventFunc, bool) {
+	fn, ok := m[name]
+	return fn, ok
+}
+
+// registeredEvents is the EventSource of schedules without one attached: the events
+// given to RegisterEvent, looked up when the schedule is evaluated.
+type registeredEvents struct{}
+
+func (registeredEvents) Event(name string) (EventFunc, bool) {
+	return lookupEvent(name)
+}
+
+// WithEvents returns a copy of the schedule that resolves its event dates against src
+// instead of the registered events.
+func (s *Schedule) WithEvents(src EventSource) *Schedule {
+	c := *s
+	c.events = src
+	return c.withPlan()
+}
+
+// Events returns the event source attached to the schedule, or nil if it resolves
+// events against those registered with RegisterEvent.
+func (s *Schedule) Events() EventSource {
+	return s.events
+}
+
+// eventSource returns the source the schedule's events resolve against.
+func (s *Schedule) eventSource() EventSource {
+	if s.events == nil {
+		return registeredEvents{}
+	}
+	return s.events
+}
+
+// RegisterEvent makes a named event available to every schedule without an EventSource
+// attached, for expressions like "on 2 days after <name> at 09:00". Names are single
+// case-insensitive words that don't clash with hron keywords. Registering an existing
+// name replaces it. "easter" (Western) is built in.
+func RegisterEvent(name string, dateInYear EventFunc) error {
+	name = strings.ToLower(name)
+	if !isEventName(name) {
+		return fmt.Errorf("invalid event name %q (a letter followed by letters, digits, and '_')", name)
+	}
+	if _, ok := keywordMap[name]; ok {
+		return fmt.Errorf("event name %q is a reserved keyword", name)
+	}
+
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	events[name] = dateInYear
+	return nil
+}
+
+func lookupEvent(name string) (EventFunc, bool) {
+	eventsMu.RLock()
+	defer eventsMu.RUnlock()
+	fn, ok := events[name]
+	return fn, ok
+}
+
+// easterSunday computes Western (Gregorian) Easter using the anonymous Gregorian algorithm.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// isEventName reports whether word can name an event: a letter followed by letters,
+// digits, and '_'.
+func isEventName(word string) bool {
+	if word == "" || !isAlpha(word[0]) {
+		return false
+	}
+	for i := 0; i < len(word); i++ {
+		if !isAlphanumeric(word[i]) && word[i] != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// eventNames returns the names of the events the dates of schedule refer to.
+func eventNames(schedule *ScheduleData) []string {
+	if schedule.Expr.Kind != ScheduleExprKindSingleDate {
+		return nil
+	}
+	var names []string
+	for _, spec := range schedule.Expr.AllDateSpecs() {
+		for spec.Kind == DateSpecKindRelative {
+			spec = *spec.Base
+		}
+		if spec.Kind == DateSpecKindEvent && !slices.Contains(names, spec.Event) {
+			names = append(names, spec.Event)
+		}
+	}
+	return names
+}
+
+// resolveDateSpec returns the date a date spec falls on in the given year, looking events
+// up in events. ISO dates (and dates relative to them) ignore the year. Returns false for
+// dates that don't exist that year.
+func resolveDateSpec(spec DateSpec, year int, events EventSource) (time.Time, bool) {
+	switch spec.Kind {
+	case DateSpecKindNamed:
+		d := time.Date(year, time.Month(spec.Month.Number()), spec.Day, 0, 0, 0, 0, time.UTC)
+		return d, d.Day() == spec.Day
+	case DateSpecKindISO:
+		d, err := parseISODate(spec.Date)
+		return d, err == nil
+	case DateSpecKindEvent:
+		fn, ok := events.Event(spec.Event)
+		if !ok {
+			return time.Time{}, false
+		}
+		return dateOnly(fn(year)), true
+	case DateSpecKindRelative:
+		base, ok := resolveDateSpec(*spec.Base, year, events)
+		if !ok {
+			return time.Time{}, false
+		}
+		return shiftDate(base, spec.Offset, spec.Unit), true
+	}
+	return time.Time{}, false
+}
+
+// shiftDate moves d by offset days, or by offset weekdays (skipping weekends).
+func shiftDate(d time.Time, offset int, unit RelativeUnit) time.Time {
+	if unit == RelativeDays {
+		return d.AddDate(0, 0, offset)
+	}
+	step := 1
+	if offset < 0 {
+		step, offset = -1, -offset
+	}
+	for offset > 0 {
+		d = d.AddDate(0, 0, step)
+		if isoWeekday(d) <= 5 {
+			offset--
+		}
+	}
+	return d
+}
@@ -0,0 +1,177 @@
+package hron
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	cases := map[int]time.Time{
+		2024: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		2026: time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC),
+		2027: time.Date(2027, 3, 28, 0, 0, 0, 0, time.UTC),
+		2038: time.Date(2038, 4, 25, 0, 0, 0, 0, time.UTC),
+	}
+	for year, want := range cases {
+		if got := easterSunday(year); !got.Equal(want) {
+			t.Errorf("easterSunday(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestRelativeDateParseDisplay(t *testing.T) {
+	cases := []struct{ input, canonical string }{
+		{"on the weekday before dec 25 at 17:00", "on the weekday before dec 25 at 17:00"},
+		{"on 2 days after easter at 09:00", "on 2 days after easter at 09:00"},
+		{"on 1 day after easter at 09:00", "on the day after easter at 09:00"},
+		{"on 3 weekdays before 2026-07-04 at 09:00", "on 3 weekdays before 2026-07-04 at 09:00"},
+		{"on Easter at 10:00", "on easter at 10:00"},
+	}
+	for _, tc := range cases {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tc.input, err)
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+	}
+
+	for _, input := range []string{
+		"on 0 days after easter at 09:00",
+		"on the day after the day before dec 25 at 09:00",
+		"on the week before dec 25 at 09:00",
+		"on 2 days around easter at 09:00",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestRelativeDateEval(t *testing.T) {
+	s := MustParse("on the weekday before dec 25 at 17:00")
+	// Dec 25, 2026 is a Friday; Dec 25, 2027 is a Saturday
+	want := []time.Time{
+		time.Date(2026, 12, 24, 17, 0, 0, 0, time.UTC),
+		time.Date(2027, 12, 24, 17, 0, 0, 0, time.UTC),
+	}
+	got := s.NextNFrom(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), 2)
+	if len(got) != 2 || !got[0].Equal(want[0]) || !got[1].Equal(want[1]) {
+		t.Errorf("NextNFrom() = %v, want %v", got, want)
+	}
+	if !s.Matches(want[1]) || s.Matches(time.Date(2027, 12, 23, 17, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() disagrees with the resolved date")
+	}
+
+	easter := MustParse("on 2 days after easter at 09:00")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got, want := easter.NextFrom(from), time.Date(2026, 4, 7, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("easter NextFrom() = %v, want %v", got, want)
+	}
+	if got, want := easter.PreviousFrom(from), time.Date(2025, 4, 22, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("easter PreviousFrom() = %v, want %v", got, want)
+	}
+}
+
+func TestRelativeDateCrossesYear(t *testing.T) {
+	s := MustParse("on the weekday before jan 1 at 09:00")
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	if got, want := s.NextFrom(from), time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", got, want)
+	}
+	if got, want := s.PreviousFrom(from), time.Date(2025, 12, 31, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("PreviousFrom() = %v, want %v", got, want)
+	}
+	if !s.Matches(time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() should resolve against the following year's base date")
+	}
+}
+
+func TestRegisterEvent(t *testing.T) {
+	// Events are names until evaluated: an unregistered one parses but is invalid
+	s := MustParse("on the day before founders_day at 12:00")
+	if err := s.Validate(); err == nil {
+		t.Error("Validate() = nil for an unregistered event")
+	}
+	if got := s.NextFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); got != nil {
+		t.Errorf("NextFrom() = %v, want nil for an unregistered event", got)
+	}
+
+	if err := RegisterEvent("Founders_Day", func(year int) time.Time {
+		return time.Date(year, 9, 17, 0, 0, 0, 0, time.UTC)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		eventsMu.Lock()
+		delete(events, "founders_day")
+		eventsMu.Unlock()
+	}()
+
+	if err := s.Validate(); err != nil {
+		t.Errorf("Validate() = %v after registering the event", err)
+	}
+	if got, want := s.NextFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)), time.Date(2026, 9, 16, 12, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", got, want)
+	}
+
+	for _, name := range []string{"every", "", "9lives", "new-year"} {
+		if err := RegisterEvent(name, easterSunday); err == nil {
+			t.Errorf("RegisterEvent(%q) succeeded, want error", name)
+		}
+	}
+}
+
+func TestWithEvents(t *testing.T) {
+	s := MustParse("on launch, 2 days after launch at 09:00")
+	if err := s.Validate(); err == nil {
+		t.Error("Validate() = nil for an unknown event")
+	}
+
+	launch := EventMap{"launch": func(year int) time.Time {
+		return time.Date(year, 5, 4, 0, 0, 0, 0, time.UTC)
+	}}
+	s = s.WithEvents(launch)
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	want := []time.Time{
+		time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 6, 9, 0, 0, 0, time.UTC),
+	}
+	if got := s.NextNFrom(time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC), 2); !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("NextNFrom() = %v, want %v", got, want)
+	}
+	if !s.Matches(want[1]) {
+		t.Error("Matches() should resolve the event against the attached source")
+	}
+
+	// An attached source replaces the registered events, easter included
+	if err := MustParse("on easter at 09:00").WithEvents(launch).Validate(); err == nil {
+		t.Error("Validate() = nil for an event missing from the attached source")
+	}
+}
+
+func TestEventNamesOnlyInDates(t *testing.T) {
+	for _, input := range []string{
+		"on feb 14 and launch at 09:00",
+		"on the weekday before launch at 09:00",
+	} {
+		if _, err := ParseSchedule(input); err != nil {
+			t.Errorf("ParseSchedule(%q): %v", input, err)
+		}
+	}
+	// Elsewhere an unknown word is still a typo
+	for _, input := range []string{
+		"every mondy at 09:00",
+		"on launch at 09:00 exept dec 25",
+	} {
+		var herr *HronError
+		if _, err := ParseSchedule(input); !errors.As(err, &herr) || herr.Kind != ErrorKindLex {
+			t.Errorf("ParseSchedule(%q) error = %v, want a lex error", input, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEveryOtherParse(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every other week on monday at 09:00", "every 2 weeks on monday at 09:00"},
+		{"every other day at 07:00", "every 2 days at 07:00"},
+		{"every other month on the 1st at 09:00", "every 2 months on the 1st at 09:00"},
+		{"every other year on jan 1 at 00:00", "every 2 years on jan 1 at 00:00"},
+		{"every other hour from 09:00 to 17:00", "every 2 hours from 09:00 to 17:00"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+	if _, err := ParseSchedule("every other at 09:00"); err == nil {
+		t.Error("ParseSchedule(\"every other at 09:00\") succeeded, want error")
+	}
+}
+
+func TestEveryOtherMatchesInterval(t *testing.T) {
+	other := MustParse("every other week on monday at 09:00 starting 2026-01-05")
+	two := MustParse("every 2 weeks on monday at 09:00 starting 2026-01-05")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, want := other.NextNFrom(now, 3), two.NextNFrom(now, 3)
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom returned %d results, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package hron
+
+import "time"
+
+// Next returns the next occurrence of the expression after now, for rules engines and
+// templates that hold expressions as strings. It parses expr on every call; EnableCache
+// makes repeated calls with the same expression cheap. It returns the zero time and no
+// error when the schedule has no occurrence after now, and the error of NextFromErr when
+// the expression cannot be evaluated.
+func Next(expr string, now time.Time) (time.Time, error) {
+	s, err := ParseSchedule(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next, err := s.NextFromErr(now)
+	if err != nil || next == nil {
+		return time.Time{}, err
+	}
+	return *next, nil
+}
+
+// Matches reports whether t is an occurrence of the expression, parsing it as Next does.
+// It returns the Validate error of a schedule that cannot be evaluated.
+func Matches(expr string, t time.Time) (bool, error) {
+	s, err := ParseSchedule(expr)
+	if err != nil {
+		return false, err
+	}
+	if err := s.Validate(); err != nil {
+		return false, err
+	}
+	return s.Matches(t), nil
+}
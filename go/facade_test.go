@@ -0,0 +1,60 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextExpr(t *testing.T) {
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC) // Friday
+	got, err := Next("every weekday at 09:00 in UTC", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+
+	got, err = Next("on 2026-01-01 at 09:00 in UTC", now)
+	if err != nil || !got.IsZero() {
+		t.Errorf("Next() of a past date = %v, %v, want zero time", got, err)
+	}
+
+	for _, expr := range []string{"every blue moon", "every day at 09:00 in local"} {
+		if _, err := Next(expr, now); err == nil {
+			t.Errorf("Next(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+func TestMatchesExpr(t *testing.T) {
+	ok, err := Matches("every weekday at 09:00 in UTC", time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC))
+	if err != nil || !ok {
+		t.Errorf("Matches() = %v, %v, want true", ok, err)
+	}
+	ok, err = Matches("every weekday at 09:00 in UTC", time.Date(2026, 2, 7, 9, 0, 0, 0, time.UTC))
+	if err != nil || ok {
+		t.Errorf("Matches() on a Saturday = %v, %v, want false", ok, err)
+	}
+	if _, err := Matches("every weekday at 09:00 except holidays", time.Date(2026, 2, 6, 9, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("Matches() without a holiday calendar succeeded, want error")
+	}
+}
+
+func TestFacadeEnableCache(t *testing.T) {
+	EnableCache(4)
+	t.Cleanup(func() { EnableCache(0) })
+
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	for range 3 {
+		if _, err := Next("every day at 07:00", now); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Matches("every day at 08:00", now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := parseCache.Load().len(); n != 2 {
+		t.Errorf("parse cache holds %d inputs, want 2", n)
+	}
+}
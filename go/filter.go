@@ -0,0 +1,21 @@
+package hron
+
+import "time"
+
+// Filtered returns a copy of the schedule whose occurrences are only those keep accepts,
+// for business rules the grammar cannot express, such as skipping payroll cutover days.
+// NextFrom, PreviousFrom, Matches, and the iterators built on them all apply it. Each
+// rejected occurrence counts against EvalOptions.MaxIterations, so a predicate that
+// rejects everything ends the search with ErrLimitExceeded rather than looping forever.
+//
+// Filtering a filtered schedule keeps both predicates. Like the holiday calendar, the
+// filter is not part of the expression: the canonical string and encodings leave it out.
+func (s *Schedule) Filtered(keep func(time.Time) bool) *Schedule {
+	c := *s
+	if prev := s.filter; prev != nil {
+		c.filter = func(t time.Time) bool { return prev(t) && keep(t) }
+	} else {
+		c.filter = keep
+	}
+	return c.withPlan()
+}
@@ -0,0 +1,61 @@
+package hron
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFiltered(t *testing.T) {
+	// Skip the payroll cutover: the last business day of each month
+	cutover := MustParse("every month on the last weekday at 00:00 in UTC")
+	isCutover := func(t time.Time) bool { return cutover.Matches(dateOnly(t)) }
+	s := MustParse("every weekday at 09:00 in UTC").Filtered(func(t time.Time) bool { return !isCutover(t) })
+
+	now := time.Date(2026, 3, 30, 12, 0, 0, 0, time.UTC) // Monday; Tuesday the 31st is the cutover
+	if next, _ := s.NextTime(now); !next.Equal(time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextTime = %v, want 2026-04-01 09:00", next)
+	}
+	if prev := s.PreviousFrom(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)); prev == nil || !prev.Equal(time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("PreviousFrom = %v, want 2026-03-30 09:00", prev)
+	}
+	if s.Matches(time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches accepted an occurrence the filter rejects")
+	}
+	if !s.Matches(time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches rejected an occurrence the filter keeps")
+	}
+
+	from, to := time.Date(2026, 3, 29, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 4, 0, 0, 0, 0, time.UTC)
+	got := slices.Collect(s.Between(from, to))
+	if len(got) != 4 {
+		t.Errorf("Between = %v, want 4 occurrences without the cutover", got)
+	}
+	if n := s.CountBetween(from, to); n != 4 {
+		t.Errorf("CountBetween = %d, want 4", n)
+	}
+
+	// Filters compose, and the original schedule is unchanged
+	odd := s.Filtered(func(t time.Time) bool { return t.Day()%2 == 1 })
+	if next, _ := odd.NextTime(now); !next.Equal(time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("composed NextTime = %v, want 2026-04-01 09:00", next)
+	}
+	if next, _ := odd.NextTime(time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)); !next.Equal(time.Date(2026, 4, 3, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("composed NextTime = %v, want 2026-04-03 09:00", next)
+	}
+	if s.String() != "every weekday at 09:00 in UTC" {
+		t.Errorf("String() = %q, filter must not change the expression", s.String())
+	}
+}
+
+func TestFilteredRejectingEverything(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC").Filtered(func(time.Time) bool { return false })
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	if _, err := s.NextFromErr(now); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("NextFromErr error = %v, want ErrLimitExceeded", err)
+	}
+	if _, err := s.PreviousFromErr(now); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("PreviousFromErr error = %v, want ErrLimitExceeded", err)
+	}
+}
@@ -0,0 +1,242 @@
+package hron
+
+import (
+	"slices"
+	"time"
+)
+
+// FiscalPattern is how a fiscal calendar divides its year into twelve periods.
+type FiscalPattern int
+
+const (
+	// FiscalCalendarMonths uses calendar months, from the fiscal year's start month.
+	FiscalCalendarMonths FiscalPattern = iota
+	// Fiscal445 splits each quarter into periods of 4, 4, and 5 weeks.
+	Fiscal445
+	// Fiscal454 splits each quarter into periods of 4, 5, and 4 weeks.
+	Fiscal454
+	// Fiscal544 splits each quarter into periods of 5, 4, and 4 weeks.
+	Fiscal544
+)
+
+// weeks returns the weeks in each period of a quarter, or nil for calendar months.
+func (p FiscalPattern) weeks() []int {
+	switch p {
+	case Fiscal445:
+		return []int{4, 4, 5}
+	case Fiscal454:
+		return []int{4, 5, 4}
+	case Fiscal544:
+		return []int{5, 4, 4}
+	default:
+		return nil
+	}
+}
+
+// FiscalCalendar lays out the fiscal months, quarters, and years that fiscal repeats
+// (every fiscal quarter on the first business day) run in.
+//
+// With calendar months, the fiscal year starts on the 1st of StartMonth. With a week
+// pattern, it starts on the WeekStart day nearest the 1st of StartMonth and lasts 52
+// weeks, or 53 when the next year's start requires it; the extra week goes to the last
+// period.
+type FiscalCalendar struct {
+	StartMonth MonthName // The month the fiscal year starts in; zero means jan
+	Pattern    FiscalPattern
+	WeekStart  Weekday // The day week patterns start their weeks on; zero means monday
+}
+
+// WithFiscalCalendar returns a copy of the schedule that evaluates fiscal repeats
+// against fc.
+func (s *Schedule) WithFiscalCalendar(fc FiscalCalendar) *Schedule {
+	c := *s
+	c.fiscal = &fc
+	return c.withPlan()
+}
+
+// FiscalCalendar returns the fiscal calendar attached to the schedule, or false if none
+// is set.
+func (s *Schedule) FiscalCalendar() (FiscalCalendar, bool) {
+	if s.fiscal == nil {
+		return FiscalCalendar{}, false
+	}
+	return *s.fiscal, true
+}
+
+// usesFiscal reports whether the schedule is a fiscal repeat.
+func usesFiscal(schedule *ScheduleData) bool {
+	return schedule.Expr.Kind == ScheduleExprKindFiscal
+}
+
+// yearStart returns the first day of the fiscal year starting in or near StartMonth of
+// the given calendar year.
+func (fc FiscalCalendar) yearStart(year int) time.Time {
+	month := time.January
+	if fc.StartMonth != 0 {
+		month = time.Month(fc.StartMonth.Number())
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if fc.Pattern == FiscalCalendarMonths {
+		return first
+	}
+	weekStart := Monday
+	if fc.WeekStart != 0 {
+		weekStart = fc.WeekStart
+	}
+	back := (isoWeekday(first) - weekStart.Number() + 7) % 7
+	if back <= 3 {
+		return first.AddDate(0, 0, -back)
+	}
+	return first.AddDate(0, 0, 7-back)
+}
+
+// periods returns the thirteen boundaries of the twelve fiscal months of the fiscal year
+// containing date d: each month runs from one boundary up to the next.
+func (fc FiscalCalendar) periods(d time.Time) [13]time.Time {
+	year := d.Year()
+	for fc.yearStart(year).After(d) {
+		year--
+	}
+	for !fc.yearStart(year + 1).After(d) {
+		year++
+	}
+
+	var bounds [13]time.Time
+	start := fc.yearStart(year)
+	weeks := fc.Pattern.weeks()
+	days := 0
+	for i := range 12 {
+		if weeks == nil {
+			bounds[i] = start.AddDate(0, i, 0)
+			continue
+		}
+		bounds[i] = start.AddDate(0, 0, days)
+		days += 7 * weeks[i%3]
+	}
+	bounds[12] = fc.yearStart(year + 1)
+	return bounds
+}
+
+// fiscalRange returns the first day of the fiscal unit containing date d and the first
+// day after it.
+func fiscalRange(fc *FiscalCalendar, unit FiscalUnit, d time.Time) (time.Time, time.Time) {
+	bounds := fc.periods(d)
+	month := 0
+	for month < 11 && !bounds[month+1].After(d) {
+		month++
+	}
+	switch unit {
+	case FiscalQuarter:
+		quarter := month / 3 * 3
+		return bounds[quarter], bounds[quarter+3]
+	case FiscalYear:
+		return bounds[0], bounds[12]
+	default:
+		return bounds[month], bounds[month+1]
+	}
+}
+
+// fiscalTargetDates returns the days in [start, end) that target picks, in order. Day
+// numbers and ordinal weekdays count from either end of the range as they do in a month.
+func fiscalTargetDates(target MonthTarget, start, end time.Time, cal HolidayCalendar) []time.Time {
+	var dates []time.Time
+	last := end.AddDate(0, 0, -1)
+	switch target.Kind {
+	case MonthTargetKindDays:
+		for _, n := range target.ExpandDays() {
+			if d := start.AddDate(0, 0, n-1); d.Before(end) {
+				dates = append(dates, d)
+			}
+		}
+	case MonthTargetKindLastDay:
+		dates = append(dates, last)
+	case MonthTargetKindLastWeekday:
+		for d := last; !d.Before(start); d = d.AddDate(0, 0, -1) {
+			if isoWeekday(d) <= 5 {
+				dates = append(dates, d)
+				break
+			}
+		}
+	case MonthTargetKindOrdinalWeekday:
+		for _, pair := range target.OrdinalWeekdays() {
+			var d time.Time
+			if pair.Ordinal.FromEnd() {
+				back := (isoWeekday(last) - pair.Weekday.Number() + 7) % 7
+				d = last.AddDate(0, 0, -back+7*(pair.Ordinal.ToN()+1))
+			} else {
+				ahead := (pair.Weekday.Number() - isoWeekday(start) + 7) % 7
+				d = start.AddDate(0, 0, ahead+7*(pair.Ordinal.ToN()-1))
+			}
+			if !d.Before(start) && d.Before(end) {
+				dates = append(dates, d)
+			}
+		}
+	case MonthTargetKindBusinessDay:
+		count := 0
+		for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+			if isBusinessDay(d, cal) {
+				if count++; count == target.Day {
+					dates = append(dates, d)
+					break
+				}
+			}
+		}
+	case MonthTargetKindLastBusinessDay:
+		for d := last; !d.Before(start); d = d.AddDate(0, 0, -1) {
+			if isBusinessDay(d, cal) {
+				dates = append(dates, d)
+				break
+			}
+		}
+	}
+	slices.SortFunc(dates, time.Time.Compare)
+	return slices.CompactFunc(dates, time.Time.Equal)
+}
+
+// maxFiscalPeriods bounds how many fiscal units a search scans for one whose target
+// exists, as nextMonthRepeat does with months.
+const maxFiscalPeriods = 24
+
+func nextFiscalRepeat(expr ScheduleExpr, fc *FiscalCalendar, loc *time.Location, cal HolidayCalendar, now time.Time) *time.Time {
+	if fc == nil {
+		return nil
+	}
+	day := dateOnly(now.In(loc))
+	for range maxFiscalPeriods {
+		start, end := fiscalRange(fc, expr.Fiscal, day)
+		for _, d := range fiscalTargetDates(expr.MonthTarget, start, end, cal) {
+			if candidate := earliestFutureAtTimes(d, expr.Times, loc, now); candidate != nil {
+				return candidate
+			}
+		}
+		day = end
+	}
+	return nil
+}
+
+func prevFiscalRepeat(expr ScheduleExpr, fc *FiscalCalendar, loc *time.Location, cal HolidayCalendar, now time.Time) *time.Time {
+	if fc == nil {
+		return nil
+	}
+	day := dateOnly(now.In(loc))
+	for range maxFiscalPeriods {
+		start, end := fiscalRange(fc, expr.Fiscal, day)
+		dates := fiscalTargetDates(expr.MonthTarget, start, end, cal)
+		for i := len(dates) - 1; i >= 0; i-- {
+			if candidate := latestPastAtTimes(dates[i], expr.Times, loc, now); candidate != nil {
+				return candidate
+			}
+		}
+		day = start.AddDate(0, 0, -1)
+	}
+	return nil
+}
+
+// matchesFiscalTarget checks if date d is a target day of its fiscal unit.
+func matchesFiscalTarget(expr ScheduleExpr, fc *FiscalCalendar, cal HolidayCalendar, d time.Time) bool {
+	if fc == nil {
+		return false
+	}
+	start, end := fiscalRange(fc, expr.Fiscal, d)
+	return slices.ContainsFunc(fiscalTargetDates(expr.MonthTarget, start, end, cal), d.Equal)
+}
@@ -0,0 +1,99 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalRepeat(t *testing.T) {
+	retail := FiscalCalendar{StartMonth: Feb, Pattern: Fiscal445, WeekStart: Sunday}
+	from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		fc   FiscalCalendar
+		want []string
+	}{
+		{"every fiscal quarter on the first business day at 08:00 in UTC", FiscalCalendar{StartMonth: Jul},
+			[]string{"2026-04-01", "2026-07-01", "2026-10-01"}},
+		{"every fiscal year on the 1st at 00:00 in UTC", FiscalCalendar{StartMonth: Jul},
+			[]string{"2026-07-01", "2027-07-01", "2028-07-01"}},
+		// Sunday nearest feb 1: 2026-02-01, 2027-01-31, 2028-01-30, 2029-02-04
+		{"every fiscal year on the 1st at 00:00 in UTC", retail,
+			[]string{"2026-02-01", "2027-01-31", "2028-01-30"}},
+		// Periods of 4, 4, and 5 weeks from 2026-02-01
+		{"every fiscal month on the last day at 17:00 in UTC", retail,
+			[]string{"2026-01-31", "2026-02-28", "2026-03-28", "2026-05-02"}},
+		{"every fiscal quarter on the first business day at 08:00 in UTC", retail,
+			[]string{"2026-02-02", "2026-05-04", "2026-08-03"}},
+		{"every fiscal quarter on the last friday at 09:00 in UTC", retail,
+			[]string{"2026-01-30", "2026-05-01", "2026-07-31"}},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr).WithFiscalCalendar(tt.fc)
+		got := s.NextNFrom(from, len(tt.want))
+		if len(got) != len(tt.want) {
+			t.Errorf("%q NextNFrom = %v, want %v", tt.expr, got, tt.want)
+			continue
+		}
+		for i, want := range tt.want {
+			if d := got[i].Format(time.DateOnly); d != want {
+				t.Errorf("%q NextNFrom[%d] = %s, want %s", tt.expr, i, d, want)
+			}
+			if !s.Matches(got[i]) {
+				t.Errorf("%q does not match its occurrence %v", tt.expr, got[i])
+			}
+		}
+		last := got[len(got)-1]
+		if prev := s.PreviousFrom(last); prev == nil || !prev.Equal(got[len(got)-2]) {
+			t.Errorf("%q PreviousFrom(%v) = %v, want %v", tt.expr, last, prev, got[len(got)-2])
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("%q: %v", tt.expr, err)
+		}
+	}
+}
+
+func TestFiscalRepeatLongYear(t *testing.T) {
+	// The year from 2028-01-30 needs 53 weeks to reach the next start on 2029-02-04, so
+	// its last period runs 6 weeks from 2028-12-24
+	retail := FiscalCalendar{StartMonth: Feb, Pattern: Fiscal445, WeekStart: Sunday}
+	s := MustParse("every fiscal month on the last day at 17:00 in UTC").WithFiscalCalendar(retail)
+	got := s.NextNFrom(time.Date(2028, 12, 1, 0, 0, 0, 0, time.UTC), 3)
+	want := []string{"2028-12-23", "2029-02-03", "2029-03-03"}
+	for i := range want {
+		if i >= len(got) || got[i].Format(time.DateOnly) != want[i] {
+			t.Fatalf("NextNFrom = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFiscalRepeatSyntax(t *testing.T) {
+	s := MustParse("every fiscal quarter on the first business day at 08:00")
+	if got := s.String(); got != "every fiscal quarter on the 1st business day at 08:00" {
+		t.Errorf("String() = %q", got)
+	}
+	if got, want := s.Describe(), "Runs at 8:00 AM on the 1st business day of each fiscal quarter"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if err := s.Validate(); err == nil {
+		t.Error("Validate accepted a fiscal repeat without a fiscal calendar")
+	}
+	if s.NextFrom(time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)) != nil {
+		t.Error("NextFrom found an occurrence without a fiscal calendar")
+	}
+	if err := s.WithFiscalCalendar(FiscalCalendar{}).Validate(); err != nil {
+		t.Errorf("Validate with a fiscal calendar: %v", err)
+	}
+	if _, err := s.ToCron(); err == nil {
+		t.Error("ToCron accepted a fiscal repeat")
+	}
+	for _, input := range []string{
+		"every fiscal week on the 1st at 09:00",
+		"every fiscal quarter in the second week on monday at 09:00",
+		"every fiscal month on the nearest weekday to the 15th at 09:00",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", input)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package hron
+
+import "time"
+
+// Forecast counts the occurrences of all schedules in consecutive buckets of the given
+// width, starting at from. Bucket i covers [from+i*bucket, from+(i+1)*bucket); the last
+// bucket is cut off at to. It returns nil if bucket is not positive or to is not after from.
+// Schedules that fail Validate contribute nothing. Schedules CountBetween counts a day at
+// a time are counted per bucket when buckets span at least a day; others are iterated.
+func Forecast(schedules []*Schedule, from, to time.Time, bucket time.Duration) []int {
+	if bucket <= 0 || !to.After(from) {
+		return nil
+	}
+	counts := make([]int, (to.Sub(from)+bucket-1)/bucket)
+
+	for _, s := range schedules {
+		// Narrower buckets hold no whole day, and counting each would cost a search
+		if _, ok := dailyPredicate(s.data, s.calendar); ok && s.filter == nil && bucket >= 24*time.Hour {
+			for i := range counts {
+				start := from.Add(time.Duration(i) * bucket)
+				end := start.Add(bucket)
+				if end.After(to) {
+					end = to
+				}
+				// CountBetween is (from, to]; step both back for [start, end)
+				counts[i] += s.CountBetween(start.Add(-time.Nanosecond), end.Add(-time.Nanosecond))
+			}
+			continue
+		}
+		// Occurrences is strictly after its argument; step back so one at from is counted
+		for occ := range s.Occurrences(from.Add(-time.Nanosecond)) {
+			if !occ.Before(to) {
+				break
+			}
+			counts[occ.Sub(from)/bucket]++
+		}
+	}
+	return counts
+}
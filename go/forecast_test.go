@@ -0,0 +1,57 @@
+package hron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestForecast(t *testing.T) {
+	fleet := []*Schedule{
+		MustParse("every 15 min from 09:00 to 10:00 in UTC"),
+		MustParse("every day at 09:00 in UTC"),
+		MustParse("every weekday at 09:30, 11:00 in UTC"),
+		MustParse("every day at 09:00 except holidays in UTC"), // no calendar: skipped
+	}
+	from := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) // monday
+	to := time.Date(2026, 3, 2, 11, 30, 0, 0, time.UTC)
+
+	got := Forecast(fleet, from, to, time.Hour)
+	// 09:xx: 4 interval slots + 09:00 daily + 09:30 weekday; 10:00 slot; 11:00 weekday
+	if want := []int{6, 1, 1}; !slices.Equal(got, want) {
+		t.Errorf("Forecast() = %v, want %v", got, want)
+	}
+
+	got = Forecast(fleet, from, to, 30*time.Minute)
+	if want := []int{3, 3, 1, 0, 1}; !slices.Equal(got, want) {
+		t.Errorf("Forecast() = %v, want %v", got, want)
+	}
+
+	if Forecast(fleet, from, to, 0) != nil || Forecast(fleet, to, from, time.Hour) != nil {
+		t.Error("expected nil for an empty range or non-positive bucket")
+	}
+}
+
+// Buckets counted a day at a time agree with iterating the occurrences.
+func TestForecastCountsWholeDays(t *testing.T) {
+	fleet := []*Schedule{
+		MustParse("every day at 01:30, 02:30 in America/New_York"), // 02:30 falls in the spring gap
+		MustParse("every weekday at 09:00 except 2026-03-10 in UTC"),
+		MustParse("every month on the 1st, 15th at 12:00 in Europe/Berlin"),
+		MustParse("every 2 days at 09:00 in UTC"), // iterated
+	}
+	from := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 2, 0)
+	for _, bucket := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour} {
+		got := Forecast(fleet, from, to, bucket)
+		want := make([]int, len(got))
+		for _, s := range fleet {
+			for occ := range s.Between(from.Add(-time.Nanosecond), to.Add(-time.Nanosecond)) {
+				want[occ.Sub(from)/bucket]++
+			}
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Forecast(%v) = %v, want %v", bucket, got, want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package hron
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatOptions chooses among the spellings Format writes. The zero value writes the
+// canonical string.
+type FormatOptions struct {
+	TitleCase       bool // Month and day names capitalized: Jan, Monday
+	NumericOrdinals bool // Ordinal weekdays as numbers: 1st monday, 2nd to last friday
+	TwelveHour      bool // Times on a 12-hour clock: 9am, 5:30pm, 12am for midnight
+}
+
+// Format parses input and writes it in canonical form with the spellings opts chooses,
+// for tools that store or show expressions in a house style. The result is checked to
+// parse to a schedule Equal to the input's; Format returns the parse error of input, or
+// an EvalError if the check fails.
+func Format(input string, opts FormatOptions) (string, error) {
+	data, err := Parse(input)
+	if err != nil {
+		return "", err
+	}
+	canonical := Display(data)
+	tokens, err := Tokenize(canonical)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	last := 0
+	for i, tok := range tokens {
+		text := canonical[tok.Span.Start:tok.Span.End]
+		styled := text
+		switch tok.Kind {
+		case TokenMonthName, TokenDayName:
+			if opts.TitleCase {
+				styled = strings.ToUpper(text[:1]) + text[1:]
+			}
+		case TokenOrdinal:
+			if opts.NumericOrdinals && ordinalWeekdayAt(tokens, i) {
+				styled = ordinalNumber(int(tok.OrdinalVal))
+			}
+		case TokenTime:
+			if opts.TwelveHour {
+				styled = twelveHour(TimeOfDay{Hour: tok.TimeHour, Minute: tok.TimeMinute})
+			}
+		}
+		sb.WriteString(canonical[last:tok.Span.Start])
+		sb.WriteString(styled)
+		last = tok.Span.End
+	}
+	sb.WriteString(canonical[last:])
+
+	out := sb.String()
+	formatted, err := Parse(out)
+	if err != nil || Display(Normalize(formatted)) != Display(Normalize(data)) {
+		return "", EvalError(fmt.Sprintf("formatting %q as %q changed its meaning", canonical, out))
+	}
+	return out, nil
+}
+
+// ordinalWeekdayAt reports whether the ordinal at tokens[i] starts an ordinal weekday,
+// which the lexer also reads when written as a number.
+func ordinalWeekdayAt(tokens []Token, i int) bool {
+	kindAt := func(j int) TokenKind {
+		if j < len(tokens) {
+			return tokens[j].Kind
+		}
+		return -1
+	}
+	return kindAt(i+1) == TokenDayName ||
+		(kindAt(i+1) == TokenTo && kindAt(i+2) == TokenLast && kindAt(i+3) == TokenDayName)
+}
+
+// twelveHour writes t on a 12-hour clock, leaving out whole hours' minutes.
+func twelveHour(t TimeOfDay) string {
+	suffix := "am"
+	if t.Hour >= 12 {
+		suffix = "pm"
+	}
+	hour := t.Hour % 12
+	if hour == 0 {
+		hour = 12
+	}
+	if t.Minute == 0 {
+		return fmt.Sprintf("%d%s", hour, suffix)
+	}
+	return fmt.Sprintf("%d:%02d%s", hour, t.Minute, suffix)
+}
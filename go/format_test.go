@@ -0,0 +1,35 @@
+package hron
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  FormatOptions
+		want  string
+	}{
+		{"every month on the 1st monday at 9:30pm", FormatOptions{}, "every month on the first monday at 21:30"},
+		{"every month on the first monday at 21:30", FormatOptions{NumericOrdinals: true}, "every month on the 1st monday at 21:30"},
+		{"every month on the second to last friday at 09:00", FormatOptions{NumericOrdinals: true}, "every month on the 2nd to last friday at 09:00"},
+		{"every month on the 2nd business day at 09:00", FormatOptions{NumericOrdinals: true}, "every month on the 2nd business day at 09:00"},
+		{"every year on mar 15 at 09:00 during jan, feb", FormatOptions{TitleCase: true}, "every year on Mar 15 at 09:00 during Jan, Feb"},
+		{"every monday at 09:00", FormatOptions{TitleCase: true}, "every Monday at 09:00"},
+		{"every day at 00:00, 09:00, 12:00, 17:30", FormatOptions{TwelveHour: true}, "every day at 12am, 9am, 12pm, 5:30pm"},
+		{"every 15 min from 09:00 to 17:30", FormatOptions{TwelveHour: true}, "every 15 min from 9am to 5:30pm"},
+		{"every month on the first friday at 17:00", FormatOptions{TitleCase: true, NumericOrdinals: true, TwelveHour: true}, "every month on the 1st Friday at 5pm"},
+	}
+	for _, tt := range tests {
+		got, err := Format(tt.input, tt.opts)
+		if err != nil {
+			t.Errorf("Format(%q, %+v): %v", tt.input, tt.opts, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Format(%q, %+v) = %q, want %q", tt.input, tt.opts, got, tt.want)
+		}
+	}
+
+	if _, err := Format("every day at", FormatOptions{}); err == nil {
+		t.Error("Format accepted an invalid expression")
+	}
+}
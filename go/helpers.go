@@ -1,6 +1,10 @@
 package hron
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -10,13 +14,131 @@ var (
 	epochMonday = time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC) // Monday
 )
 
+// locations caches loaded timezones by name. time.LoadLocation reads and parses the zone
+// file on every call, and a fleet of schedules mostly shares a handful of zones.
+var locations sync.Map // string -> *time.Location
+
 // resolveTimezone resolves a timezone name to a *time.Location.
-// If tzName is empty, returns UTC for deterministic behavior.
+// If tzName is empty, returns UTC for deterministic behavior. Offset literals such as
+// UTC+05:30 and GMT-8 resolve to fixed zones, and IANA names load with the zone provider;
+// an unknown name is an EvalError.
 func resolveTimezone(tzName string) (*time.Location, error) {
-	if tzName != "" {
-		return time.LoadLocation(tzName)
+	if tzName == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(tzName); ok {
+		return loc.(*time.Location), nil
+	}
+	var loc *time.Location
+	if offset, ok := parseUTCOffset(tzName); ok {
+		loc = time.FixedZone(tzName, offset)
+	} else {
+		var err error
+		if loc, err = loadZone(tzName); err != nil {
+			return nil, err
+		}
 	}
-	return time.UTC, nil
+	locations.Store(tzName, loc)
+	return loc, nil
+}
+
+// parseUTCOffset parses an offset literal: UTC or GMT followed by a sign and hours, with
+// optional minutes (UTC+5, GMT-08, UTC+05:30, UTC+0530). It returns the offset east of
+// UTC in seconds, or false if tz is not one or is out of range (beyond ±14:00).
+func parseUTCOffset(tz string) (int, bool) {
+	if len(tz) < 5 || (!strings.EqualFold(tz[:3], "UTC") && !strings.EqualFold(tz[:3], "GMT")) {
+		return 0, false
+	}
+	sign := 1
+	switch tz[3] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, false
+	}
+	digits := tz[4:]
+	hours, minutes := digits, "0"
+	if h, m, ok := strings.Cut(digits, ":"); ok {
+		hours, minutes = h, m
+		if len(m) != 2 {
+			return 0, false
+		}
+	} else if len(digits) == 4 {
+		hours, minutes = digits[:2], digits[2:]
+	}
+	if len(hours) > 2 {
+		return 0, false
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil || h < 0 {
+		return 0, false
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 || m > 59 || h*60+m > 14*60 {
+		return 0, false
+	}
+	return sign * (h*3600 + m*60), true
+}
+
+// ianaTimezone returns the IANA name of a zone, for systems that accept nothing else: the
+// name itself, or for an offset literal of whole hours the Etc/GMT zone, whose sign is
+// inverted (UTC+5 is Etc/GMT-5). Other offset literals have no IANA name.
+func ianaTimezone(tz string) (string, bool) {
+	offset, ok := parseUTCOffset(tz)
+	switch {
+	case !ok:
+		return tz, true
+	case offset == 0:
+		return "UTC", true
+	case offset%3600 != 0 || offset > 14*3600 || offset < -12*3600:
+		return "", false
+	default:
+		return fmt.Sprintf("Etc/GMT%+d", -offset/3600), true
+	}
+}
+
+// timezoneAbbreviations maps common timezone abbreviations, in upper case, to the IANA
+// zones they are used for. An abbreviation with one zone is read as that zone, standard
+// and daylight names alike (PST and PDT are both America/Los_Angeles); one with several is
+// ambiguous and rejected with its candidates, most common first. EST, MST, and HST are
+// left out: they are IANA zone names themselves, fixed at UTC-5, UTC-7, and UTC-10, and
+// are read as those zones.
+var timezoneAbbreviations = map[string][]string{
+	"EDT":  {"America/New_York"},
+	"CDT":  {"America/Chicago"},
+	"MDT":  {"America/Denver"},
+	"PST":  {"America/Los_Angeles"},
+	"PDT":  {"America/Los_Angeles"},
+	"AKST": {"America/Anchorage"},
+	"AKDT": {"America/Anchorage"},
+	"NST":  {"America/St_Johns"},
+	"NDT":  {"America/St_Johns"},
+	"WET":  {"Europe/Lisbon"},
+	"WEST": {"Europe/Lisbon"},
+	"CET":  {"Europe/Paris"},
+	"CEST": {"Europe/Paris"},
+	"EET":  {"Europe/Athens"},
+	"EEST": {"Europe/Athens"},
+	"MSK":  {"Europe/Moscow"},
+	"PKT":  {"Asia/Karachi"},
+	"HKT":  {"Asia/Hong_Kong"},
+	"SGT":  {"Asia/Singapore"},
+	"JST":  {"Asia/Tokyo"},
+	"KST":  {"Asia/Seoul"},
+	"AWST": {"Australia/Perth"},
+	"ACST": {"Australia/Adelaide"},
+	"ACDT": {"Australia/Adelaide"},
+	"AEST": {"Australia/Sydney"},
+	"AEDT": {"Australia/Sydney"},
+	"NZST": {"Pacific/Auckland"},
+	"NZDT": {"Pacific/Auckland"},
+	"CST":  {"America/Chicago", "Asia/Shanghai", "America/Havana"},
+	"IST":  {"Asia/Kolkata", "Asia/Jerusalem", "Europe/Dublin"},
+	"BST":  {"Europe/London", "Asia/Dhaka"},
+	"AST":  {"America/Halifax", "Asia/Riyadh"},
+	"ADT":  {"America/Halifax"},
+	"GST":  {"Asia/Dubai", "Atlantic/South_Georgia"},
 }
 
 // atTimeOnDate creates a time.Time at the given date and time of day in the given location.
@@ -89,9 +211,12 @@ func lastWeekdayOfMonth(year int, month time.Month) time.Time {
 	}
 }
 
-// nthWeekdayOfMonth returns the nth occurrence of a weekday in a month.
-// Returns zero time if the nth occurrence doesn't exist.
+// nthWeekdayOfMonth returns the nth occurrence of a weekday in a month; a negative n
+// counts from the end (-1 is the last). Returns zero time if the nth occurrence doesn't exist.
 func nthWeekdayOfMonth(year int, month time.Month, weekday Weekday, n int) (time.Time, bool) {
+	if n < 0 {
+		return nthWeekdayFromEnd(year, month, weekday, -n)
+	}
 	// Convert hron weekday to Go weekday
 	targetDOW := time.Weekday((weekday.Number() % 7))
 
@@ -114,6 +239,79 @@ func nthWeekdayOfMonth(year int, month time.Month, weekday Weekday, n int) (time
 	return d, true
 }
 
+// nthWeekdayFromEnd returns the nth-to-last occurrence of a weekday in a month (1 is the last).
+// Returns zero time if the occurrence doesn't exist.
+func nthWeekdayFromEnd(year int, month time.Month, weekday Weekday, n int) (time.Time, bool) {
+	d := lastWeekdayInMonth(year, month, weekday).AddDate(0, 0, -(n-1)*7)
+	if d.Month() != month {
+		return time.Time{}, false
+	}
+	return d, true
+}
+
+// ordinalWeekdayOfMonth resolves an ordinal weekday (including last) in a month.
+func ordinalWeekdayOfMonth(year int, month time.Month, pair OrdinalWeekday) (time.Time, bool) {
+	if pair.Ordinal == Last {
+		return lastWeekdayInMonth(year, month, pair.Weekday), true
+	}
+	return nthWeekdayOfMonth(year, month, pair.Weekday, pair.Ordinal.ToN())
+}
+
+// crossesMonthBoundary reports whether a month target can produce dates outside its month.
+func crossesMonthBoundary(target MonthTarget) bool {
+	switch target.Kind {
+	case MonthTargetKindNearestWeekday:
+		return target.Direction != NearestNone
+	case MonthTargetKindWeekOfMonth:
+		return true
+	}
+	return false
+}
+
+// weekOfMonthStart returns the Monday of the nth ISO week of a month, where a week
+// belongs to the month containing its Thursday. Returns false if the week doesn't exist.
+func weekOfMonthStart(year int, month time.Month, week OrdinalPosition) (time.Time, bool) {
+	thursday, ok := ordinalWeekdayOfMonth(year, month, OrdinalWeekday{Ordinal: week, Weekday: Thursday})
+	if !ok {
+		return time.Time{}, false
+	}
+	return thursday.AddDate(0, 0, -3), true
+}
+
+// weekOfMonthDates returns the dates of the given weekdays within the nth ISO week of a month.
+func weekOfMonthDates(year int, month time.Month, week OrdinalPosition, days []Weekday) []time.Time {
+	monday, ok := weekOfMonthStart(year, month, week)
+	if !ok {
+		return nil
+	}
+	dates := make([]time.Time, len(days))
+	for i, wd := range days {
+		dates[i] = monday.AddDate(0, 0, wd.Number()-1)
+	}
+	return dates
+}
+
+// weekOfMonthOwner returns the first day of the month that owns d's ISO week.
+func weekOfMonthOwner(d time.Time) time.Time {
+	thursday := dateOnly(d).AddDate(0, 0, 4-isoWeekday(d))
+	return time.Date(thursday.Year(), thursday.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// matchesWeekOfMonth checks if d falls on one of the weekdays of the nth ISO week of its owning month.
+func matchesWeekOfMonth(d time.Time, week OrdinalPosition, days []Weekday) bool {
+	owner := weekOfMonthOwner(d)
+	monday, ok := weekOfMonthStart(owner.Year(), owner.Month(), week)
+	if !ok || !monday.Equal(dateOnly(d).AddDate(0, 0, 1-isoWeekday(d))) {
+		return false
+	}
+	for _, wd := range days {
+		if wd.Number() == isoWeekday(d) {
+			return true
+		}
+	}
+	return false
+}
+
 // lastWeekdayInMonth returns the last occurrence of a specific weekday in a month.
 func lastWeekdayInMonth(year int, month time.Month, weekday Weekday) time.Time {
 	targetDOW := time.Weekday((weekday.Number() % 7))
@@ -140,8 +338,85 @@ func monthsBetweenYM(a, b time.Time) int {
 	return (b.Year()*12 + int(b.Month())) - (a.Year()*12 + int(a.Month()))
 }
 
+// alignmentAnchor returns the anchor that interval offsets are counted from, or "" for the epoch.
+func alignmentAnchor(schedule *ScheduleData) string {
+	if schedule.Alignment == AlignmentDefault {
+		return schedule.Anchor
+	}
+	return ""
+}
+
+// monthOnGrid reports whether the month of d is on the grid of a month interval: counted
+// from anchor, or from each January when aligned to year start.
+func monthOnGrid(d, anchor time.Time, interval int, alignment AlignmentKind) bool {
+	if alignment == AlignmentYearStart {
+		return (int(d.Month())-1)%interval == 0
+	}
+	offset := monthsBetweenYM(dateOnly(anchor), d)
+	return offset >= 0 && offset%interval == 0
+}
+
+// wrapsMidnight reports whether the expression is an interval window that runs past midnight.
+func wrapsMidnight(expr ScheduleExpr) bool {
+	return expr.Kind == ScheduleExprKindInterval && expr.Overnight
+}
+
+// occurrenceDay returns the day that date-level clauses (during, except, until, starting)
+// are checked against. Slots after midnight of a wrapping window belong to the day the
+// window opened.
+func occurrenceDay(expr ScheduleExpr, t time.Time) time.Time {
+	d := dateOnly(t)
+	if wrapsMidnight(expr) && t.Hour()*60+t.Minute() < expr.FromTime.TotalMinutes() {
+		return d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+// dayStart returns the earliest instant of an occurrence belonging to day d.
+func dayStart(expr ScheduleExpr, d time.Time, loc *time.Location) time.Time {
+	if wrapsMidnight(expr) {
+		return atTimeOnDate(d, expr.FromTime, loc)
+	}
+	return atTimeOnDate(d, TimeOfDay{0, 0}, loc)
+}
+
+// dayEnd returns the latest instant of an occurrence belonging to day d.
+func dayEnd(expr ScheduleExpr, d time.Time, loc *time.Location) time.Time {
+	if wrapsMidnight(expr) {
+		return atTimeOnDate(d.AddDate(0, 0, 1), expr.ToTime, loc)
+	}
+	return atTimeOnDate(d, TimeOfDay{23, 59}, loc)
+}
+
+// isPeriodAlignment reports whether day counting restarts at every week, month, or year.
+func isPeriodAlignment(alignment AlignmentKind) bool {
+	return alignment == AlignmentWeekStart || alignment == AlignmentMonthStart || alignment == AlignmentYearStart
+}
+
+// dayAlignedToPeriod checks if d falls on the interval grid restarted at the start of its period.
+func dayAlignedToPeriod(d time.Time, interval int, alignment AlignmentKind) bool {
+	var periodStart time.Time
+	switch alignment {
+	case AlignmentWeekStart:
+		periodStart = d.AddDate(0, 0, -(isoWeekday(d) - 1))
+	case AlignmentMonthStart:
+		periodStart = time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case AlignmentYearStart:
+		periodStart = time.Date(d.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return false
+	}
+	return daysBetween(periodStart, dateOnly(d))%interval == 0
+}
+
+// isoWeekOffset returns the zero-based ISO week number of d.
+func isoWeekOffset(d time.Time) int {
+	_, week := d.ISOWeek()
+	return week - 1
+}
+
 // isExcepted checks if a date is in the exception list.
-func isExcepted(d time.Time, exceptions []ExceptionSpec) bool {
+func isExcepted(d time.Time, exceptions []ExceptionSpec, cal HolidayCalendar) bool {
 	for _, exc := range exceptions {
 		switch exc.Kind {
 		case ExceptionSpecKindNamed:
@@ -153,6 +428,20 @@ func isExcepted(d time.Time, exceptions []ExceptionSpec) bool {
 			if err == nil && d.Year() == excDate.Year() && d.Month() == excDate.Month() && d.Day() == excDate.Day() {
 				return true
 			}
+		case ExceptionSpecKindHolidays:
+			if cal != nil && cal.IsHoliday(dateOnly(d)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// usesHolidays reports whether the schedule has an 'except holidays' clause.
+func usesHolidays(schedule *ScheduleData) bool {
+	for _, exc := range schedule.Except {
+		if exc.Kind == ExceptionSpecKindHolidays {
+			return true
 		}
 	}
 	return false
@@ -173,30 +462,279 @@ func matchesDuring(d time.Time, during []MonthName) bool {
 
 // nextDuringMonth returns the first day of the next allowed month.
 func nextDuringMonth(d time.Time, during []MonthName) time.Time {
-	currentMonth := int(d.Month())
-
-	// Sort months
-	months := make([]int, len(during))
-	for i, m := range during {
-		months[i] = m.Number()
-	}
-	// Simple bubble sort for small slice
-	for i := 0; i < len(months)-1; i++ {
-		for j := i + 1; j < len(months); j++ {
-			if months[i] > months[j] {
-				months[i], months[j] = months[j], months[i]
+	var allowed [13]bool
+	for _, m := range during {
+		allowed[m.Number()] = true
+	}
+	for i := 1; i <= 12; i++ {
+		if m := (int(d.Month())+i-1)%12 + 1; allowed[m] {
+			return time.Date(d.Year()+(int(d.Month())+i-1)/12, time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+		}
+	}
+	return time.Time{}
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// intervalWindowSeconds returns the start of an interval window and its inclusive end, in
+// seconds from the start of the day the window opens. An overnight window ends on the next
+// day, so its end is beyond secondsPerDay; any other window whose end is before its start
+// never opens.
+func intervalWindowSeconds(from, to TimeOfDay, overnight bool) (int, int) {
+	start, end := from.TotalMinutes()*60, to.TotalMinutes()*60
+	if overnight {
+		end += secondsPerDay
+	}
+	return start, end
+}
+
+// secondOfDay returns the wall-clock seconds since midnight.
+func secondOfDay(t time.Time) int {
+	return t.Hour()*3600 + t.Minute()*60 + t.Second()
+}
+
+// atSecondOnDate resolves a wall-clock second of the day on date d, like atTimeOnDate.
+func atSecondOnDate(d time.Time, second int, loc *time.Location) time.Time {
+	return atTimeOnDate(d, TimeOfDay{second / 3600, second % 3600 / 60}, loc).Add(time.Duration(second%60) * time.Second)
+}
+
+// hasDuringClause reports whether the schedule restricts dates with a during clause.
+func hasDuringClause(schedule *ScheduleData) bool {
+	return len(schedule.During) > 0 || len(schedule.DuringDates) > 0 || len(schedule.DuringWeeks) > 0 ||
+		len(schedule.DuringQuarters) > 0 || len(schedule.DuringHalves) > 0 || len(schedule.DuringYears) > 0
+}
+
+// duringMonthsOnly reports whether the during clause is a plain month list, which month
+// repeats can apply while generating candidates.
+func duringMonthsOnly(schedule *ScheduleData) bool {
+	return len(schedule.DuringDates) == 0 && len(schedule.DuringWeeks) == 0 && len(schedule.DuringYears) == 0
+}
+
+// duringMonths returns the whole months of the during clause, with quarters and half
+// years expanded.
+func duringMonths(schedule *ScheduleData) []MonthName {
+	if len(schedule.DuringQuarters) == 0 && len(schedule.DuringHalves) == 0 {
+		return schedule.During
+	}
+	months := append([]MonthName(nil), schedule.During...)
+	for _, q := range schedule.DuringQuarters {
+		for m := (q-1)*3 + 1; m <= q*3; m++ {
+			months = append(months, MonthName(m))
+		}
+	}
+	for _, h := range schedule.DuringHalves {
+		for m := (h-1)*6 + 1; m <= h*6; m++ {
+			months = append(months, MonthName(m))
+		}
+	}
+	return months
+}
+
+// matchesDuringClause checks if a date falls within the during clause's year ranges and
+// in any of its months, date windows, or week ranges.
+func matchesDuringClause(d time.Time, schedule *ScheduleData) bool {
+	if len(schedule.DuringYears) > 0 && !inDuringYears(d.Year(), schedule.DuringYears) {
+		return false
+	}
+	return matchesDuringUnion(d, schedule)
+}
+
+// inDuringYears checks if a year falls in any of the year ranges.
+func inDuringYears(year int, years []YearRange) bool {
+	for _, r := range years {
+		if year >= r.From && year <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDuringUnion checks if a date falls in any month, date window, or week range of
+// the during clause. A clause with only year ranges matches every date.
+func matchesDuringUnion(d time.Time, schedule *ScheduleData) bool {
+	months := duringMonths(schedule)
+	if len(schedule.DuringDates) == 0 && len(schedule.DuringWeeks) == 0 {
+		return matchesDuring(d, months)
+	}
+	if len(months) > 0 && matchesDuring(d, months) {
+		return true
+	}
+	for _, w := range schedule.DuringDates {
+		if inDateWindow(d, w) {
+			return true
+		}
+	}
+	_, week := d.ISOWeek()
+	for _, r := range schedule.DuringWeeks {
+		if inWeekRange(week, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// inWeekRange checks if an ISO week number falls within a week range.
+func inWeekRange(week int, r WeekRange) bool {
+	if r.From <= r.To {
+		return week >= r.From && week <= r.To
+	}
+	return week >= r.From || week <= r.To
+}
+
+// maxDuringWeekScan bounds the day-by-day search for an allowed ISO week. Week 53 can
+// be more than five years away, so the bound covers seven years.
+const maxDuringWeekScan = 7 * 366
+
+// scanDuringDate steps one day at a time from d in direction step (+1 or -1) to the first
+// date allowed by the months, windows, and weeks of the during clause.
+func scanDuringDate(d time.Time, schedule *ScheduleData, step int) time.Time {
+	day := dateOnly(d)
+	for i := 0; i < maxDuringWeekScan; i++ {
+		day = day.AddDate(0, 0, step)
+		if matchesDuringUnion(day, schedule) {
+			return day
+		}
+	}
+	return day
+}
+
+// inDateWindow checks if a date falls within a yearly date window.
+func inDateWindow(d time.Time, w DateWindow) bool {
+	md := int(d.Month())*100 + d.Day()
+	from := w.FromMonth.Number()*100 + w.FromDay
+	to := w.ToMonth.Number()*100 + w.ToDay
+	if from <= to {
+		return md >= from && md <= to
+	}
+	return md >= from || md <= to
+}
+
+// nextDuringDate returns the first allowed date after d, which must be outside the during
+// clause. It returns the zero time once d is past the last year range.
+func nextDuringDate(d time.Time, schedule *ScheduleData) time.Time {
+	if len(schedule.DuringYears) == 0 {
+		return nextDuringUnionDate(d, schedule)
+	}
+	day := dateOnly(d)
+	for i := 0; i < maxIterations; i++ {
+		if !inDuringYears(day.Year(), schedule.DuringYears) {
+			year, ok := nextDuringYear(day.Year(), schedule.DuringYears)
+			if !ok {
+				return time.Time{}
 			}
+			day = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
 		}
+		if matchesDuringUnion(day, schedule) {
+			return day
+		}
+		day = nextDuringUnionDate(day, schedule)
 	}
+	return time.Time{}
+}
 
-	// Find next month after current
-	for _, m := range months {
-		if m > currentMonth {
-			return time.Date(d.Year(), time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+// nextDuringYear returns the first year after year that falls in a year range.
+func nextDuringYear(year int, years []YearRange) (int, bool) {
+	best, ok := 0, false
+	for _, r := range years {
+		if r.To > year && (!ok || max(r.From, year+1) < best) {
+			best, ok = max(r.From, year+1), true
 		}
 	}
-	// Wrap to first month of next year
-	return time.Date(d.Year()+1, time.Month(months[0]), 1, 0, 0, 0, 0, time.UTC)
+	return best, ok
+}
+
+// prevDuringYear returns the last year before year that falls in a year range.
+func prevDuringYear(year int, years []YearRange) (int, bool) {
+	best, ok := 0, false
+	for _, r := range years {
+		if r.From < year && (!ok || min(r.To, year-1) > best) {
+			best, ok = min(r.To, year-1), true
+		}
+	}
+	return best, ok
+}
+
+// nextDuringUnionDate returns the first date after d allowed by the months, windows, and
+// weeks of the during clause, ignoring its year ranges.
+func nextDuringUnionDate(d time.Time, schedule *ScheduleData) time.Time {
+	if len(schedule.DuringWeeks) > 0 {
+		return scanDuringDate(d, schedule, 1)
+	}
+	months := duringMonths(schedule)
+	if len(schedule.DuringDates) == 0 {
+		return nextDuringMonth(d, months)
+	}
+	day := dateOnly(d)
+	var best time.Time
+	if len(months) > 0 {
+		best = nextDuringMonth(d, months)
+	}
+	for _, w := range schedule.DuringDates {
+		start := time.Date(day.Year(), time.Month(w.FromMonth.Number()), w.FromDay, 0, 0, 0, 0, time.UTC)
+		if !start.After(day) {
+			start = time.Date(day.Year()+1, time.Month(w.FromMonth.Number()), w.FromDay, 0, 0, 0, 0, time.UTC)
+		}
+		if best.IsZero() || start.Before(best) {
+			best = start
+		}
+	}
+	return best
+}
+
+// prevDuringDate returns the last allowed date before d, which must be outside the during
+// clause. It returns the zero time once d is before the first year range.
+func prevDuringDate(d time.Time, schedule *ScheduleData) time.Time {
+	if len(schedule.DuringYears) == 0 {
+		return prevDuringUnionDate(d, schedule)
+	}
+	day := dateOnly(d)
+	for i := 0; i < maxIterations; i++ {
+		if !inDuringYears(day.Year(), schedule.DuringYears) {
+			year, ok := prevDuringYear(day.Year(), schedule.DuringYears)
+			if !ok {
+				return time.Time{}
+			}
+			day = time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC)
+		}
+		if matchesDuringUnion(day, schedule) {
+			return day
+		}
+		day = prevDuringUnionDate(day, schedule)
+	}
+	return time.Time{}
+}
+
+// prevDuringUnionDate returns the last date before d allowed by the months, windows, and
+// weeks of the during clause, ignoring its year ranges.
+func prevDuringUnionDate(d time.Time, schedule *ScheduleData) time.Time {
+	if len(schedule.DuringWeeks) > 0 {
+		return scanDuringDate(d, schedule, -1)
+	}
+	months := duringMonths(schedule)
+	if len(schedule.DuringDates) == 0 {
+		return prevDuringMonth(d, months)
+	}
+	day := dateOnly(d)
+	var best time.Time
+	if len(months) > 0 {
+		best = prevDuringMonth(d, months)
+	}
+	for _, w := range schedule.DuringDates {
+		end := windowEnd(day.Year(), w)
+		if !end.Before(day) {
+			end = windowEnd(day.Year()-1, w)
+		}
+		if end.After(best) {
+			best = end
+		}
+	}
+	return best
+}
+
+// windowEnd returns the last day of a date window in the given year, clamping feb 29.
+func windowEnd(year int, w DateWindow) time.Time {
+	month := time.Month(w.ToMonth.Number())
+	return time.Date(year, month, min(w.ToDay, lastDayOfMonth(year, month).Day()), 0, 0, 0, 0, time.UTC)
 }
 
 // resolveUntil converts an UntilSpec to a date.
@@ -219,19 +757,64 @@ func resolveUntil(until UntilSpec, now time.Time) time.Time {
 	}
 }
 
+// untilBound is the end an until clause puts on a schedule: its last day, and for an
+// until with a time of day, the last instant allowed on that day.
+type untilBound struct {
+	day  time.Time
+	last time.Time // Zero when the whole day is allowed
+}
+
+func resolveUntilBound(until UntilSpec, now time.Time, loc *time.Location) untilBound {
+	b := untilBound{day: dateOnly(resolveUntil(until, now))}
+	if until.Time != nil {
+		b.last = atTimeOnDate(b.day, *until.Time, loc)
+	}
+	return b
+}
+
+// excludes reports whether an occurrence at t, belonging to day, comes after the bound.
+func (b untilBound) excludes(day, t time.Time) bool {
+	if !b.last.IsZero() {
+		return t.After(b.last)
+	}
+	return day.After(b.day)
+}
+
 // earliestFutureAtTimes finds the earliest time in the list that is strictly after now.
 func earliestFutureAtTimes(d time.Time, times []TimeOfDay, loc *time.Location, now time.Time) *time.Time {
-	var best *time.Time
+	if t, ok := earliestFutureAt(d, times, loc, now); ok {
+		return &t
+	}
+	return nil
+}
+
+// earliestFutureAt is earliestFutureAtTimes returning the time by value.
+func earliestFutureAt(d time.Time, times []TimeOfDay, loc *time.Location, now time.Time) (time.Time, bool) {
+	var best time.Time
+	found := false
 	for _, tod := range times {
 		candidate := atTimeOnDate(d, tod, loc)
-		if candidate.After(now) {
-			if best == nil || candidate.Before(*best) {
-				c := candidate
-				best = &c
-			}
+		if candidate.After(now) && (!found || candidate.Before(best)) {
+			best, found = candidate, true
 		}
 	}
-	return best
+	return best, found
+}
+
+// weekdaySet is a set of ISO weekday numbers (Monday=1), for scanning a week in order
+// without sorting.
+type weekdaySet uint8
+
+func newWeekdaySet(days []Weekday) weekdaySet {
+	var set weekdaySet
+	for _, d := range days {
+		set |= 1 << d.Number()
+	}
+	return set
+}
+
+func (s weekdaySet) has(isoDay int) bool {
+	return s&(1<<isoDay) != 0
 }
 
 // parseISODate parses an ISO date string (YYYY-MM-DD).
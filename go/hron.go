@@ -22,15 +22,32 @@
 package hron
 
 import (
+	"context"
+	"fmt"
 	"iter"
+	"slices"
 	"time"
 )
 
+// LocalTimezone is the placeholder timezone of `in local` expressions. Such a schedule
+// stores no zone; bind one per user with WithTimezone before evaluating it.
+const LocalTimezone = "local"
+
 // Schedule represents a parsed hron schedule.
 type Schedule struct {
-	data     *ScheduleData
-	tzName   string
-	location *time.Location
+	data      *ScheduleData
+	tzName    string
+	location  *time.Location
+	warnings  []Warning
+	calendar  HolidayCalendar
+	solar     SolarProvider
+	fiscal    *FiscalCalendar
+	events    EventSource
+	zones     []*time.Location // Every zone of an in clause listing several
+	options   EvalOptions
+	reference time.Time
+	filter    func(time.Time) bool
+	compiled  *evalPlan
 }
 
 // Parse parses an hron expression string into a Schedule.
@@ -40,15 +57,30 @@ func (s *Schedule) String() string {
 
 // NewSchedule creates a new Schedule from parsed data.
 func NewSchedule(data *ScheduleData) (*Schedule, error) {
-	loc, err := resolveTimezone(data.Timezone)
+	var loc *time.Location
+	if data.Timezone != LocalTimezone {
+		var err error
+		loc, err = resolveTimezone(data.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	zones, err := resolveZones(data)
 	if err != nil {
 		return nil, err
 	}
-	return &Schedule{
+	if strictRoundtrip {
+		if err := CheckRoundtrip(data); err != nil {
+			return nil, err
+		}
+	}
+	s := &Schedule{
 		data:     data,
 		tzName:   data.Timezone,
 		location: loc,
-	}, nil
+		zones:    zones,
+	}
+	return s.withPlan(), nil
 }
 
 // MustParse parses an hron expression string into a Schedule.
@@ -61,14 +93,45 @@ func MustParse(input string) *Schedule {
 	return s
 }
 
+// ParseOption configures ParseSchedule and ParseWithWarnings.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	onDeprecation func(Warning)
+	normalize     bool
+}
+
+func newParseConfig(opts []ParseOption) *parseConfig {
+	c := &parseConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // ParseSchedule parses an hron expression string into a Schedule.
 // This is the main entry point for parsing.
-func ParseSchedule(input string) (*Schedule, error) {
-	data, err := Parse(input)
+func ParseSchedule(input string, opts ...ParseOption) (*Schedule, error) {
+	data, warnings, err := ParseWithWarnings(input, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return NewSchedule(data)
+	s, err := NewSchedule(data)
+	if err != nil {
+		return nil, err
+	}
+	s.warnings = warnings
+	return s, nil
+}
+
+// ParseScheduleAt parses an hron expression string into a Schedule whose relative dates
+// are resolved against ref; see WithReference.
+func ParseScheduleAt(input string, ref time.Time) (*Schedule, error) {
+	s, err := ParseSchedule(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.WithReference(ref), nil
 }
 
 // FromCronExpr converts a 5-field cron expression to a Schedule.
@@ -80,33 +143,203 @@ func FromCronExpr(cronExpr string) (*Schedule, error) {
 	return NewSchedule(data)
 }
 
+// FromCronExprDays converts a 5-field cron expression to schedules whose occurrences
+// together are the cron's, combining the day fields as match says; see FromCronDays.
+func FromCronExprDays(cronExpr string, match CronDayMatch) ([]*Schedule, error) {
+	datas, err := FromCronDays(cronExpr, match)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]*Schedule, len(datas))
+	for i, data := range datas {
+		if schedules[i], err = NewSchedule(data); err != nil {
+			return nil, err
+		}
+	}
+	return schedules, nil
+}
+
 // Validate checks if an input string is a valid hron expression.
 func Validate(input string) bool {
-	_, err := Parse(input)
+	_, err := parseInput(input)
 	return err == nil
 }
 
+// Validate reports whether the schedule can be evaluated. It returns an EvalError if the
+// expression excepts holidays but no HolidayCalendar is attached, runs at a solar event
+// but no SolarProvider is, repeats every fiscal period but no FiscalCalendar is, is `in
+// local` with no timezone bound, names relative dates with no reference time set, or
+// names an event its EventSource does not know; evaluation methods then find no
+// occurrences.
+func (s *Schedule) Validate() error {
+	if s.calendar == nil && usesHolidays(s.data) {
+		return EvalError("schedule excepts holidays but no holiday calendar is attached (use WithHolidayCalendar)")
+	}
+	if s.solar == nil && usesSolar(s.data) {
+		return EvalError("schedule runs at a solar event but no location is attached (use WithCoordinates)")
+	}
+	if s.fiscal == nil && usesFiscal(s.data) {
+		return EvalError("schedule repeats every fiscal period but no fiscal calendar is attached (use WithFiscalCalendar)")
+	}
+	if s.location == nil {
+		return EvalError("schedule is 'in local' but no timezone is bound (use WithTimezone)")
+	}
+	if s.reference.IsZero() && hasRelativeDates(s.data) {
+		return EvalError("schedule has relative dates but no reference time (use WithReference or ParseScheduleAt)")
+	}
+	for _, name := range eventNames(s.data) {
+		if _, ok := s.eventSource().Event(name); !ok {
+			return EvalError(fmt.Sprintf("schedule names the event '%s' but it is not registered (use RegisterEvent or WithEvents)", name))
+		}
+	}
+	return nil
+}
+
+// WithTimezone returns a copy of an `in local` schedule evaluated in the named IANA
+// timezone. The canonical string still reads `in local`, so one stored expression can
+// serve every user. Schedules with a fixed timezone return an EvalError.
+func (s *Schedule) WithTimezone(name string) (*Schedule, error) {
+	if s.data.Timezone != LocalTimezone {
+		return nil, EvalError("WithTimezone requires an 'in local' schedule")
+	}
+	loc, err := resolveTimezone(name)
+	if err != nil {
+		return nil, err
+	}
+	c := *s
+	c.tzName = name
+	c.location = loc
+	return c.withPlan(), nil
+}
+
+// WithReference returns a copy of the schedule whose relative starting and until dates
+// (`starting next monday`, `until end of month`) are resolved against ref, in the
+// schedule's timezone. Without a reference the schedule cannot be evaluated, and Validate
+// reports it. The canonical string keeps the relative dates.
+func (s *Schedule) WithReference(ref time.Time) *Schedule {
+	c := *s
+	c.reference = ref
+	return c.withPlan()
+}
+
+// Reference returns the reference time set with WithReference, or the zero time.
+func (s *Schedule) Reference() time.Time {
+	return s.reference
+}
+
 // NextFrom computes the next occurrence after now.
-// Returns nil if there is no future occurrence.
+// Returns nil if there is no future occurrence, and also if the schedule cannot be
+// evaluated, as when it excepts holidays with no HolidayCalendar attached; NextFromErr
+// tells the two apart.
 func (s *Schedule) NextFrom(now time.Time) *time.Time {
-	return nextFrom(s.data, s.location, now)
+	if s.Validate() != nil {
+		return nil
+	}
+	return nextFrom(s.plan(), s.calendar, s.options, now)
+}
+
+// NextFromInclusive computes the next occurrence at or after now. Unlike NextFrom, an
+// occurrence exactly at now is returned rather than skipped, for schedulers that treat
+// a job as due once the clock reaches it.
+func (s *Schedule) NextFromInclusive(now time.Time) *time.Time {
+	// Occurrences fall on whole seconds, so nothing lies between the two
+	return s.NextFrom(now.Add(-time.Nanosecond))
+}
+
+// NextTime is NextFrom returning the occurrence by value, with false if there is none
+// or the schedule fails Validate. For day, week, and interval repeats it does not allocate, for hot loops that poll many
+// schedules.
+func (s *Schedule) NextTime(now time.Time) (time.Time, bool) {
+	if s.Validate() != nil {
+		return time.Time{}, false
+	}
+	next, ok, _ := nextTimeCtx(context.Background(), s.plan(), s.calendar, s.options, now)
+	return next, ok
+}
+
+// NextFromErr computes the next occurrence after now like NextFrom, but says why there
+// is none: nil with no error means the schedule has no future occurrence (its until date
+// or single date has passed), while an EvalError wrapping ErrLimitExceeded means the
+// search gave up. It also returns the Validate error of a schedule that cannot be
+// evaluated.
+func (s *Schedule) NextFromErr(now time.Time) (*time.Time, error) {
+	return s.NextFromCtx(context.Background(), now)
 }
 
-// NextNFrom computes the next n occurrences after now.
+// NextFromCtx computes the next occurrence after now like NextFrom, but returns
+// ctx.Err() if ctx is done before the search finishes. It also returns the Validate
+// error of a schedule that cannot be evaluated, and an EvalError wrapping
+// ErrLimitExceeded if the search reached its EvalOptions limits.
+func (s *Schedule) NextFromCtx(ctx context.Context, now time.Time) (*time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return nextFromCtx(ctx, s.plan(), s.calendar, s.options, now)
+}
+
+// NextNFrom computes the next n occurrences after now. It returns none for a schedule
+// that fails Validate, such as one excepting holidays with no HolidayCalendar attached.
 func (s *Schedule) NextNFrom(now time.Time, n int) []time.Time {
-	return nextNFrom(s.data, s.location, now, n)
+	if s.Validate() != nil {
+		return nil
+	}
+	return nextNFrom(s.plan(), s.calendar, s.options, now, n)
 }
 
 // PreviousFrom computes the most recent occurrence strictly before now.
 // Returns nil if there is no previous occurrence (e.g., before a starting anchor
-// or for single dates in the future).
+// or for single dates in the future), and also if the schedule cannot be evaluated, as
+// when it excepts holidays with no HolidayCalendar attached; PreviousFromErr tells the
+// two apart.
 func (s *Schedule) PreviousFrom(now time.Time) *time.Time {
-	return previousFrom(s.data, s.location, now)
+	if s.Validate() != nil {
+		return nil
+	}
+	return previousFrom(s.plan(), s.calendar, s.options, now)
+}
+
+// PreviousFromInclusive computes the most recent occurrence at or before now, returning
+// an occurrence exactly at now rather than the one before it.
+func (s *Schedule) PreviousFromInclusive(now time.Time) *time.Time {
+	return s.PreviousFrom(now.Add(time.Nanosecond))
 }
 
-// Matches checks if a datetime matches this schedule.
+// PreviousFromErr computes the most recent occurrence strictly before now like
+// PreviousFrom, but says why there is none, as NextFromErr does.
+func (s *Schedule) PreviousFromErr(now time.Time) (*time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return previousFromErr(s.plan(), s.calendar, s.options, now)
+}
+
+// Matches checks if a datetime matches this schedule. It reports false for every time
+// if the schedule cannot be evaluated, as when it excepts holidays with no
+// HolidayCalendar attached; MatchesErr returns the reason instead.
 func (s *Schedule) Matches(dt time.Time) bool {
-	return matches(s.data, s.location, dt)
+	match, _ := s.MatchesErr(dt)
+	return match
+}
+
+// MatchesErr checks if a datetime matches this schedule like Matches, but returns the
+// Validate error of a schedule that cannot be evaluated.
+func (s *Schedule) MatchesErr(dt time.Time) (bool, error) {
+	if err := s.Validate(); err != nil {
+		return false, err
+	}
+	return matches(s.plan(), s.calendar, dt), nil
+}
+
+// MatchesWithin is Matches with clock skew allowed: it also reports true when an
+// occurrence lies within tolerance of dt on either side, so that a trigger firing a few
+// seconds early or late still matches. A negative tolerance counts as zero.
+func (s *Schedule) MatchesWithin(dt time.Time, tolerance time.Duration) bool {
+	if s.Matches(dt) {
+		return true
+	}
+	tolerance = max(tolerance, 0)
+	next := s.NextFromInclusive(dt.Add(-tolerance))
+	return next != nil && !next.After(dt.Add(tolerance))
 }
 
 // Occurrences returns a lazy iterator of occurrences starting after `from`.
@@ -116,23 +349,67 @@ func (s *Schedule) Occurrences(from time.Time) iter.Seq[time.Time] {
 	return Occurrences(s, from)
 }
 
+// OccurrencesCtx returns a lazy iterator of occurrences starting after `from` that
+// stops once ctx is done.
+func (s *Schedule) OccurrencesCtx(ctx context.Context, from time.Time) iter.Seq[time.Time] {
+	return OccurrencesCtx(ctx, s, from)
+}
+
 // Between returns a bounded iterator of occurrences where `from < occurrence <= to`.
 // The iterator yields occurrences strictly after `from` and up to and including `to`.
 func (s *Schedule) Between(from, to time.Time) iter.Seq[time.Time] {
 	return Between(s, from, to)
 }
 
+// OccurrencesBefore returns a lazy iterator of occurrences strictly before `from`, most
+// recent first.
+func (s *Schedule) OccurrencesBefore(from time.Time) iter.Seq[time.Time] {
+	return OccurrencesBefore(s, from)
+}
+
+// BetweenReverse returns a bounded iterator of occurrences where `from < occurrence <= to`,
+// most recent first.
+func (s *Schedule) BetweenReverse(from, to time.Time) iter.Seq[time.Time] {
+	return BetweenReverse(s, from, to)
+}
+
+// BetweenPrevious returns the occurrences where `from < occurrence <= to`, most recent
+// first.
+func (s *Schedule) BetweenPrevious(from, to time.Time) []time.Time {
+	return BetweenPrevious(s, from, to)
+}
+
+// MissedBetween returns the occurrences after lastRun up to and including now, oldest
+// first: the runs a job last run at lastRun has missed. It collects Between, so keep the
+// range short for frequent schedules.
+func (s *Schedule) MissedBetween(lastRun, now time.Time) []time.Time {
+	return slices.Collect(Between(s, lastRun, now))
+}
+
 // ToCron converts this schedule to a 5-field cron expression.
 // Returns an error if the schedule is not expressible as cron.
 func (s *Schedule) ToCron() (string, error) {
 	return ToCron(s.data)
 }
 
-// Timezone returns the IANA timezone name, or empty string if not specified.
+// Timezone returns the IANA timezone name, or empty string if not specified. For an
+// `in local` schedule it is LocalTimezone until WithTimezone binds a zone. For a schedule
+// in several zones it is the first; Timezones lists them all.
 func (s *Schedule) Timezone() string {
 	return s.tzName
 }
 
+// Starts returns the start of the schedule's starting anchor day in its timezone, moved
+// by any plus or minus clause. PreviousFrom finds no occurrence before it. No occurrence
+// at all is produced before it when the starting date is relative or an `aligned to`
+// clause takes over aligning the interval; otherwise the date anchors the interval, as in
+// the spec. Returns false if there is no starting clause, no timezone is
+// bound, or the clause names a relative date and no reference time is set.
+func (s *Schedule) Starts() (time.Time, bool) {
+	p := s.plan()
+	return p.start.Add(p.offset), p.hasStart
+}
+
 // Data returns the underlying ScheduleData.
 func (s *Schedule) Data() *ScheduleData {
 	return s.data
@@ -0,0 +1,317 @@
+// Package hrontab runs shell commands at the occurrences of hron schedules listed in a
+// file, as a human-readable replacement for crontab. Each line is a schedule followed
+// by the command to run, optionally labelled with a name:
+//
+//	# Lines starting with # are comments
+//	every weekday at 09:00 in America/New_York   /usr/local/bin/send-report
+//	backup: every day at 02:00 in UTC            tar czf /backups/home.tgz /home
+//
+// The schedule is the longest run of leading words that parses as an hron expression,
+// and the rest of the line is the command, passed to /bin/sh -c as cron does.
+//
+//	err := hrontab.Watch(ctx, "/etc/hrontab")
+package hrontab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/prasrvenkat/hron/go"
+	"github.com/prasrvenkat/hron/go/runner"
+)
+
+// reloadInterval is how often Watch rereads its file by default.
+const reloadInterval = 10 * time.Second
+
+// Entry is a line of a Table: a schedule and the command to run at its occurrences.
+type Entry struct {
+	Name     string // The label of the line, or "" for none
+	Line     int    // 1-based
+	Schedule *hron.Schedule
+	Command  string
+}
+
+// key identifies an entry across reloads: an entry with the same key keeps running.
+func (e Entry) key() string {
+	return e.Name + "\x00" + e.Schedule.String() + "\x00" + e.Command
+}
+
+// Table is a parsed hrontab file.
+type Table struct {
+	Entries []Entry
+	Errors  []hron.DocumentError // One per line that is not an entry
+}
+
+// Err returns the errors of the table joined into one, or nil if every line parsed.
+func (t *Table) Err() error {
+	return (&hron.Document{Errors: t.Errors}).Err()
+}
+
+// Parse parses the text of an hrontab file. Blank lines and lines whose first non-blank
+// character is `#` are skipped; a `#` later in a line belongs to the command. Names
+// follow the rules of hron.ParseDocument and each may label one line.
+func Parse(text string) *Table {
+	table := &Table{}
+	names := map[string]int{}
+	for i, line := range strings.Split(text, "\n") {
+		n := i + 1
+		line = strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		entry, lineErr := parseLine(line)
+		if lineErr == nil && entry.Name != "" {
+			if first, ok := names[entry.Name]; ok {
+				msg := fmt.Sprintf("schedule name '%s' is already used on line %d", entry.Name, first)
+				lineErr = &hron.DocumentError{Column: 1, Err: hron.ParseError(msg, hron.Span{Start: 0, End: len(line)}, line, "")}
+			}
+			names[entry.Name] = n
+		}
+		if lineErr != nil {
+			lineErr.Line = n
+			table.Errors = append(table.Errors, *lineErr)
+			continue
+		}
+		entry.Line = n
+		table.Entries = append(table.Entries, entry)
+	}
+	return table
+}
+
+// parseLine splits line into its schedule and command at the longest prefix of words
+// that parses.
+func parseLine(line string) (Entry, *hron.DocumentError) {
+	var ends []int
+	for i := 1; i <= len(line); i++ {
+		if (i == len(line) || unicode.IsSpace(rune(line[i]))) && !unicode.IsSpace(rune(line[i-1])) {
+			ends = append(ends, i)
+		}
+	}
+	for k := len(ends) - 1; k >= 0; k-- {
+		if strings.Contains(line[:ends[k]], "#") {
+			continue
+		}
+		doc := hron.ParseDocument(line[:ends[k]])
+		if len(doc.Entries) != 1 {
+			continue
+		}
+		e := doc.Entries[0]
+		command := strings.TrimSpace(line[ends[k]:])
+		if command == "" {
+			msg := "expected a command after the schedule"
+			span := hron.Span{Start: len(line), End: len(line)}
+			return Entry{}, &hron.DocumentError{Column: 1, Err: hron.ParseError(msg, span, line, "")}
+		}
+		return Entry{Name: e.Name, Schedule: e.Schedule, Command: command}, nil
+	}
+	// No prefix parses: report why the whole line does not, which points at the first
+	// mistake in the schedule
+	doc := hron.ParseDocument(line)
+	if len(doc.Errors) == 0 {
+		msg := "expected a schedule followed by a command"
+		return Entry{}, &hron.DocumentError{Column: 1, Err: hron.ParseError(msg, hron.Span{Start: 0, End: len(line)}, line, "")}
+	}
+	err := doc.Errors[0]
+	return Entry{}, &err
+}
+
+// ReadFile reads and parses the hrontab file at path.
+func ReadFile(path string) (*Table, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(string(text)), nil
+}
+
+// Executor runs the command of entry for the occurrence at t. It should return once
+// the command finishes, or soon after ctx is done.
+type Executor func(ctx context.Context, entry Entry, t time.Time) error
+
+// Option configures Run and Watch.
+type Option func(*config)
+
+type config struct {
+	exec     Executor
+	onError  func(Entry, error)
+	policy   runner.Policy
+	interval time.Duration
+	stdout   io.Writer
+	stderr   io.Writer
+}
+
+// WithExecutor replaces how commands are run. By default each runs in /bin/sh -c with
+// the environment of the process plus HRON_TIME, the occurrence in RFC 3339, and
+// HRON_NAME, the entry's name.
+func WithExecutor(exec Executor) Option {
+	return func(c *config) { c.exec = exec }
+}
+
+// WithOutput sets where the default executor sends the output of commands. The
+// default is the process's standard output and error.
+func WithOutput(stdout, stderr io.Writer) Option {
+	return func(c *config) { c.stdout, c.stderr = stdout, stderr }
+}
+
+// WithErrorHandler sets a function called with each failed command, each schedule that
+// cannot be evaluated, and each reload rejected for errors, passing the zero Entry for
+// the last. By default errors are written to standard error.
+func WithErrorHandler(fn func(Entry, error)) Option {
+	return func(c *config) { c.onError = fn }
+}
+
+// WithCatchUp sets the policy for occurrences missed while a command overran or the
+// process was suspended. The default is runner.Skip, as cron does.
+func WithCatchUp(policy runner.Policy) Option {
+	return func(c *config) { c.policy = policy }
+}
+
+// WithReloadInterval sets how often Watch rereads its file. The default is 10 seconds.
+func WithReloadInterval(d time.Duration) Option {
+	return func(c *config) { c.interval = d }
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{policy: runner.Skip, interval: reloadInterval, stdout: os.Stdout, stderr: os.Stderr}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.exec == nil {
+		c.exec = c.shell
+	}
+	if c.onError == nil {
+		c.onError = func(e Entry, err error) {
+			if e.Schedule == nil {
+				fmt.Fprintf(c.stderr, "hrontab: %v\n", err)
+				return
+			}
+			fmt.Fprintf(c.stderr, "hrontab: line %d: %v\n", e.Line, err)
+		}
+	}
+	return c
+}
+
+func (c *config) shell(ctx context.Context, e Entry, t time.Time) error {
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", e.Command)
+	cmd.Env = append(os.Environ(), "HRON_TIME="+t.Format(time.RFC3339), "HRON_NAME="+e.Name)
+	cmd.Stdout, cmd.Stderr = c.stdout, c.stderr
+	return cmd.Run()
+}
+
+// Run runs the commands of table at the occurrences of their schedules until ctx is
+// done, and returns ctx.Err() once the commands in progress have finished. Entries run
+// independently, each in its own goroutine; runs of one entry never overlap. An entry
+// whose schedule ends stops, and one whose schedule cannot be evaluated stops and is
+// reported to the error handler.
+func Run(ctx context.Context, table *Table, opts ...Option) error {
+	s := newSupervisor(newConfig(opts))
+	s.apply(ctx, table)
+	<-ctx.Done()
+	s.wait()
+	return ctx.Err()
+}
+
+// Watch runs the commands of the hrontab file at path like Run, rereading the file
+// every reload interval. When its text changes, entries no longer in it are stopped,
+// cancelling their commands in progress, and new ones started; entries with the same
+// name, schedule, and command keep running. A changed file with errors is reported to
+// the error handler and the previous entries keep running until it is fixed. Watch
+// returns the error of reading or parsing the file when it starts, or ctx.Err().
+func Watch(ctx context.Context, path string, opts ...Option) error {
+	c := newConfig(opts)
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	table := Parse(string(text))
+	if err := table.Err(); err != nil {
+		return err
+	}
+
+	s := newSupervisor(c)
+	defer s.wait()
+	s.apply(ctx, table)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		next, err := os.ReadFile(path)
+		if err != nil {
+			c.onError(Entry{}, fmt.Errorf("reloading %s: %w", path, err))
+			continue
+		}
+		if string(next) == string(text) {
+			continue
+		}
+		text = next
+		table := Parse(string(text))
+		if err := table.Err(); err != nil {
+			c.onError(Entry{}, fmt.Errorf("not reloading %s: %w", path, err))
+			continue
+		}
+		s.apply(ctx, table)
+	}
+}
+
+// supervisor runs the entries of a table, each under its own context.
+type supervisor struct {
+	c       *config
+	running map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newSupervisor(c *config) *supervisor {
+	return &supervisor{c: c, running: map[string]context.CancelFunc{}}
+}
+
+// apply stops the running entries not in table and starts those of table not running.
+func (s *supervisor) apply(ctx context.Context, table *Table) {
+	keep := map[string]bool{}
+	for _, e := range table.Entries {
+		// Identical lines each run: number the repeats
+		key := e.key()
+		for i := 2; keep[key]; i++ {
+			key = fmt.Sprintf("%s\x00%d", e.key(), i)
+		}
+		keep[key] = true
+		if _, ok := s.running[key]; ok {
+			continue
+		}
+		entryCtx, cancel := context.WithCancel(ctx)
+		s.running[key] = cancel
+		s.wg.Go(func() { s.run(entryCtx, e) })
+	}
+	for key, cancel := range s.running {
+		if !keep[key] {
+			cancel()
+			delete(s.running, key)
+		}
+	}
+}
+
+func (s *supervisor) run(ctx context.Context, e Entry) {
+	err := runner.Schedule(ctx, e.Schedule, func(t time.Time) {
+		if err := s.c.exec(ctx, e, t); err != nil && ctx.Err() == nil {
+			s.c.onError(e, err)
+		}
+	}, runner.WithCatchUp(s.c.policy))
+	if err != nil && ctx.Err() == nil {
+		s.c.onError(e, err)
+	}
+}
+
+func (s *supervisor) wait() {
+	s.wg.Wait()
+}
@@ -0,0 +1,204 @@
+package hrontab
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// Tests of running run in a synctest bubble, where the clock starts at 2000-01-01 00:00
+// UTC and advances only when every goroutine is blocked.
+
+func TestParse(t *testing.T) {
+	table := Parse(strings.Join([]string{
+		"# comment",
+		"",
+		"every weekday at 09:00 in America/New_York   /usr/local/bin/send-report --daily",
+		"backup: every day at 02:00 in UTC  tar czf /backups/home.tgz /home # keep",
+		"every day at 09:00",
+		"every mondy at 09:00 echo hi",
+		"backup: every day at 03:00 echo again",
+		"   # indented comment",
+		"every 30 min echo at 5",
+	}, "\n"))
+
+	want := []struct {
+		name, schedule, command string
+		line                    int
+	}{
+		{"", "every weekday at 09:00 in America/New_York", "/usr/local/bin/send-report --daily", 3},
+		{"backup", "every day at 02:00 in UTC", "tar czf /backups/home.tgz /home # keep", 4},
+		{"", "every 30 min from 00:00 to 23:59", "echo at 5", 9},
+	}
+	if len(table.Entries) != len(want) {
+		t.Fatalf("entries = %+v, want %d", table.Entries, len(want))
+	}
+	for i, w := range want {
+		e := table.Entries[i]
+		if e.Name != w.name || e.Schedule.String() != w.schedule || e.Command != w.command || e.Line != w.line {
+			t.Errorf("entry %d = %q %q %q line %d, want %q %q %q line %d",
+				i, e.Name, e.Schedule, e.Command, e.Line, w.name, w.schedule, w.command, w.line)
+		}
+	}
+
+	wantErrs := []struct {
+		line    int
+		message string
+	}{
+		{5, "expected a command after the schedule"},
+		{6, "unknown keyword 'mondy'"},
+		{7, "schedule name 'backup' is already used on line 4"},
+	}
+	if len(table.Errors) != len(wantErrs) {
+		t.Fatalf("errors = %v, want %d", table.Errors, len(wantErrs))
+	}
+	for i, w := range wantErrs {
+		e := table.Errors[i]
+		var herr *hron.HronError
+		if e.Line != w.line || !errors.As(&e, &herr) || herr.Message != w.message {
+			t.Errorf("error %d = %v, want line %d: %s", i, &e, w.line, w.message)
+		}
+	}
+	if err := table.Err(); err == nil || !strings.Contains(err.Error(), "line 6:") {
+		t.Errorf("Err() = %v", err)
+	}
+}
+
+// recorder is an Executor recording the commands it runs.
+type recorder struct {
+	mu   sync.Mutex
+	runs []string
+}
+
+func (r *recorder) exec(_ context.Context, e Entry, t time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runs = append(r.runs, t.UTC().Format("02 15:04 ")+e.Command)
+	return nil
+}
+
+func (r *recorder) got() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Sorted(slices.Values(r.runs))
+}
+
+func TestRun(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 35*time.Hour)
+		defer cancel()
+
+		table := Parse("every day at 09:00 in UTC  report\nevery day at 12:00 in UTC  lunch\n")
+		var r recorder
+		err := Run(ctx, table, WithExecutor(r.exec))
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Run() = %v, want context.DeadlineExceeded", err)
+		}
+		want := []string{"01 09:00 report", "01 12:00 lunch", "02 09:00 report"}
+		if got := r.got(); !slices.Equal(got, want) {
+			t.Errorf("runs = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestRunReportsFailures(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Hour)
+		defer cancel()
+
+		var mu sync.Mutex
+		var failed []string
+		Run(ctx, Parse("job: every day at 09:00 in UTC  false"),
+			WithExecutor(func(context.Context, Entry, time.Time) error { return errors.New("exit status 1") }),
+			WithErrorHandler(func(e Entry, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = append(failed, e.Name+": "+err.Error())
+			}))
+
+		if want := []string{"job: exit status 1"}; !slices.Equal(failed, want) {
+			t.Errorf("failures = %v, want %v", failed, want)
+		}
+	})
+}
+
+func TestWatchReloads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hrontab")
+	write := func(text string) {
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("every day at 09:00 in UTC  report\n")
+
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 61*time.Hour)
+		defer cancel()
+
+		var r recorder
+		var mu sync.Mutex
+		var rejected []error
+		done := make(chan error)
+		go func() {
+			done <- Watch(ctx, path, WithExecutor(r.exec), WithReloadInterval(time.Minute),
+				WithErrorHandler(func(_ Entry, err error) {
+					mu.Lock()
+					defer mu.Unlock()
+					rejected = append(rejected, err)
+				}))
+		}()
+
+		// Day 1 10:00: add a job, keeping the report running
+		time.Sleep(10 * time.Hour)
+		write("every day at 09:00 in UTC  report\nevery day at 12:00 in UTC  lunch\n")
+		// Day 2 10:00: a broken file leaves both running
+		time.Sleep(24 * time.Hour)
+		write("every day at 09:00 in UTC  report\nevery mondy at 12:00 lunch\n")
+		// Day 3 10:00: drop the report
+		time.Sleep(24 * time.Hour)
+		write("every day at 12:00 in UTC  lunch\n")
+
+		if err := <-done; !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Watch() = %v, want context.DeadlineExceeded", err)
+		}
+		want := []string{"01 09:00 report", "01 12:00 lunch", "02 09:00 report", "02 12:00 lunch", "03 09:00 report", "03 12:00 lunch"}
+		if got := r.got(); !slices.Equal(got, want) {
+			t.Errorf("runs = %v, want %v", got, want)
+		}
+		if len(rejected) != 1 || !strings.Contains(rejected[0].Error(), "mondy") {
+			t.Errorf("rejected reloads = %v, want the broken file", rejected)
+		}
+	})
+}
+
+func TestWatchRejectsBrokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hrontab")
+	os.WriteFile(path, []byte("every day at 09:00\n"), 0o644)
+	if err := Watch(context.Background(), path); err == nil || !strings.Contains(err.Error(), "expected a command") {
+		t.Errorf("Watch() = %v, want the parse error", err)
+	}
+	if err := Watch(context.Background(), filepath.Join(t.TempDir(), "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Watch() = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestShellExecutor(t *testing.T) {
+	var stdout bytes.Buffer
+	c := newConfig([]Option{WithOutput(&stdout, &stdout)})
+	at := time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC)
+	err := c.exec(context.Background(), Entry{Name: "job", Command: `echo "$HRON_NAME $HRON_TIME"`}, at)
+	if err != nil || stdout.String() != "job 2000-01-01T09:00:00Z\n" {
+		t.Errorf("shell = %v with output %q", err, stdout.String())
+	}
+}
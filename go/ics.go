@@ -0,0 +1,333 @@
+package hron
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxICSEvents bounds the events of an expanded export, so a frequent schedule over a
+// long range fails instead of writing millions of events.
+const maxICSEvents = 10000
+
+// icsDays are the iCalendar weekday codes, indexed by ISO weekday number.
+var icsDays = [8]string{"", "MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+const (
+	icsUTCFormat   = "20060102T150405Z"
+	icsLocalFormat = "20060102T150405"
+)
+
+// ExportICS renders the schedule as an iCalendar (RFC 5545) file that calendar apps can
+// import or subscribe to, with summary as the title of its events and now, the time the
+// file is created, as their DTSTAMP.
+//
+// Schedules that an RRULE describes exactly (day, week, month, and year repeats at clock
+// times with at most a month-only during clause and an ISO until date) become a single recurring
+// VEVENT starting at the first occurrence at or after from, which keeps repeating past
+// to; its VTIMEZONE lists the zone's offset changes between from and to. Other schedules
+// are expanded into one VEVENT per occurrence in [from, to), in UTC, and fail with an
+// EvalError wrapping ErrLimitExceeded beyond 10000 occurrences. It also returns the
+// Validate error of a schedule that cannot be evaluated.
+func ExportICS(schedule *Schedule, summary string, from, to, now time.Time) ([]byte, error) {
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	if !to.After(from) {
+		return nil, EvalError("ICS export needs to after from")
+	}
+
+	w := &icsWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//hron//hron Go " + Version + "//EN")
+	w.line("CALSCALE:GREGORIAN")
+
+	stamp := now.UTC().Format(icsUTCFormat)
+	uid := icsUID(schedule.String())
+	if rule, ok := icsRRule(schedule); ok {
+		if first, found := schedule.NextTime(from.Add(-time.Nanosecond)); found {
+			dtstart := "DTSTART:" + first.UTC().Format(icsUTCFormat)
+			if schedule.tzName != "" {
+				w.vtimezone(schedule.tzName, schedule.location, from, to)
+				dtstart = "DTSTART;TZID=" + schedule.tzName + ":" + first.In(schedule.location).Format(icsLocalFormat)
+			}
+			w.line("BEGIN:VEVENT")
+			w.line("UID:" + uid + "@hron")
+			w.line("DTSTAMP:" + stamp)
+			w.line(dtstart)
+			w.line("RRULE:" + rule)
+			w.text("SUMMARY", summary)
+			w.text("DESCRIPTION", schedule.String())
+			w.line("END:VEVENT")
+		}
+	} else {
+		n := 0
+		for occ := range Between(schedule, from.Add(-time.Nanosecond), to) {
+			if !occ.Before(to) {
+				break
+			}
+			if n++; n > maxICSEvents {
+				err := EvalError(fmt.Sprintf("more than %d occurrences to export; shorten the range", maxICSEvents))
+				err.cause = ErrLimitExceeded
+				return nil, err
+			}
+			w.line("BEGIN:VEVENT")
+			w.line(fmt.Sprintf("UID:%s-%d@hron", uid, occ.Unix()))
+			w.line("DTSTAMP:" + stamp)
+			w.line("DTSTART:" + occ.UTC().Format(icsUTCFormat))
+			w.text("SUMMARY", summary)
+			w.text("DESCRIPTION", schedule.String())
+			w.line("END:VEVENT")
+		}
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes(), nil
+}
+
+// ExportICS renders the schedule as an iCalendar file; see the ExportICS function.
+func (s *Schedule) ExportICS(summary string, from, to, now time.Time) ([]byte, error) {
+	return ExportICS(s, summary, from, to, now)
+}
+
+// icsRRule returns the RRULE of a schedule, or false if an RRULE cannot describe it
+// exactly. The rule relies on DTSTART being the schedule's first occurrence, so that
+// INTERVAL counts from an aligned day, week, month, or year.
+func icsRRule(schedule *Schedule) (string, bool) {
+	data := schedule.data
+	expr := data.Expr
+	if len(data.Except) > 0 || data.Alignment != AlignmentDefault || !duringMonthsOnly(data) || data.Offset != (Offset{}) || usesSolar(data) || len(data.Timezones) > 0 ||
+		(data.Until != nil && data.Until.Kind != UntilSpecKindISO) {
+		return "", false
+	}
+
+	var parts []string
+	interval := expr.Interval
+	// BYSETPOS picks from every time of the day set, not the day, so it needs one time
+	setPos := false
+	switch expr.Kind {
+	case ScheduleExprKindDay:
+		parts = append(parts, "FREQ=DAILY")
+		if expr.Days.Kind != DayFilterKindEvery {
+			parts = append(parts, "BYDAY="+icsDayFilter(expr.Days))
+		}
+	case ScheduleExprKindWeek:
+		parts = append(parts, "FREQ=WEEKLY", "WKST=MO", "BYDAY="+icsDayFilter(NewDayFilterDays(expr.WeekDays)))
+	case ScheduleExprKindMonth:
+		by, ok := icsMonthTarget(expr.MonthTarget)
+		if !ok {
+			return "", false
+		}
+		parts = append(parts, "FREQ=MONTHLY", by)
+		setPos = expr.MonthTarget.Kind == MonthTargetKindLastWeekday
+	case ScheduleExprKindYear:
+		if len(expr.YearTargets) > 1 {
+			return "", false
+		}
+		target := expr.YearTarget
+		if target.Kind == YearTargetKindDayOfYear {
+			parts = append(parts, "FREQ=YEARLY", fmt.Sprintf("BYYEARDAY=%d", target.Day))
+			break
+		}
+		parts = append(parts, "FREQ=YEARLY", fmt.Sprintf("BYMONTH=%d", target.Month.Number()))
+		switch target.Kind {
+		case YearTargetKindDate, YearTargetKindDayOfMonth:
+			parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", target.Day))
+		case YearTargetKindOrdinalWeekday:
+			parts = append(parts, fmt.Sprintf("BYDAY=%d%s", target.Ordinal.ToN(), icsDays[target.Weekday.Number()]))
+		case YearTargetKindLastWeekday:
+			parts = append(parts, "BYDAY=MO,TU,WE,TH,FR", "BYSETPOS=-1")
+			setPos = true
+		}
+	default:
+		return "", false
+	}
+	if interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", interval))
+	}
+
+	hours, minutes, ok := icsTimes(expr.Times)
+	if !ok || (setPos && len(expr.Times) > 1) {
+		return "", false
+	}
+	parts = append(parts, "BYHOUR="+hours, "BYMINUTE="+minutes)
+
+	if months := duringMonths(data); len(months) > 0 {
+		nums := make([]int, len(months))
+		for i, m := range months {
+			nums[i] = m.Number()
+		}
+		parts = append(parts, "BYMONTH="+icsIntList(nums))
+	}
+	if data.Until != nil {
+		d, err := parseISODate(data.Until.Date)
+		if err != nil {
+			return "", false
+		}
+		last := time.Date(d.Year(), d.Month(), d.Day(), 23, 59, 59, 0, schedule.location)
+		if t := data.Until.Time; t != nil {
+			last = time.Date(d.Year(), d.Month(), d.Day(), t.Hour, t.Minute, 0, 0, schedule.location)
+		}
+		parts = append(parts, "UNTIL="+last.UTC().Format(icsUTCFormat))
+	}
+	return strings.Join(parts, ";"), true
+}
+
+// icsMonthTarget returns the BY rule parts selecting a month target's days.
+func icsMonthTarget(target MonthTarget) (string, bool) {
+	switch target.Kind {
+	case MonthTargetKindDays:
+		return "BYMONTHDAY=" + icsIntList(target.ExpandDays()), true
+	case MonthTargetKindLastDay:
+		return "BYMONTHDAY=-1", true
+	case MonthTargetKindLastWeekday:
+		return "BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", true
+	case MonthTargetKindOrdinalWeekday:
+		var days []string
+		for _, pair := range target.OrdinalWeekdays() {
+			days = append(days, fmt.Sprintf("%d%s", pair.Ordinal.ToN(), icsDays[pair.Weekday.Number()]))
+		}
+		return "BYDAY=" + strings.Join(days, ","), true
+	}
+	return "", false
+}
+
+func icsDayFilter(f DayFilter) string {
+	var days []string
+	for iso := 1; iso <= 7; iso++ {
+		// Any date with this ISO weekday; 2024-01-01 was a Monday
+		if matchesDayFilter(time.Date(2024, 1, iso, 0, 0, 0, 0, time.UTC), f) {
+			days = append(days, icsDays[iso])
+		}
+	}
+	return strings.Join(days, ",")
+}
+
+// icsTimes returns BYHOUR and BYMINUTE lists for the times, which an RRULE combines in
+// every pairing; false if that adds times not listed.
+func icsTimes(times []TimeOfDay) (string, string, bool) {
+	var hours, minutes []int
+	for _, t := range times {
+		hours = append(hours, t.Hour)
+		minutes = append(minutes, t.Minute)
+	}
+	slices.Sort(hours)
+	slices.Sort(minutes)
+	hours, minutes = slices.Compact(hours), slices.Compact(minutes)
+	for _, h := range hours {
+		for _, m := range minutes {
+			if !slices.Contains(times, TimeOfDay{h, m}) {
+				return "", "", false
+			}
+		}
+	}
+	return icsIntList(hours), icsIntList(minutes), true
+}
+
+func icsIntList(nums []int) string {
+	nums = slices.Compact(slices.Sorted(slices.Values(nums)))
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.Itoa(n)
+	}
+	return strings.Join(strs, ",")
+}
+
+// icsUID derives a stable UID from the canonical expression, so re-exports of the same
+// schedule update the events a calendar already has instead of duplicating them.
+func icsUID(canonical string) string {
+	h := fnv.New64a()
+	h.Write([]byte(canonical))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+type icsWriter struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line, folded with CRLF line breaks so that no line is longer
+// than 75 octets, counting the space that starts each continuation line.
+func (w *icsWriter) line(s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 { // do not split a UTF-8 sequence
+			cut--
+		}
+		w.buf.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		limit = 74
+	}
+	w.buf.WriteString(s + "\r\n")
+}
+
+// text writes a TEXT property, escaping its value.
+func (w *icsWriter) text(name, value string) {
+	value = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+	w.line(name + ":" + value)
+}
+
+// vtimezone writes a VTIMEZONE for loc: the observance in effect at from, then one for
+// each offset change up to to. Go does not expose a zone's rules, so the changes are
+// found by sampling the offset daily.
+func (w *icsWriter) vtimezone(name string, loc *time.Location, from, to time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + name)
+
+	t := from.In(loc)
+	abbr, offset := t.Zone()
+	w.observance(t, abbr, offset, offset, t.IsDST())
+	for t.Before(to) {
+		next := t.Add(24 * time.Hour).In(loc)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// Narrow the change down to the second
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, o := mid.Zone(); o == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			nextAbbr, newOffset := hi.Zone()
+			w.observance(hi, nextAbbr, offset, newOffset, hi.IsDST())
+			offset = newOffset
+		}
+		t = next
+	}
+	w.line("END:VTIMEZONE")
+}
+
+// observance writes a STANDARD or DAYLIGHT block starting at t, whose DTSTART is local
+// time in the offset before it.
+func (w *icsWriter) observance(t time.Time, abbr string, fromOffset, toOffset int, dst bool) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + t.UTC().Add(time.Duration(fromOffset)*time.Second).Format(icsLocalFormat))
+	w.line("TZOFFSETFROM:" + icsOffset(fromOffset))
+	w.line("TZOFFSETTO:" + icsOffset(toOffset))
+	w.line("TZNAME:" + abbr)
+	w.line("END:" + kind)
+}
+
+// icsOffset formats a UTC offset in seconds as ±hhmm, or ±hhmmss when it has seconds.
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
@@ -0,0 +1,175 @@
+package hron
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// icsStamp is when the test ICS files are created.
+var icsStamp = time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+
+// icsLines returns the unfolded content lines of an ICS file.
+func icsLines(t *testing.T, data []byte) []string {
+	t.Helper()
+	s := string(data)
+	if !strings.HasSuffix(s, "\r\n") {
+		t.Fatalf("ICS does not end in CRLF: %q", s)
+	}
+	var lines []string
+	for _, l := range strings.Split(strings.ReplaceAll(s, "\r\n ", ""), "\r\n") {
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func TestExportICSRRule(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expr    string
+		dtstart string
+		rrule   string
+	}{
+		{"every weekday at 09:00", "DTSTART:20260302T090000Z", "RRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0"},
+		{"every 3 days at 09:00, 17:00 in UTC", "DTSTART;TZID=UTC:20260302T090000", "RRULE:FREQ=DAILY;INTERVAL=3;BYHOUR=9,17;BYMINUTE=0"},
+		{"every 2 weeks on monday, thursday at 08:30 in Europe/Berlin", "DTSTART;TZID=Europe/Berlin:20260302T083000", "RRULE:FREQ=WEEKLY;WKST=MO;BYDAY=MO,TH;INTERVAL=2;BYHOUR=8;BYMINUTE=30"},
+		{"every month on the 1st, 15th at 09:00 during jan, jul", "DTSTART:20260701T090000Z", "RRULE:FREQ=MONTHLY;BYMONTHDAY=1,15;BYHOUR=9;BYMINUTE=0;BYMONTH=1,7"},
+		{"every month on the last day at 18:00 until 2026-12-31", "DTSTART:20260331T180000Z", "RRULE:FREQ=MONTHLY;BYMONTHDAY=-1;BYHOUR=18;BYMINUTE=0;UNTIL=20261231T235959Z"},
+		{"every month on the last day at 18:00 until 2026-12-31 12:00", "DTSTART:20260331T180000Z", "RRULE:FREQ=MONTHLY;BYMONTHDAY=-1;BYHOUR=18;BYMINUTE=0;UNTIL=20261231T120000Z"},
+		{"every month on the second to last friday at 09:00", "DTSTART:20260320T090000Z", "RRULE:FREQ=MONTHLY;BYDAY=-2FR;BYHOUR=9;BYMINUTE=0"},
+		{"every year on the first monday of september at 09:00", "DTSTART:20260907T090000Z", "RRULE:FREQ=YEARLY;BYMONTH=9;BYDAY=1MO;BYHOUR=9;BYMINUTE=0"},
+		{"every year on the 256th day at 09:00", "DTSTART:20260913T090000Z", "RRULE:FREQ=YEARLY;BYYEARDAY=256;BYHOUR=9;BYMINUTE=0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			data, err := ExportICS(MustParse(tt.expr), "Report", from, to, icsStamp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := icsLines(t, data)
+			var events int
+			var dtstart, rrule string
+			for _, l := range lines {
+				switch {
+				case l == "BEGIN:VEVENT":
+					events++
+				case strings.HasPrefix(l, "DTSTART") && events > 0:
+					dtstart = l
+				case strings.HasPrefix(l, "RRULE:"):
+					rrule = l
+				}
+			}
+			if events != 1 || dtstart != tt.dtstart || rrule != tt.rrule {
+				t.Errorf("got %d events, %s, %s; want %s, %s", events, dtstart, rrule, tt.dtstart, tt.rrule)
+			}
+		})
+	}
+}
+
+func TestExportICSTimezone(t *testing.T) {
+	s := MustParse("every day at 09:00 in America/New_York")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	data, err := ExportICS(s, "Standup", from, to, icsStamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(icsLines(t, data), "\n")
+	for _, want := range []string{
+		"BEGIN:VTIMEZONE\nTZID:America/New_York\nBEGIN:STANDARD\nDTSTART:20251231T190000\nTZOFFSETFROM:-0500\nTZOFFSETTO:-0500\nTZNAME:EST\nEND:STANDARD",
+		"BEGIN:DAYLIGHT\nDTSTART:20260308T020000\nTZOFFSETFROM:-0500\nTZOFFSETTO:-0400\nTZNAME:EDT\nEND:DAYLIGHT",
+		"BEGIN:STANDARD\nDTSTART:20261101T020000\nTZOFFSETFROM:-0400\nTZOFFSETTO:-0500\nTZNAME:EST\nEND:STANDARD\nEND:VTIMEZONE",
+		"DTSTART;TZID=America/New_York:20260101T090000",
+		"DTSTAMP:20260206T120000Z\nDTSTART;TZID=America/New_York:20260101T090000",
+		"SUMMARY:Standup\nDESCRIPTION:every day at 09:00 in America/New_York",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ICS missing\n%s\nin\n%s", want, got)
+		}
+	}
+}
+
+func TestExportICSExpanded(t *testing.T) {
+	s := MustParse("every 30 min from 09:00 to 10:00 except 2026-03-03")
+	from := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 4, 9, 30, 0, 0, time.UTC)
+	data, err := ExportICS(s, "Poll; check, report", from, to, icsStamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var starts []string
+	for _, l := range icsLines(t, data) {
+		if strings.HasPrefix(l, "DTSTART:") {
+			starts = append(starts, strings.TrimPrefix(l, "DTSTART:"))
+		}
+		if strings.HasPrefix(l, "RRULE") || strings.HasPrefix(l, "BEGIN:VTIMEZONE") {
+			t.Errorf("unexpected %s", l)
+		}
+		if strings.HasPrefix(l, "SUMMARY:") && l != `SUMMARY:Poll\; check\, report` {
+			t.Errorf("summary not escaped: %s", l)
+		}
+	}
+	// from is included and to excluded
+	want := "20260302T090000Z 20260302T093000Z 20260302T100000Z 20260304T090000Z"
+	if got := strings.Join(starts, " "); got != want {
+		t.Errorf("DTSTART = %s, want %s", got, want)
+	}
+
+	_, err = ExportICS(MustParse("every 1 min from 00:00 to 23:59"), "x", from, from.AddDate(0, 1, 0), icsStamp)
+	if !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected ErrLimitExceeded, got %v", err)
+	}
+	if _, err := ExportICS(s, "x", to, from, icsStamp); err == nil {
+		t.Error("expected an error for an empty range")
+	}
+	if _, err := ExportICS(MustParse("every day at 09:00 in local"), "x", from, to, icsStamp); err == nil {
+		t.Error("expected the Validate error of an unbound schedule")
+	}
+}
+
+func TestICSFolding(t *testing.T) {
+	for _, value := range []string{strings.Repeat("é", 60), strings.Repeat("x", 200)} {
+		w := &icsWriter{}
+		w.text("SUMMARY", value)
+		for _, l := range strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n") {
+			if len(l) > 75 {
+				t.Errorf("line of %d octets: %q", len(l), l)
+			}
+		}
+		if got := strings.ReplaceAll(w.buf.String(), "\r\n ", ""); got != "SUMMARY:"+value+"\r\n" {
+			t.Errorf("unfolded = %q", got)
+		}
+	}
+}
+
+func TestExportICSSetPosTimes(t *testing.T) {
+	// BYSETPOS would pick only the last time of the last weekday, so each is an event
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	for expr, want := range map[string]string{
+		"every month on the last weekday at 09:00, 17:00 in UTC":       "20260331T090000Z 20260331T170000Z",
+		"every year on the last weekday of mar at 09:00, 17:00 in UTC": "20260331T090000Z 20260331T170000Z",
+	} {
+		data, err := ExportICS(MustParse(expr), "x", from, to, icsStamp)
+		if err != nil {
+			t.Errorf("%q: %v", expr, err)
+			continue
+		}
+		var starts []string
+		for _, l := range icsLines(t, data) {
+			if strings.HasPrefix(l, "RRULE") {
+				t.Errorf("%q: unexpected %s", expr, l)
+			}
+			if strings.HasPrefix(l, "DTSTART") {
+				starts = append(starts, l[strings.LastIndex(l, ":")+1:])
+			}
+		}
+		if got := strings.Join(starts, " "); got != want {
+			t.Errorf("%q DTSTART = %s, want %s", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInclusiveBoundaries(t *testing.T) {
+	tests := []struct {
+		expr string
+		now  time.Time
+		next time.Time // NextFromInclusive
+		prev time.Time // PreviousFromInclusive
+	}{
+		// An occurrence exactly at now is returned both ways
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)},
+		// Off an occurrence they agree with NextFrom and PreviousFrom
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 9, 0, 0, 1, time.UTC),
+			time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)},
+		{"every 90 seconds from 09:00 to 10:00 in UTC", time.Date(2026, 3, 10, 9, 1, 30, 0, time.UTC),
+			time.Date(2026, 3, 10, 9, 1, 30, 0, time.UTC), time.Date(2026, 3, 10, 9, 1, 30, 0, time.UTC)},
+		{"every 30 min from 09:00 to 17:00 in America/New_York", time.Date(2026, 3, 10, 13, 30, 0, 0, time.UTC),
+			time.Date(2026, 3, 10, 13, 30, 0, 0, time.UTC), time.Date(2026, 3, 10, 13, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr)
+		if got := s.NextFromInclusive(tt.now); got == nil || !got.Equal(tt.next) {
+			t.Errorf("%q NextFromInclusive(%v) = %v, want %v", tt.expr, tt.now, got, tt.next)
+		}
+		if got := s.PreviousFromInclusive(tt.now); got == nil || !got.Equal(tt.prev) {
+			t.Errorf("%q PreviousFromInclusive(%v) = %v, want %v", tt.expr, tt.now, got, tt.prev)
+		}
+	}
+
+	// The strict forms still skip an occurrence at now
+	s := MustParse("every day at 09:00 in UTC")
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	if got := s.NextFrom(now); got == nil || got.Equal(now) {
+		t.Errorf("NextFrom(%v) = %v, want the next day", now, got)
+	}
+}
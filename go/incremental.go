@@ -0,0 +1,108 @@
+package hron
+
+import "slices"
+
+// incrementalLookback is how many tokens before an edit are lexed again: the lexer reads
+// up to three words past a token to decide it (2 to last friday, 9 am), so an edit can
+// change that many tokens before it.
+const incrementalLookback = 4
+
+// ParseState is an expression parsed by ParseIncremental, with its diagnostics. It keeps
+// the tokens of the parse so the next edit of the expression lexes only what changed.
+type ParseState struct {
+	Input    string
+	Schedule *ScheduleData // nil whenever Errors is not empty
+	Errors   []*HronError  // As ParseAll reports them
+
+	tokens  []Token
+	lexErrs []*HronError
+}
+
+// ParseIncremental parses input as ParseAll does, reusing the state of prev, a parse of
+// an earlier version of the same expression, so editors can refresh diagnostics on every
+// keystroke. Tokens before the first changed byte are kept and only the rest of input is
+// lexed again; when a valid expression's tokens come out unchanged, as after an edit of
+// whitespace, its schedule is kept without parsing. A nil prev parses input in full.
+func ParseIncremental(prev *ParseState, input string) *ParseState {
+	if prev != nil && prev.Input == input {
+		return prev
+	}
+	var kept []Token
+	var lexErrs []*HronError
+	restart := 0
+	if prev != nil {
+		kept, lexErrs, restart = prev.reusable(input)
+	}
+
+	rest, _ := (&lexer{input: input, pos: restart, errs: &lexErrs, before: kept}).tokenize()
+	tokens := append(kept, rest...)
+
+	state := &ParseState{Input: input, tokens: tokens, lexErrs: slices.Clone(lexErrs)}
+	if prev != nil && prev.Schedule != nil && len(lexErrs) == 0 && sameTokens(prev.tokens, tokens) {
+		state.Schedule = prev.Schedule
+		return state
+	}
+	state.Schedule, state.Errors = parseCollecting(tokens, lexErrs, input)
+	return state
+}
+
+// reusable returns the tokens and lex errors of s that an edit producing input leaves in
+// place, rebased onto input, and the offset to lex input from.
+func (s *ParseState) reusable(input string) ([]Token, []*HronError, int) {
+	changed := 0
+	for changed < len(s.Input) && changed < len(input) && s.Input[changed] == input[changed] {
+		changed++
+	}
+	// Tokens ending at the edit may grow into it; so may the lookback before them
+	keep := 0
+	for keep < len(s.tokens) && s.tokens[keep].Span.End < changed {
+		keep++
+	}
+	keep = max(keep-incrementalLookback, 0)
+	// The lexer reads zones after `in` and commas after zones; restart before the list
+	for keep > 0 && inZoneList(s.tokens[:keep]) {
+		keep--
+	}
+	restart := 0
+	if keep > 0 {
+		restart = s.tokens[keep-1].Span.End
+	}
+	var lexErrs []*HronError
+	for _, e := range s.lexErrs {
+		if e.Span.End <= restart {
+			lexErrs = append(lexErrs, e)
+		}
+	}
+	return slices.Clone(s.tokens[:keep]), rebase(lexErrs, input), restart
+}
+
+// inZoneList reports whether the last of tokens is lexed as part of a list of zones.
+func inZoneList(tokens []Token) bool {
+	n := len(tokens)
+	switch tokens[n-1].Kind {
+	case TokenIn, TokenTimezone:
+		return true
+	case TokenComma:
+		return n > 1 && tokens[n-2].Kind == TokenTimezone
+	}
+	return false
+}
+
+// sameTokens reports whether a and b are the same tokens, wherever they are in the input.
+func sameTokens(a, b []Token) bool {
+	return slices.EqualFunc(a, b, func(x, y Token) bool {
+		x.Span, y.Span = Span{}, Span{}
+		return x == y
+	})
+}
+
+// rebase returns copies of errs reporting against input, whose spans they still cover.
+func rebase(errs []*HronError, input string) []*HronError {
+	var out []*HronError
+	for _, e := range errs {
+		c := *e
+		c.Input = input
+		out = append(out, &c)
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package hron
+
+import (
+	"reflect"
+	"testing"
+)
+
+// checkIncremental fails unless state agrees with ParseAll of its input.
+func checkIncremental(t *testing.T, state *ParseState) {
+	t.Helper()
+	data, errs := ParseAll(state.Input)
+	if (data == nil) != (state.Schedule == nil) || (data != nil && Display(data) != Display(state.Schedule)) {
+		t.Errorf("ParseIncremental(%q) schedule = %v, want %v", state.Input, state.Schedule, data)
+	}
+	if len(errs) != len(state.Errors) {
+		t.Fatalf("ParseIncremental(%q) errors = %v, want %v", state.Input, state.Errors, errs)
+	}
+	for i := range errs {
+		if !reflect.DeepEqual(*errs[i], *state.Errors[i]) {
+			t.Errorf("ParseIncremental(%q) error %d = %+v, want %+v", state.Input, i, *state.Errors[i], *errs[i])
+		}
+	}
+}
+
+func TestParseIncrementalTyping(t *testing.T) {
+	// Type each expression a byte at a time, then delete it from the end
+	for _, input := range []string{
+		"every mondy at 9 am in America/New_York, Asia/Tokyo except dec 25",
+		"every month on the 2nd to last friday at 17:30 until 2030-01-01",
+		"every day at 09:00 in Nowhere/Zone",
+		"1st monday of every month at 10:00",
+		"on feb 14, 2 days after launch_day at 9:00 except launch",
+	} {
+		var state *ParseState
+		for i := 1; i <= len(input); i++ {
+			state = ParseIncremental(state, input[:i])
+			checkIncremental(t, state)
+		}
+		for i := len(input) - 1; i >= 0; i-- {
+			state = ParseIncremental(state, input[:i])
+			checkIncremental(t, state)
+		}
+	}
+}
+
+func TestParseIncrementalEdits(t *testing.T) {
+	edits := []string{
+		"every monday at 09:00",
+		"every mondy at 09:00",    // Error in the middle
+		"every monday at 09:00",   // Fixed
+		"every  monday at 09:00",  // Whitespace only
+		"every 1 monday at 09:00", // Insertion before a day
+		"every 1 to last monday at 09:00 in UTC",
+		"every monday at 09:00 in UTC", // Deletion
+		"every monday at 9 am in UTC",
+		"every monday at 9 pm in UTC",
+		"",
+	}
+	var state *ParseState
+	for _, input := range edits {
+		state = ParseIncremental(state, input)
+		checkIncremental(t, state)
+	}
+}
+
+func TestParseIncrementalReusesSchedule(t *testing.T) {
+	state := ParseIncremental(nil, "every monday at 09:00")
+	next := ParseIncremental(state, "every monday  at 09:00")
+	if next.Schedule != state.Schedule {
+		t.Errorf("a whitespace edit parsed again; want the previous schedule kept")
+	}
+	if ParseIncremental(next, next.Input) != next {
+		t.Errorf("an unchanged input returned a new state")
+	}
+	if edited := ParseIncremental(next, "every monday  at 10:00"); edited.Schedule == next.Schedule {
+		t.Errorf("an edit of a time kept the previous schedule")
+	}
+}
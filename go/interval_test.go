@@ -0,0 +1,384 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIntervalWindowValidation(t *testing.T) {
+	// Windows that never fire or fire only once parse; ValidateDetailed reports them
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	for input, want := range map[string]int{
+		"every 2 hours from 17:00 to 09:00 in UTC":  0,
+		"every 30 min from 09:00 to 09:00 in UTC":   3,
+		"every 2 hours from 09:00 to 10:00 in UTC":  3,
+		"every 25 hours from 00:00 to 23:59 in UTC": 3,
+	} {
+		s, err := ParseSchedule(input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", input, err)
+			continue
+		}
+		if got := s.CountBetween(from, from.AddDate(0, 0, 3)); got != want {
+			t.Errorf("%q CountBetween = %d, want %d", input, got, want)
+		}
+	}
+
+	_, err := Parse("every 1 hour from 09:00 to 17:00 next day")
+	hronErr, ok := err.(*HronError)
+	if !ok || hronErr.Kind != ErrorKindParse || !strings.Contains(hronErr.Message, "must end before it starts") {
+		t.Fatalf("Parse error = %v, want a parse error for the next day window", err)
+	}
+	if want := (Span{18, 41}); hronErr.Span == nil || *hronErr.Span != want {
+		t.Errorf("span = %v, want %v", hronErr.Span, want)
+	}
+
+	for _, input := range []string{
+		"every 1 hour from 09:00 to 10:00",
+		"every 45 min from 09:00 to 09:45",
+		"every 23 hours from 00:00 to 23:59",
+		"every 2 hours from 17:00 to 09:00 next day",
+		"every weekday at 22:00 to 02:00 next day every 30 min",
+	} {
+		if _, err := Parse(input); err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+		}
+	}
+}
+
+func TestInlineIntervalWindow(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every weekday at 09:00 to 17:00 every 30 min", "every 30 min from 09:00 to 17:00 on weekday"},
+		{"every day at 9am to 5pm every 2 hours", "every 2 hours from 09:00 to 17:00"},
+		{"every monday, friday at 08:00 to 09:00 every minute in UTC", "every 1 minute from 08:00 to 09:00 on monday, friday in UTC"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+		if s.data.Expr.Kind != ScheduleExprKindInterval {
+			t.Errorf("ParseSchedule(%q) kind = %v, want interval", tt.input, s.data.Expr.Kind)
+		}
+	}
+
+	s := MustParse("every weekday at 09:00 to 17:00 every 30 min in UTC")
+	// Saturday evening rolls over to Monday morning
+	next := s.NextFrom(time.Date(2026, 3, 7, 18, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+
+	for _, input := range []string{
+		"every 2 days at 09:00 to 17:00 every 30 min",
+		"every weekday at 09:00 to 17:00",
+		"every weekday at 09:00 to 17:00 every 30 days",
+		"every weekday at 09:00, 10:00 to 17:00 every 30 min",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestIntervalImpliedWindow(t *testing.T) {
+	tests := []struct {
+		input, want, cron string
+	}{
+		{"every 4 hours", "every 4 hours from 00:00 to 23:59", "0 */4 * * *"},
+		{"every 15 min", "every 15 min from 00:00 to 23:59", "*/15 * * * *"},
+		{"every 2 hours from 06:00", "every 2 hours from 06:00 to 23:59", ""},
+		{"every 2 hours starting at 06:00", "every 2 hours from 06:00 to 23:59", ""},
+		{"every 30 min starting at 09:00 to 17:00 starting 2026-03-02", "every 30 min from 09:00 to 17:00 starting 2026-03-02", ""},
+		{"every 3 hours from 08:30 on weekdays", "every 3 hours from 08:30 to 23:59 on weekday", ""},
+		{"every hour until 2027-01-01", "every 1 hour from 00:00 to 23:59 until 2027-01-01", ""},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+		if tt.cron == "" {
+			continue
+		}
+		if cron, err := s.ToCron(); err != nil || cron != tt.cron {
+			t.Errorf("ParseSchedule(%q).ToCron() = %q, %v, want %q", tt.input, cron, err, tt.cron)
+		}
+	}
+
+	// Like any partial-day window, an open-ended one has no exact cron form
+	if cron, err := MustParse("every 2 hours from 06:00").ToCron(); err == nil {
+		t.Errorf("ToCron() = %q, want error", cron)
+	}
+
+	s := MustParse("every 2 hours from 20:00 in UTC")
+	// 22:00 is the last slot of the day
+	next := s.NextFrom(time.Date(2026, 3, 7, 22, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 8, 20, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+
+	for _, input := range []string{
+		"every 30 min to 17:00",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestIntervalPreviousFrom(t *testing.T) {
+	tests := []struct {
+		expr string
+		now  time.Time
+		want time.Time
+	}{
+		// Missed-run detection outside a december-only window
+		{"every 30 min from 09:00 to 17:00 on weekday during dec in UTC",
+			time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 17, 0, 0, 0, time.UTC)},
+		{"every 30 min from 09:00 to 17:00 on weekday except dec 29, dec 30, dec 31 during dec in UTC",
+			time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 26, 17, 0, 0, 0, time.UTC)},
+		{"every 15 min from 22:00 to 23:00 on sunday during dec in UTC",
+			time.Date(2026, 12, 1, 8, 0, 0, 0, time.UTC), time.Date(2025, 12, 28, 23, 0, 0, 0, time.UTC)},
+		// A slot earlier in the current minute is still in the past
+		{"every 30 min from 09:00 to 17:00 in UTC",
+			time.Date(2026, 12, 10, 9, 30, 30, 0, time.UTC), time.Date(2026, 12, 10, 9, 30, 0, 0, time.UTC)},
+		{"every 30 min from 09:00 to 17:00 in UTC",
+			time.Date(2026, 12, 10, 9, 30, 0, 0, time.UTC), time.Date(2026, 12, 10, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := MustParse(tt.expr).PreviousFrom(tt.now)
+		if got == nil || !got.Equal(tt.want) {
+			t.Errorf("%q PreviousFrom(%v) = %v, want %v", tt.expr, tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestIntervalSeconds(t *testing.T) {
+	for _, tt := range []struct{ input, want string }{
+		{"every 90 seconds from 09:00 to 10:00", "every 90 seconds from 09:00 to 10:00"},
+		{"every 30 secs from 09:00 to 09:05 on weekday", "every 30 seconds from 09:00 to 09:05 on weekday"},
+		{"every 1 second from 09:00 to 09:01", "every 1 second from 09:00 to 09:01"},
+		{"every weekday at 09:00 to 09:10 every 45 sec", "every 45 seconds from 09:00 to 09:10 on weekday"},
+	} {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	s := MustParse("every 90 seconds from 09:00 to 10:00 in UTC")
+	got := s.NextNFrom(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), 3)
+	want := []time.Time{
+		time.Date(2026, 3, 2, 9, 1, 30, 0, time.UTC),
+		time.Date(2026, 3, 2, 9, 3, 0, 0, time.UTC),
+		time.Date(2026, 3, 2, 9, 4, 30, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// The window end is inclusive; the next slot is the following morning
+	next := s.NextFrom(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom at window end = %v, want %v", next, want)
+	}
+	prev := s.PreviousFrom(time.Date(2026, 3, 2, 9, 4, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 2, 9, 3, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, want)
+	}
+
+	if !s.Matches(time.Date(2026, 3, 2, 9, 1, 30, 0, time.UTC)) {
+		t.Error("09:01:30 should match")
+	}
+	if s.Matches(time.Date(2026, 3, 2, 9, 1, 0, 0, time.UTC)) {
+		t.Error("09:01:00 should not match")
+	}
+
+	var count int
+	for range s.Between(time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)) {
+		count++
+	}
+	if count != 41 {
+		t.Errorf("Between counted %d occurrences, want 41", count)
+	}
+
+	if _, err := MustParse("every 30 seconds from 00:00 to 23:59").ToCron(); err == nil {
+		t.Error("expected ToCron error for a seconds interval")
+	}
+	if report, err := ValidateDetailed("every 90 seconds from 09:00 to 09:01"); err != nil || len(report.Findings) != 1 {
+		t.Errorf("ValidateDetailed = %+v, %v, want a finding for a step longer than the window", report, err)
+	}
+}
+
+func TestIntervalAcrossMidnight(t *testing.T) {
+	s := MustParse("every 30 min from 22:00 to 02:00 next day on friday in UTC")
+	if got := s.String(); got != "every 30 min from 22:00 to 02:00 next day on friday in UTC" {
+		t.Errorf("String() = %q", got)
+	}
+
+	// Friday night runs into saturday morning
+	got := s.NextNFrom(time.Date(2026, 3, 6, 23, 10, 0, 0, time.UTC), 7)
+	want := []time.Time{
+		time.Date(2026, 3, 6, 23, 30, 0, 0, time.UTC),
+		time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 7, 0, 30, 0, 0, time.UTC),
+		time.Date(2026, 3, 7, 1, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 7, 1, 30, 0, 0, time.UTC),
+		time.Date(2026, 3, 7, 2, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 13, 22, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	prev := s.PreviousFrom(time.Date(2026, 3, 7, 1, 15, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 7, 1, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, want)
+	}
+	prev = s.PreviousFrom(time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 7, 2, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, want)
+	}
+
+	if !s.Matches(time.Date(2026, 3, 7, 0, 30, 0, 0, time.UTC)) {
+		t.Error("saturday 00:30 should match friday's window")
+	}
+	if s.Matches(time.Date(2026, 3, 6, 0, 30, 0, 0, time.UTC)) {
+		t.Error("friday 00:30 belongs to thursday's window")
+	}
+	if s.Matches(time.Date(2026, 3, 7, 2, 30, 0, 0, time.UTC)) {
+		t.Error("02:30 is after the window")
+	}
+}
+
+func TestIntervalAcrossMidnightDST(t *testing.T) {
+	// Clocks go back at 02:00 on 2026-11-01 in New York, so 01:xx happens twice
+	s := MustParse("every 1 hour from 23:00 to 03:00 next day in America/New_York")
+	loc, _ := time.LoadLocation("America/New_York")
+	got := s.NextNFrom(time.Date(2026, 10, 31, 22, 0, 0, 0, loc), 5)
+	want := []string{"23:00 EDT", "00:00 EDT", "01:00 EDT", "02:00 EST", "03:00 EST"}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v", got)
+	}
+	for i := range want {
+		if s := got[i].In(loc).Format("15:04 MST"); s != want[i] {
+			t.Errorf("NextNFrom[%d] = %s, want %s", i, s, want[i])
+		}
+	}
+
+	// Clocks go forward at 02:00 on 2026-03-08, so 02:00 is pushed to 03:00
+	got = s.NextNFrom(time.Date(2026, 3, 7, 22, 0, 0, 0, loc), 5)
+	want = []string{"23:00 EST", "00:00 EST", "01:00 EST", "03:00 EDT", "23:00 EDT"}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v", got)
+	}
+	for i := range want {
+		if s := got[i].In(loc).Format("15:04 MST"); s != want[i] {
+			t.Errorf("NextNFrom[%d] = %s, want %s", i, s, want[i])
+		}
+	}
+}
+
+func TestIntervalWithAllClauses(t *testing.T) {
+	s := MustParse("every hour from 08:00 to 18:00 on weekdays except 2026-01-01, 2026-02-02 until 2026-02-20 starting 2026-01-01 during jan, feb in UTC")
+	if got := s.String(); got != "every 1 hour from 08:00 to 18:00 on weekday except 2026-01-01, 2026-02-02 until 2026-02-20 starting 2026-01-01 during jan, feb in UTC" {
+		t.Errorf("String() = %q", got)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		next *time.Time
+		prev *time.Time
+	}{
+		{"starting and except skip to jan 2", time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), timePtr(time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)), nil},
+		{"except skips monday feb 2", time.Date(2026, 1, 30, 18, 0, 0, 0, time.UTC), timePtr(time.Date(2026, 2, 3, 8, 0, 0, 0, time.UTC)), timePtr(time.Date(2026, 1, 30, 17, 0, 0, 0, time.UTC))},
+		{"until ends the schedule", time.Date(2026, 2, 20, 18, 0, 0, 0, time.UTC), nil, timePtr(time.Date(2026, 2, 20, 17, 0, 0, 0, time.UTC))},
+		{"previous from summer", time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), nil, timePtr(time.Date(2026, 2, 20, 18, 0, 0, 0, time.UTC))},
+	}
+	for _, tt := range tests {
+		next := s.NextFrom(tt.now)
+		if (next == nil) != (tt.next == nil) || (next != nil && !next.Equal(*tt.next)) {
+			t.Errorf("%s: NextFrom = %v, want %v", tt.name, next, tt.next)
+		}
+		prev := s.PreviousFrom(tt.now)
+		if (prev == nil) != (tt.prev == nil) || (prev != nil && !prev.Equal(*tt.prev)) {
+			t.Errorf("%s: PreviousFrom = %v, want %v", tt.name, prev, tt.prev)
+		}
+	}
+
+	if s.Matches(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Error("excepted jan 1 should not match")
+	}
+	if !s.Matches(time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)) {
+		t.Error("monday jan 5 should match")
+	}
+}
+
+// Date-level clauses apply to the day a wrapping window opened, like the day filter
+func TestIntervalAcrossMidnightClauses(t *testing.T) {
+	s := MustParse("every 1 hour from 22:00 to 02:00 next day except 2026-01-02 starting 2026-01-01 during jan in UTC")
+
+	got := s.NextNFrom(time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC), 6)
+	want := []time.Time{
+		time.Date(2026, 1, 1, 22, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 3, 22, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// jan 31's window runs into february
+	prev := s.PreviousFrom(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 2, 1, 2, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, want)
+	}
+	if !s.Matches(time.Date(2026, 2, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Error("feb 1 01:00 belongs to jan 31's window")
+	}
+	if s.Matches(time.Date(2026, 1, 3, 1, 0, 0, 0, time.UTC)) {
+		t.Error("jan 3 01:00 belongs to the excepted jan 2 window")
+	}
+	if s.Matches(time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Error("jan 1 01:00 belongs to dec 31, before the starting anchor")
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
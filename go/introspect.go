@@ -0,0 +1,97 @@
+package hron
+
+import (
+	"slices"
+	"time"
+)
+
+// Kind returns the kind of the schedule's expression.
+func (s *Schedule) Kind() ScheduleExprKind {
+	return s.data.Expr.Kind
+}
+
+// Times returns the times of day the schedule runs at, or nil for an interval repeat,
+// whose times follow from its window and step, and for a schedule at a solar event.
+func (s *Schedule) Times() []TimeOfDay {
+	return slices.Clone(s.data.Expr.Times)
+}
+
+// Timezones returns every zone of the in clause, with the bound zone for an `in local`
+// schedule, or nil if there is none.
+func (s *Schedule) Timezones() []string {
+	if len(s.data.Timezones) > 0 {
+		return slices.Clone(s.data.Timezones)
+	}
+	if s.tzName == "" {
+		return nil
+	}
+	return []string{s.tzName}
+}
+
+// Days returns the days of the week the schedule is restricted to, Monday first, or nil
+// when it runs on any day of the week. Days of the month and dates are in Data.
+func (s *Schedule) Days() []Weekday {
+	expr := s.data.Expr
+	switch expr.Kind {
+	case ScheduleExprKindDay, ScheduleExprKindRandom:
+		return filterWeekdays(expr.Days)
+	case ScheduleExprKindInterval:
+		if expr.DayFilter != nil {
+			return filterWeekdays(*expr.DayFilter)
+		}
+	case ScheduleExprKindWeek:
+		return filterWeekdays(NewDayFilterDays(expr.WeekDays))
+	}
+	return nil
+}
+
+// filterWeekdays returns the days of the week f matches, or nil if it matches them all.
+func filterWeekdays(f DayFilter) []Weekday {
+	var days []Weekday
+	for wd := Monday; wd <= Sunday; wd++ {
+		if matchesDayFilter(time.Date(2024, 1, int(wd), 0, 0, 0, 0, time.UTC), f) {
+			days = append(days, wd)
+		}
+	}
+	if len(days) == 7 {
+		return nil
+	}
+	return days
+}
+
+// Interval returns how many units apart the schedule repeats: the n of every n minutes,
+// days, weeks, months, or years, with quarters and half years counted in months. It is 0
+// for single dates and random picks, which do not repeat at an interval.
+func (s *Schedule) Interval() int {
+	return s.data.Expr.Interval
+}
+
+// Except returns the exceptions of the except clause.
+func (s *Schedule) Except() []ExceptionSpec {
+	return slices.Clone(s.data.Except)
+}
+
+// Until returns the until clause, or false if the schedule has none.
+func (s *Schedule) Until() (UntilSpec, bool) {
+	if s.data.Until == nil {
+		return UntilSpec{}, false
+	}
+	return *s.data.Until, true
+}
+
+// During returns the months of the during clause in calendar order, with quarters and
+// half years expanded, or nil if it names no months. Date windows, week ranges, and
+// years of the clause are in Data.
+func (s *Schedule) During() []MonthName {
+	months := slices.Clone(duringMonths(s.data))
+	slices.Sort(months)
+	return slices.Compact(months)
+}
+
+// Anchor returns the date of the starting clause, with a relative date resolved as it is
+// for evaluation, or false if there is no starting clause or it names a relative date and
+// no reference time is set.
+func (s *Schedule) Anchor() (time.Time, bool) {
+	p := s.plan()
+	return p.startDay, !p.startDay.IsZero()
+}
@@ -0,0 +1,67 @@
+package hron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestScheduleIntrospection(t *testing.T) {
+	s := MustParse("every 2 weeks on fri, mon at 09:00, 17:30 except dec 25 until 2027-01-01 starting 2026-01-05 during q1, jan in UTC")
+	if got := s.Kind(); got != ScheduleExprKindWeek {
+		t.Errorf("Kind() = %v, want week", got)
+	}
+	if got, want := s.Times(), []TimeOfDay{{9, 0}, {17, 30}}; !slices.Equal(got, want) {
+		t.Errorf("Times() = %v, want %v", got, want)
+	}
+	if got, want := s.Days(), []Weekday{Monday, Friday}; !slices.Equal(got, want) {
+		t.Errorf("Days() = %v, want %v", got, want)
+	}
+	if got := s.Interval(); got != 2 {
+		t.Errorf("Interval() = %d, want 2", got)
+	}
+	if got := s.Except(); len(got) != 1 {
+		t.Errorf("Except() = %v, want one exception", got)
+	}
+	if u, ok := s.Until(); !ok || u.Date != "2027-01-01" {
+		t.Errorf("Until() = %v, %v, want 2027-01-01", u, ok)
+	}
+	if got, want := s.During(), []MonthName{Jan, Feb, Mar}; !slices.Equal(got, want) {
+		t.Errorf("During() = %v, want %v", got, want)
+	}
+	if got, ok := s.Anchor(); !ok || !got.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Anchor() = %v, %v, want 2026-01-05", got, ok)
+	}
+
+	// The accessors return copies
+	s.Times()[0] = TimeOfDay{0, 0}
+	if s.Times()[0] != (TimeOfDay{9, 0}) {
+		t.Error("Times() exposed the schedule's own slice")
+	}
+
+	tests := []struct {
+		expr string
+		days []Weekday
+	}{
+		{"every day at 09:00", nil},
+		{"every weekday at 09:00", []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday}},
+		{"every 30 min from 09:00 to 17:00 on weekends", []Weekday{Saturday, Sunday}},
+		{"every month on the 1st at 09:00", nil},
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.expr).Days(); !slices.Equal(got, tt.days) {
+			t.Errorf("%q Days() = %v, want %v", tt.expr, got, tt.days)
+		}
+	}
+
+	plain := MustParse("every day at 09:00")
+	if _, ok := plain.Until(); ok {
+		t.Error("Until() reported a clause the schedule does not have")
+	}
+	if _, ok := plain.Anchor(); ok {
+		t.Error("Anchor() reported a clause the schedule does not have")
+	}
+	if got := plain.During(); got != nil {
+		t.Errorf("During() = %v, want nil", got)
+	}
+}
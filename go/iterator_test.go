@@ -1,6 +1,8 @@
 package hron
 
 import (
+	"context"
+	"errors"
 	"slices"
 	"testing"
 	"time"
@@ -482,3 +484,249 @@ func TestMapToDays(t *testing.T) {
 		t.Errorf("expected %v, got %v", expected, days)
 	}
 }
+
+func TestDuringPruningFromExcludedMonth(t *testing.T) {
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"every day at 09:00 during dec", time.Date(2026, 12, 1, 9, 0, 0, 0, time.UTC)},
+		{"every 15 min from 09:00 to 17:00 during dec", time.Date(2026, 12, 1, 9, 0, 0, 0, time.UTC)},
+		{"every 2 weeks on monday at 09:00 during dec", time.Date(2026, 12, 7, 9, 0, 0, 0, time.UTC)},
+		{"every month on the 1st at 09:00 during mar, dec", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{"every year on dec 25 at 09:00 during dec", time.Date(2026, 12, 25, 9, 0, 0, 0, time.UTC)},
+	}
+	from := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	for _, tc := range cases {
+		if got := MustParse(tc.expr).NextFrom(from); got == nil || !got.Equal(tc.want) {
+			t.Errorf("%q NextFrom() = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+
+	prev := MustParse("every day at 09:00 during jan").PreviousFrom(time.Date(2026, 11, 15, 12, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom() = %v, want %v", prev, want)
+	}
+}
+
+func TestStartingAnchorFloor(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expr   string
+		want   time.Time
+		before time.Time // An occurrence before the starting date, or zero if it is a floor
+	}{
+		// The spec's starting date anchors the interval without being a floor
+		{"every monday at 09:00 starting 2026-06-01", time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC), time.Date(2026, 5, 25, 9, 0, 0, 0, time.UTC)},
+		{"every 30 min from 09:00 to 17:00 starting 2026-06-01", time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 5, 25, 9, 0, 0, 0, time.UTC)},
+		// With an aligned-to clause it only marks where the schedule begins
+		{"every 2 days at 09:00 aligned to month start starting 2026-06-03", time.Date(2026, 6, 3, 9, 0, 0, 0, time.UTC), time.Time{}},
+		{"every 2 weeks on friday at 09:00 aligned to iso weeks starting 2026-06-01", time.Date(2026, 6, 5, 9, 0, 0, 0, time.UTC), time.Time{}},
+		{"every 2 months on the 1st at 00:00 aligned to epoch starting 2026-06-01", time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tc := range cases {
+		s := MustParse(tc.expr)
+		if got := s.NextFrom(from); got == nil || !got.Equal(tc.want) {
+			t.Errorf("%q NextFrom() = %v, want %v", tc.expr, got, tc.want)
+		}
+		if !tc.before.IsZero() && !s.Matches(tc.before) {
+			t.Errorf("%q Matches(%v) = false, want true", tc.expr, tc.before)
+		}
+		if tc.before.IsZero() && s.Matches(time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)) {
+			t.Errorf("%q Matches() before the starting date = true", tc.expr)
+		}
+	}
+}
+
+func TestStarts(t *testing.T) {
+	s := MustParse("every monday at 09:00 starting 2026-06-01 in America/New_York")
+	start, ok := s.Starts()
+	if !ok {
+		t.Fatal("Starts() = false, want true")
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+	if want := time.Date(2026, 6, 1, 0, 0, 0, 0, ny); !start.Equal(want) {
+		t.Errorf("Starts() = %v, want %v", start, want)
+	}
+
+	if _, ok := MustParse("every monday at 09:00").Starts(); ok {
+		t.Error("Starts() without starting clause = true")
+	}
+}
+
+// =============================================================================
+// Reverse Iteration
+// =============================================================================
+
+func TestOccurrencesBeforeDescending(t *testing.T) {
+	s := MustParse("every day at 09:00, 17:00 in UTC")
+	from := time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC)
+
+	var got []time.Time
+	for dt := range s.OccurrencesBefore(from) {
+		got = append(got, dt)
+		if len(got) == 3 {
+			break
+		}
+	}
+	want := []time.Time{
+		time.Date(2026, 2, 2, 17, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 1, 17, 0, 0, 0, time.UTC),
+	}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("OccurrencesBefore() = %v, want %v", got, want)
+	}
+}
+
+func TestOccurrencesBeforeStopsAtAnchor(t *testing.T) {
+	s := MustParse("every day at 09:00 starting 2026-02-01 in UTC")
+	from := time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC)
+
+	got := slices.Collect(s.OccurrencesBefore(from))
+	if len(got) != 3 || got[2].Day() != 1 {
+		t.Errorf("OccurrencesBefore() = %v, want Feb 3, 2, 1", got)
+	}
+}
+
+func TestBetweenReverseMatchesBetween(t *testing.T) {
+	from := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	for _, expr := range []string{
+		"every day at 09:00 in UTC",
+		"every weekday at 09:00, 13:30 in America/New_York",
+		"every 2 weeks on monday, friday at 10:00 in UTC",
+		"every month on the last day at 18:00 except mar 1 in UTC",
+		"every 45 min from 09:00 to 17:00 on weekdays in Europe/London",
+		"on 2026-02-14 at 14:00 in UTC",
+	} {
+		s := MustParse(expr)
+		forward := slices.Collect(s.Between(from, to))
+		reverse := slices.Collect(s.BetweenReverse(from, to))
+		slices.Reverse(reverse)
+		if !slices.EqualFunc(forward, reverse, time.Time.Equal) {
+			t.Errorf("%q BetweenReverse() reversed = %v, want %v", expr, reverse, forward)
+		}
+	}
+}
+
+func TestBetweenReverseIncludesTo(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC")
+	from := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC)
+
+	got := slices.Collect(s.BetweenReverse(from, to))
+	want := []time.Time{to, time.Date(2026, 2, 2, 9, 0, 0, 0, time.UTC)}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("BetweenReverse() = %v, want %v", got, want)
+	}
+}
+
+func TestBetweenPreviousPages(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC")
+	from := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 5, 9, 0, 0, 0, time.UTC)
+
+	all := slices.Collect(s.BetweenReverse(from, to))
+	var paged []time.Time
+	for cursor := to; ; {
+		page := s.BetweenPrevious(from, cursor)
+		if len(page) > 2 {
+			page = page[:2]
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		cursor = page[len(page)-1].Add(-time.Nanosecond)
+	}
+	if len(all) != 6 || !slices.EqualFunc(paged, all, time.Time.Equal) {
+		t.Errorf("paged BetweenPrevious() = %v, want %v", paged, all)
+	}
+}
+
+// =============================================================================
+// Cancellation
+// =============================================================================
+
+func TestNextFromCtxCancelled(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	next, err := s.NextFromCtx(ctx, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	if next != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("NextFromCtx() = %v, %v, want nil, context.Canceled", next, err)
+	}
+}
+
+func TestNextFromCtxMatchesNextFrom(t *testing.T) {
+	s := MustParse("every weekday at 09:00 except dec 25 in UTC")
+	from := time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC)
+
+	next, err := s.NextFromCtx(context.Background(), from)
+	if err != nil || next == nil || !next.Equal(*s.NextFrom(from)) {
+		t.Errorf("NextFromCtx() = %v, %v, want %v", next, err, s.NextFrom(from))
+	}
+}
+
+func TestOccurrencesCtxStopsOnCancel(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	count := 0
+	for range s.OccurrencesCtx(ctx, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		count++
+		if count == 3 {
+			cancel()
+		}
+		if count > 3 {
+			break
+		}
+	}
+	if count != 3 || ctx.Err() == nil {
+		t.Errorf("OccurrencesCtx() yielded %d occurrences, want 3 before cancellation", count)
+	}
+}
+
+func TestMissedBetween(t *testing.T) {
+	s := MustParse("every day at 09:00 in UTC")
+	lastRun := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC)
+
+	got := s.MissedBetween(lastRun, now)
+	want := []time.Time{time.Date(2026, 2, 2, 9, 0, 0, 0, time.UTC), now}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("MissedBetween() = %v, want %v", got, want)
+	}
+}
+
+func TestNextTime(t *testing.T) {
+	from := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	for _, expr := range []string{
+		"every weekday at 09:00, 17:00 in America/New_York",
+		"every 2 weeks on friday, monday at 09:00 in America/New_York",
+		"every 15 min from 09:00 to 17:00 on weekdays in Europe/London",
+		"every month on the last friday at 10:00 in UTC",
+		"on 2026-01-01 at 09:00",
+	} {
+		s := MustParse(expr)
+		want := s.NextFrom(from)
+		got, ok := s.NextTime(from)
+		if ok != (want != nil) || (ok && !got.Equal(*want)) {
+			t.Errorf("%q NextTime() = %v, %v, want %v", expr, got, ok, want)
+		}
+	}
+
+	for _, expr := range []string{
+		"every weekday at 09:00, 17:00 in America/New_York",
+		"every 2 weeks on friday, monday at 09:00 in America/New_York",
+		"every 30 min from 09:00 to 17:00 in UTC",
+	} {
+		s := MustParse(expr)
+		if allocs := testing.AllocsPerRun(100, func() { s.NextTime(from) }); allocs != 0 {
+			t.Errorf("%q NextTime() allocates %v times, want 0", expr, allocs)
+		}
+	}
+}
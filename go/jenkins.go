@@ -0,0 +1,123 @@
+package hron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// jenkinsFieldRanges are the values H picks from in each field. Day of month stops at the
+// 28th, as in Jenkins, so the hashed day exists in every month.
+var jenkinsFieldRanges = [5][2]int{{0, 59}, {0, 23}, {1, 28}, {1, 12}, {0, 6}}
+
+var jenkinsMacros = map[string]string{
+	"@yearly":   "H H H H *",
+	"@annually": "H H H H *",
+	"@monthly":  "H H H * *",
+	"@weekly":   "H H * * H",
+	"@daily":    "H H * * *",
+	"@midnight": "H H(0-2) * * *",
+	"@hourly":   "H * * * *",
+}
+
+// FromJenkinsCron converts a Jenkins trigger spec to a Schedule. Each H in the spec is
+// replaced by a value hashed from key (FNV-1a), usually the job's full name, so that jobs
+// sharing a spec are spread out while each job keeps the same times across restarts.
+// H, H(a-b), H/n, and H(a-b)/n are supported, as are the @ macros, which Jenkins hashes
+// too. The hashed values differ from Jenkins' own, so migrated jobs move to new times.
+//
+// The spec may span lines: blank lines and # comments are skipped, and a TZ=zone line
+// sets the timezone. It must hold exactly one cron line, which after hashing converts as
+// FromCron does.
+func FromJenkinsCron(spec, key string) (*Schedule, error) {
+	var cron, tz string
+	for line := range strings.Lines(spec) {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "TZ="):
+			tz = strings.TrimPrefix(line, "TZ=")
+		case cron != "":
+			return nil, CronError("Jenkins spec has more than one cron line; convert each line separately")
+		default:
+			cron = line
+		}
+	}
+	if cron == "" {
+		return nil, CronError("Jenkins spec has no cron line")
+	}
+
+	hashed, err := hashJenkinsCron(cron, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := FromCron(hashed)
+	if err != nil {
+		return nil, err
+	}
+	data.Timezone = tz
+	return NewSchedule(data)
+}
+
+// hashJenkinsCron replaces each H in a Jenkins cron line with a concrete value.
+func hashJenkinsCron(cron, key string) (string, error) {
+	if macro, ok := jenkinsMacros[strings.ToLower(cron)]; ok {
+		cron = macro
+	}
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return "", CronError(fmt.Sprintf("expected 5 cron fields, got %d", len(fields)))
+	}
+
+	for i, field := range fields {
+		parts := strings.Split(field, ",")
+		for j, part := range parts {
+			if !strings.HasPrefix(part, "H") {
+				continue
+			}
+			hashed, err := hashJenkinsField(part, jenkinsHash(key, i), jenkinsFieldRanges[i])
+			if err != nil {
+				return "", err
+			}
+			parts[j] = hashed
+		}
+		fields[i] = strings.Join(parts, ",")
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// hashJenkinsField resolves one H, H(a-b), H/n, or H(a-b)/n item of a field whose values
+// run from bounds[0] to bounds[1].
+func hashJenkinsField(part string, hash uint64, bounds [2]int) (string, error) {
+	lo, hi := bounds[0], bounds[1]
+	rest := strings.TrimPrefix(part, "H")
+	if inner, ok := strings.CutPrefix(rest, "("); ok {
+		rangePart, after, closed := strings.Cut(inner, ")")
+		from, to, isRange := strings.Cut(rangePart, "-")
+		a, errA := strconv.Atoi(from)
+		b, errB := strconv.Atoi(to)
+		if !closed || !isRange || errA != nil || errB != nil || a < lo || b > hi || a > b {
+			return "", CronError(fmt.Sprintf("invalid Jenkins hash range: %s", part))
+		}
+		lo, hi, rest = a, b, after
+	}
+
+	if rest == "" {
+		return strconv.Itoa(lo + int(hash%uint64(hi-lo+1))), nil
+	}
+	stepStr, ok := strings.CutPrefix(rest, "/")
+	step, err := strconv.Atoi(stepStr)
+	if !ok || err != nil || step < 1 {
+		return "", CronError(fmt.Sprintf("invalid Jenkins hash step: %s", part))
+	}
+	start := lo + int(hash%uint64(min(step, hi-lo+1)))
+	return fmt.Sprintf("%d-%d/%d", start, hi, step), nil
+}
+
+// jenkinsHash hashes key for one field, so fields get independent values.
+func jenkinsHash(key string, field int) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%d", key, field)
+	return h.Sum64()
+}
@@ -0,0 +1,78 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFromJenkinsCron(t *testing.T) {
+	cases := []struct{ spec, key, want string }{
+		{"H/15 * * * *", "nightly-build", "every 15 min from 00:"},
+		{"H 9 * * 1-5", "nightly-build", "every weekday at 09:"},
+		{"H H(0-7) * * *", "nightly-build", "every day at 0"},
+		{"TZ=Europe/London\n# weekday mornings\nH(0-29) 8 * * 1-5", "deploy", "every weekday at 08:"},
+		{"@weekly", "report", "every "},
+		{"30 6 * * *", "plain", "every day at 06:30"},
+	}
+	for _, tc := range cases {
+		s, err := FromJenkinsCron(tc.spec, tc.key)
+		if err != nil {
+			t.Errorf("FromJenkinsCron(%q, %q): %v", tc.spec, tc.key, err)
+			continue
+		}
+		if got := s.String(); !strings.HasPrefix(got, tc.want) {
+			t.Errorf("FromJenkinsCron(%q, %q) = %q, want prefix %q", tc.spec, tc.key, got, tc.want)
+		}
+	}
+
+	if london, _ := FromJenkinsCron("TZ=Europe/London\nH 8 * * *", "deploy"); !strings.HasSuffix(london.String(), "in Europe/London") {
+		t.Errorf("TZ line ignored: %q", london)
+	}
+}
+
+func TestFromJenkinsCronHashing(t *testing.T) {
+	// The same key always gets the same times
+	a, _ := FromJenkinsCron("H H * * *", "job-a")
+	again, _ := FromJenkinsCron("H H * * *", "job-a")
+	if a.String() != again.String() {
+		t.Errorf("hash not stable: %q, %q", a, again)
+	}
+
+	// Different keys spread out, and hashed values stay within their ranges
+	seen := make(map[string]bool)
+	for i := range 50 {
+		key := "job-" + strings.Repeat("x", i)
+		s, err := FromJenkinsCron("H(10-20) H(9-10) H * *", key)
+		if err != nil {
+			t.Fatalf("FromJenkinsCron key %q: %v", key, err)
+		}
+		seen[s.String()] = true
+		expr := s.Data().Expr
+		tod := expr.Times[0]
+		if tod.Minute < 10 || tod.Minute > 20 || tod.Hour < 9 || tod.Hour > 10 {
+			t.Errorf("key %q: time %s outside H(10-20) H(9-10)", key, tod)
+		}
+		if day := expr.MonthTarget.ExpandDays()[0]; day < 1 || day > 28 {
+			t.Errorf("key %q: day %d outside 1-28", key, day)
+		}
+	}
+	if len(seen) < 10 {
+		t.Errorf("50 keys gave only %d distinct schedules", len(seen))
+	}
+}
+
+func TestFromJenkinsCronErrors(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"# only a comment",
+		"H * * *",
+		"H(50-70) * * * *",
+		"H(5) * * * *",
+		"H/0 * * * *",
+		"H 9 * * *\nH 17 * * *",
+	} {
+		if _, err := FromJenkinsCron(spec, "job"); err == nil {
+			t.Errorf("FromJenkinsCron(%q) succeeded, want error", spec)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package hron
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KubernetesCron is the schedule of a Kubernetes CronJob: spec.schedule and
+// spec.timeZone. An empty TimeZone leaves the controller's local time in effect.
+type KubernetesCron struct {
+	Schedule string
+	TimeZone string
+}
+
+// ToKubernetesCron converts the schedule to a Kubernetes CronJob schedule and time zone.
+// Except and until clauses have no CronJob equivalent, so they are dropped with a
+// warning describing what was lost; the CronJob then runs on those dates too. Schedules
+// that ToCron rejects for other reasons, or that need the L, W, or # cron extensions,
+// return a CronError.
+func (s *Schedule) ToKubernetesCron() (KubernetesCron, []string, error) {
+	if s.tzName == LocalTimezone {
+		return KubernetesCron{}, nil, CronError("not expressible as Kubernetes cron (bind `in local` with WithTimezone first)")
+	}
+
+	data := *s.data
+	var warnings []string
+	if len(data.Except) > 0 {
+		warnings = append(warnings, fmt.Sprintf("except clause dropped: the CronJob also runs on %s", displayExceptions(data.Except)))
+		data.Except = nil
+	}
+	if data.Until != nil {
+		warnings = append(warnings, fmt.Sprintf("until clause dropped: the CronJob keeps running after %s", displayUntil(*data.Until)))
+		data.Until = nil
+	}
+
+	cron, err := ToCron(&data)
+	if err != nil {
+		return KubernetesCron{}, nil, err
+	}
+	if strings.ContainsAny(cron, "LW#") {
+		return KubernetesCron{}, nil, CronError(fmt.Sprintf("not expressible as Kubernetes cron (%q uses an extension CronJobs do not support)", cron))
+	}
+	tz, ok := ianaTimezone(s.tzName)
+	if !ok {
+		return KubernetesCron{}, nil, CronError(fmt.Sprintf("not expressible as Kubernetes cron (the offset %s has no IANA timezone)", s.tzName))
+	}
+	return KubernetesCron{Schedule: cron, TimeZone: tz}, warnings, nil
+}
+
+// FromKubernetesCron converts a Kubernetes CronJob schedule and time zone to a Schedule.
+// The schedule uses the CronJob dialect: 5-field cron and @ macros, without the L, W,
+// and # extensions or a CRON_TZ/TZ prefix, which Kubernetes rejects in favor of the
+// timeZone field. An empty timeZone gives a schedule without a timezone.
+func FromKubernetesCron(schedule, timeZone string) (*Schedule, error) {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "TZ=") {
+		return nil, CronError("Kubernetes cron sets the timezone in the timeZone field, not in the schedule")
+	}
+	// Day names contain W (wed) but never L, and day-of-month fields have no names
+	if fields := strings.Fields(strings.ToUpper(schedule)); len(fields) == 5 &&
+		(strings.ContainsAny(fields[2], "LW") || strings.ContainsAny(fields[4], "L#")) {
+		return nil, CronError(fmt.Sprintf("Kubernetes cron does not support the L, W, or # extensions: %q", schedule))
+	}
+
+	data, err := FromCron(schedule)
+	if err != nil {
+		return nil, err
+	}
+	data.Timezone = timeZone
+	return NewSchedule(data)
+}
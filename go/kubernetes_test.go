@@ -0,0 +1,73 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToKubernetesCron(t *testing.T) {
+	cases := []struct {
+		expr     string
+		want     KubernetesCron
+		warnings int
+	}{
+		{"every weekday at 09:00 in America/New_York", KubernetesCron{"0 9 * * 1-5", "America/New_York"}, 0},
+		{"every 15 min from 00:00 to 23:59", KubernetesCron{"*/15 * * * *", ""}, 0},
+		{"every day at 02:00 except dec 25, jan 1 in UTC", KubernetesCron{"0 2 * * *", "UTC"}, 1},
+		{"every month on the 1st at 06:00 except dec 1 until 2027-01-01 in Europe/Berlin", KubernetesCron{"0 6 1 * *", "Europe/Berlin"}, 2},
+	}
+	for _, tc := range cases {
+		got, warnings, err := MustParse(tc.expr).ToKubernetesCron()
+		if err != nil {
+			t.Errorf("%q ToKubernetesCron(): %v", tc.expr, err)
+			continue
+		}
+		if got != tc.want || len(warnings) != tc.warnings {
+			t.Errorf("%q ToKubernetesCron() = %+v, %q, want %+v with %d warnings", tc.expr, got, warnings, tc.want, tc.warnings)
+		}
+	}
+
+	_, warnings, _ := MustParse("every day at 02:00 except dec 25 in UTC").ToKubernetesCron()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "dec 25") {
+		t.Errorf("except warning = %q, want it to name dec 25", warnings)
+	}
+}
+
+func TestToKubernetesCronErrors(t *testing.T) {
+	for _, expr := range []string{
+		"every month on the nearest weekday to 15th at 09:00",
+		"every 2 weeks on monday at 09:00",
+		"every day at 09:00 in local",
+	} {
+		if got, _, err := MustParse(expr).ToKubernetesCron(); err == nil {
+			t.Errorf("%q ToKubernetesCron() = %+v, want error", expr, got)
+		}
+	}
+}
+
+func TestFromKubernetesCron(t *testing.T) {
+	cases := []struct{ schedule, timeZone, want string }{
+		{"0 9 * * 1-5", "America/New_York", "every weekday at 09:00 in America/New_York"},
+		{"30 6 * JUL WED", "", "every wednesday at 06:30 during jul"},
+		{"@daily", "UTC", "every day at 00:00 in UTC"},
+	}
+	for _, tc := range cases {
+		s, err := FromKubernetesCron(tc.schedule, tc.timeZone)
+		if err != nil {
+			t.Errorf("FromKubernetesCron(%q, %q): %v", tc.schedule, tc.timeZone, err)
+			continue
+		}
+		if got := s.String(); got != tc.want {
+			t.Errorf("FromKubernetesCron(%q, %q) = %q, want %q", tc.schedule, tc.timeZone, got, tc.want)
+		}
+	}
+
+	for _, schedule := range []string{"CRON_TZ=UTC 0 9 * * *", "0 9 L * *", "0 9 15W * *", "0 9 * * 1#2", "0 9 * * 5L"} {
+		if _, err := FromKubernetesCron(schedule, ""); err == nil {
+			t.Errorf("FromKubernetesCron(%q) succeeded, want error", schedule)
+		}
+	}
+	if _, err := FromKubernetesCron("0 9 * * *", "Mars/Olympus"); err == nil {
+		t.Error("FromKubernetesCron with an unknown time zone succeeded, want error")
+	}
+}
@@ -1,8 +1,10 @@
 package hron
 
 import (
+	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // TokenKind represents the type of token.
@@ -41,6 +43,34 @@ const (
 	TokenNearest
 	TokenNext
 	TokenPrevious
+	TokenAligned
+	TokenEpoch
+	TokenStart
+	TokenISO
+	TokenBusiness
+	TokenHolidays
+	TokenBefore
+	TokenAfter
+	TokenEvent
+	TokenOne
+	TokenRandom
+	TokenEach
+	TokenSeeded
+	TokenBy
+	TokenString
+	TokenEnd
+	TokenQuarter
+	TokenHalf
+	TokenQuarterName
+	TokenHalfName
+	TokenOther
+	TokenToday
+	TokenTomorrow
+	TokenAnd
+	TokenPlus
+	TokenMinus
+	TokenSolar
+	TokenFiscal
 )
 
 // Token represents a lexed token.
@@ -53,11 +83,14 @@ type Token struct {
 	MonthNameVal MonthName
 	OrdinalVal   OrdinalPosition
 	UnitVal      IntervalUnit
+	SolarVal     SolarEvent
 	NumberVal    int
 	TimeHour     int
 	TimeMinute   int
 	ISODateVal   string
 	TimezoneVal  string
+	EventVal     string
+	StringVal    string
 }
 
 // lexer is the internal lexer state.
@@ -65,9 +98,14 @@ type lexer struct {
 	input   string
 	pos     int
 	afterIn bool
+	locale  *Locale       // Keyword pack tried before English keywords; nil for English only
+	errs    *[]*HronError // Collects errors and keeps lexing when set (ParseAll)
+	before  []Token       // Tokens of the input before pos, when lexing starts midway
 }
 
-// Tokenize tokenizes the input string into a list of tokens.
+// Tokenize tokenizes the input string into a list of tokens, each with its span of input
+// and value. It stops at the first word it cannot lex; Scan keeps going and also returns
+// the whitespace between tokens.
 func Tokenize(input string) ([]Token, error) {
 	l := &lexer{input: input}
 	return l.tokenize()
@@ -81,17 +119,29 @@ func (l *lexer) tokenize() ([]Token, error) {
 			break
 		}
 
-		if l.afterIn {
+		start := l.pos
+		if l.afterIn && !l.atThe() {
 			l.afterIn = false
 			tok, err := l.lexTimezone()
 			if err != nil {
-				return nil, err
+				if tokens, err = l.skipError(tokens, start, err); err != nil {
+					return nil, err
+				}
+				continue
 			}
 			tokens = append(tokens, tok)
+
+			// A comma after a timezone continues a list of zones
+			l.skipWhitespace()
+			if l.pos < len(l.input) && l.input[l.pos] == ',' {
+				tokens = append(tokens, Token{Kind: TokenComma, Span: Span{l.pos, l.pos + 1}})
+				l.pos++
+				l.afterIn = true
+			}
 			continue
 		}
 
-		start := l.pos
+		l.afterIn = false
 		ch := l.input[l.pos]
 
 		if ch == ',' {
@@ -100,40 +150,119 @@ func (l *lexer) tokenize() ([]Token, error) {
 			continue
 		}
 
+		if ch == '"' {
+			tok, err := l.lexString()
+			if err != nil {
+				if tokens, err = l.skipError(tokens, start, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			tokens = append(tokens, tok)
+			continue
+		}
+
 		if isDigit(ch) {
 			tok, err := l.lexNumberOrTimeOrDate()
 			if err != nil {
-				return nil, err
+				if tokens, err = l.skipError(tokens, start, err); err != nil {
+					return nil, err
+				}
+				continue
 			}
 			tokens = append(tokens, tok)
 			continue
 		}
 
+		if l.locale != nil && (isAlpha(ch) || ch >= utf8.RuneSelf) {
+			toks, ok, err := l.lexLocalized()
+			if err != nil {
+				if tokens, err = l.skipError(tokens, start, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if ok {
+				tokens = append(tokens, toks...)
+				continue
+			}
+		}
+
 		if isAlpha(ch) {
-			tok, err := l.lexWord()
+			tok, err := l.lexWord(tokens)
 			if err != nil {
-				return nil, err
+				if tokens, err = l.skipError(tokens, start, err); err != nil {
+					return nil, err
+				}
+				continue
 			}
 			tokens = append(tokens, tok)
 			continue
 		}
 
-		return nil, LexError("unexpected character '"+string(ch)+"'", Span{start, start + 1}, l.input)
+		var err error
+		tokens, err = l.skipError(tokens, start, LexError("unexpected character '"+string(ch)+"'", Span{start, start + 1}, l.input))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return tokens, nil
 }
 
+// skipError returns err unless the lexer is collecting errors. When collecting, it records
+// err and resumes after the offending text; an unknown word near a keyword is lexed as
+// that keyword so the parser does not report the same mistake again.
+func (l *lexer) skipError(tokens []Token, start int, err error) ([]Token, error) {
+	herr, ok := err.(*HronError)
+	if l.errs == nil || !ok || herr.Span == nil {
+		return tokens, err
+	}
+	*l.errs = append(*l.errs, herr)
+	l.pos = max(herr.Span.End, start+1)
+	if word := l.input[start:min(l.pos, len(l.input))]; isAlpha(word[0]) {
+		if kws := nearestKeywords(strings.ToLower(word)); len(kws) > 0 {
+			if tok, ok := keywordMap[kws[0]]; ok {
+				tok.Span = Span{start, l.pos}
+				tokens = append(tokens, tok)
+			}
+		}
+	}
+	return tokens, nil
+}
+
 func (l *lexer) skipWhitespace() {
 	for l.pos < len(l.input) && isWhitespace(l.input[l.pos]) {
 		l.pos++
 	}
 }
 
+// atWord reports whether the input at the current position is the given keyword.
+func (l *lexer) atWord(word string) bool {
+	end := l.pos + len(word)
+	if end > len(l.input) || !strings.EqualFold(l.input[l.pos:end], word) {
+		return false
+	}
+	return end == len(l.input) || isWhitespace(l.input[end])
+}
+
+func (l *lexer) lexString() (Token, error) {
+	start := l.pos
+	l.pos++ // skip opening quote
+	for l.pos < len(l.input) && l.input[l.pos] != '"' {
+		l.pos++
+	}
+	if l.pos >= len(l.input) {
+		return Token{}, LexError("unterminated string", Span{start, l.pos}, l.input)
+	}
+	l.pos++ // skip closing quote
+	return Token{Kind: TokenString, Span: Span{start, l.pos}, StringVal: l.input[start+1 : l.pos-1]}, nil
+}
+
 func (l *lexer) lexTimezone() (Token, error) {
 	l.skipWhitespace()
 	start := l.pos
-	for l.pos < len(l.input) && !isWhitespace(l.input[l.pos]) {
+	for l.pos < len(l.input) && !isWhitespace(l.input[l.pos]) && l.input[l.pos] != ',' {
 		l.pos++
 	}
 	tz := l.input[start:l.pos]
@@ -185,6 +314,9 @@ func (l *lexer) lexNumberOrTimeOrDate() (Token, error) {
 			if err != nil {
 				return Token{}, LexError("invalid time minute", Span{start, l.pos}, l.input)
 			}
+			if pm, ok := l.lexMeridiem(); ok {
+				return l.twelveHourTime(hour, minute, pm, start)
+			}
 			if hour > 23 || minute > 59 {
 				return Token{}, LexError("invalid time", Span{start, l.pos}, l.input)
 			}
@@ -197,11 +329,22 @@ func (l *lexer) lexNumberOrTimeOrDate() (Token, error) {
 		return Token{}, LexError("invalid number", Span{start, l.pos}, l.input)
 	}
 
+	// Check for 12-hour time without minutes: 9am, 12 pm
+	if len(digits) <= 2 {
+		if pm, ok := l.lexMeridiem(); ok {
+			return l.twelveHourTime(num, 0, pm, start)
+		}
+	}
+
 	// Check for ordinal suffix: st, nd, rd, th
 	if l.pos+1 < len(l.input) {
 		suffix := strings.ToLower(l.input[l.pos : l.pos+2])
 		if suffix == "st" || suffix == "nd" || suffix == "rd" || suffix == "th" {
 			l.pos += 2
+			if num >= 1 && num <= 5 && l.ordinalWeekdayAhead() {
+				// 1st monday and 2nd to last friday are ordinal weekdays, like first monday
+				return Token{Kind: TokenOrdinal, Span: Span{start, l.pos}, OrdinalVal: OrdinalPosition(num)}, nil
+			}
 			return Token{Kind: TokenOrdinalNumber, Span: Span{start, l.pos}, NumberVal: num}, nil
 		}
 	}
@@ -209,7 +352,56 @@ func (l *lexer) lexNumberOrTimeOrDate() (Token, error) {
 	return Token{Kind: TokenNumber, Span: Span{start, l.pos}, NumberVal: num}, nil
 }
 
-func (l *lexer) lexWord() (Token, error) {
+// ordinalWeekdayAhead reports whether the words after an ordinal number make it an
+// ordinal weekday: a day name, or to last and a day name.
+func (l *lexer) ordinalWeekdayAhead() bool {
+	if l.pos < len(l.input) && !isWhitespace(l.input[l.pos]) {
+		return false
+	}
+	words := strings.Fields(strings.ToLower(l.input[l.pos:]))
+	isDay := func(i int) bool {
+		return i < len(words) && keywordMap[strings.TrimRight(words[i], ",")].Kind == TokenDayName
+	}
+	return isDay(0) || (len(words) > 1 && words[0] == "to" && words[1] == "last" && isDay(2))
+}
+
+// lexMeridiem consumes an "am"/"pm" suffix, optionally separated by a space, and
+// reports whether it was "pm".
+func (l *lexer) lexMeridiem() (pm bool, ok bool) {
+	pos := l.pos
+	if pos < len(l.input) && l.input[pos] == ' ' {
+		pos++
+	}
+	if pos+2 > len(l.input) {
+		return false, false
+	}
+	suffix := strings.ToLower(l.input[pos : pos+2])
+	if suffix != "am" && suffix != "pm" {
+		return false, false
+	}
+	if pos+2 < len(l.input) && (isAlphanumeric(l.input[pos+2]) || l.input[pos+2] == '_') {
+		return false, false
+	}
+	l.pos = pos + 2
+	return suffix == "pm", true
+}
+
+// twelveHourTime converts a 12-hour clock time to a 24-hour time token.
+func (l *lexer) twelveHourTime(hour, minute int, pm bool, start int) (Token, error) {
+	span := Span{start, l.pos}
+	if hour < 1 || hour > 12 || minute > 59 {
+		return Token{}, LexError("invalid 12-hour time (hour must be 1-12)", span, l.input)
+	}
+	hour %= 12
+	if pm {
+		hour += 12
+	}
+	return Token{Kind: TokenTime, Span: span, TimeHour: hour, TimeMinute: minute}, nil
+}
+
+// lexWord lexes a keyword, or an event name where tokens, those lexed so far, leave
+// the next word naming a date.
+func (l *lexer) lexWord(tokens []Token) (Token, error) {
 	start := l.pos
 	for l.pos < len(l.input) && (isAlphanumeric(l.input[l.pos]) || l.input[l.pos] == '_') {
 		l.pos++
@@ -220,6 +412,9 @@ func (l *lexer) lexWord() (Token, error) {
 	// Check keyword map
 	tok, ok := keywordMap[word]
 	if !ok {
+		if l.atDate(tokens) && isEventName(word) {
+			return Token{Kind: TokenEvent, Span: span, EventVal: word}, nil
+		}
 		return Token{}, LexError("unknown keyword '"+word+"'", span, l.input)
 	}
 
@@ -232,6 +427,24 @@ func (l *lexer) lexWord() (Token, error) {
 	return tok, nil
 }
 
+// atDate reports whether the next word names a date of an `on` expression: its first
+// date, one after a comma or `and`, or the base of a relative date. Events are only
+// named there, so an unknown word anywhere else is still a typo.
+func (l *lexer) atDate(tokens []Token) bool {
+	all := append(slices.Clip(l.before), tokens...)
+	if len(all) == 0 || all[0].Kind != TokenOn {
+		return false
+	}
+	if slices.ContainsFunc(all, func(t Token) bool { return t.Kind == TokenAt }) {
+		return false
+	}
+	switch all[len(all)-1].Kind {
+	case TokenOn, TokenComma, TokenAnd, TokenBefore, TokenAfter:
+		return true
+	}
+	return false
+}
+
 // keywordMap maps lowercase keywords to tokens.
 var keywordMap = map[string]Token{
 	"every":    {Kind: TokenEvery},
@@ -259,6 +472,17 @@ var keywordMap = map[string]Token{
 	"week":     {Kind: TokenWeeks},
 	"month":    {Kind: TokenMonth},
 	"months":   {Kind: TokenMonth},
+	"quarter":  {Kind: TokenQuarter},
+	"quarters": {Kind: TokenQuarter},
+	"half":     {Kind: TokenHalf},
+	"other":    {Kind: TokenOther},
+	// Quarter and half-year names
+	"q1": {Kind: TokenQuarterName, NumberVal: 1},
+	"q2": {Kind: TokenQuarterName, NumberVal: 2},
+	"q3": {Kind: TokenQuarterName, NumberVal: 3},
+	"q4": {Kind: TokenQuarterName, NumberVal: 4},
+	"h1": {Kind: TokenHalfName, NumberVal: 1},
+	"h2": {Kind: TokenHalfName, NumberVal: 2},
 	// Day names
 	"monday":    {Kind: TokenDayName, DayNameVal: Monday},
 	"mon":       {Kind: TokenDayName, DayNameVal: Monday},
@@ -308,6 +532,37 @@ var keywordMap = map[string]Token{
 	"nearest":  {Kind: TokenNearest},
 	"next":     {Kind: TokenNext},
 	"previous": {Kind: TokenPrevious},
+	// Alignment keywords
+	"aligned": {Kind: TokenAligned},
+	"epoch":   {Kind: TokenEpoch},
+	"start":   {Kind: TokenStart},
+	"iso":     {Kind: TokenISO},
+	// Business day keyword
+	"business": {Kind: TokenBusiness},
+	"holidays": {Kind: TokenHolidays},
+	// Relative date keywords
+	"before": {Kind: TokenBefore},
+	"after":  {Kind: TokenAfter},
+	// Random pick keywords
+	"one":    {Kind: TokenOne},
+	"random": {Kind: TokenRandom},
+	"each":   {Kind: TokenEach},
+	"seeded": {Kind: TokenSeeded},
+	"by":     {Kind: TokenBy},
+	// Named times
+	"noon":     {Kind: TokenTime, TimeHour: 12},
+	"midnight": {Kind: TokenTime},
+	"end":      {Kind: TokenEnd},
+	"today":    {Kind: TokenToday},
+	"tomorrow": {Kind: TokenTomorrow},
+	"and":      {Kind: TokenAnd},
+	"plus":     {Kind: TokenPlus},
+	"minus":    {Kind: TokenMinus},
+	"sunrise":  {Kind: TokenSolar, SolarVal: SolarSunrise},
+	"sunset":   {Kind: TokenSolar, SolarVal: SolarSunset},
+	"dawn":     {Kind: TokenSolar, SolarVal: SolarDawn},
+	"dusk":     {Kind: TokenSolar, SolarVal: SolarDusk},
+	"fiscal":   {Kind: TokenFiscal},
 	// Interval units
 	"min":     {Kind: TokenIntervalUnit, UnitVal: IntervalMin},
 	"mins":    {Kind: TokenIntervalUnit, UnitVal: IntervalMin},
@@ -317,6 +572,9 @@ var keywordMap = map[string]Token{
 	"hours":   {Kind: TokenIntervalUnit, UnitVal: IntervalHours},
 	"hr":      {Kind: TokenIntervalUnit, UnitVal: IntervalHours},
 	"hrs":     {Kind: TokenIntervalUnit, UnitVal: IntervalHours},
+	"sec":     {Kind: TokenIntervalUnit, UnitVal: IntervalSeconds},
+	"secs":    {Kind: TokenIntervalUnit, UnitVal: IntervalSeconds},
+	"seconds": {Kind: TokenIntervalUnit, UnitVal: IntervalSeconds},
 }
 
 // Helper functions
@@ -0,0 +1,70 @@
+package hron
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrLimitExceeded is wrapped by the EvalError returned when a search gives up before
+// finding an occurrence, so callers can tell it from a schedule with no occurrence:
+//
+//	if errors.Is(err, hron.ErrLimitExceeded) { ... }
+var ErrLimitExceeded = errors.New("evaluation limit exceeded")
+
+// EvalOptions bounds the search for an occurrence. The zero value uses the defaults.
+type EvalOptions struct {
+	// MaxIterations is the number of candidates a search tries, each one a day, week,
+	// month, or year depending on the expression, before giving up. Zero means 1000.
+	MaxIterations int
+	// MaxHorizonYears is how many years from its starting point a search may look. Zero
+	// means no horizon beyond MaxIterations.
+	MaxHorizonYears int
+}
+
+func (o EvalOptions) maxIterations() int {
+	if o.MaxIterations > 0 {
+		return o.MaxIterations
+	}
+	return maxIterations
+}
+
+// horizon returns the furthest instant a search from now may return, searching forward
+// for direction 1 and backward for -1.
+func (o EvalOptions) horizon(now time.Time, direction int) (time.Time, bool) {
+	if o.MaxHorizonYears <= 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(direction*o.MaxHorizonYears, 0, 0), true
+}
+
+func iterationsError(o EvalOptions) *HronError {
+	err := EvalError(fmt.Sprintf("no occurrence found within %d iterations", o.maxIterations()))
+	err.cause = ErrLimitExceeded
+	return err
+}
+
+func horizonError(o EvalOptions) *HronError {
+	err := EvalError(fmt.Sprintf("no occurrence found within %d years", o.MaxHorizonYears))
+	err.cause = ErrLimitExceeded
+	return err
+}
+
+// WithEvalOptions returns a copy of the schedule whose searches use opts.
+func (s *Schedule) WithEvalOptions(opts EvalOptions) *Schedule {
+	c := *s
+	c.options = opts
+	return &c
+}
+
+// EvalOptions returns the search bounds set with WithEvalOptions.
+func (s *Schedule) EvalOptions() EvalOptions {
+	return s.options
+}
+
+// NextFromOptions computes the next occurrence after now using opts for this call only.
+// It returns nil and no error if there is no future occurrence, and an EvalError
+// wrapping ErrLimitExceeded if the search gave up first.
+func (s *Schedule) NextFromOptions(now time.Time, opts EvalOptions) (*time.Time, error) {
+	return s.WithEvalOptions(opts).NextFromErr(now)
+}
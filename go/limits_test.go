@@ -0,0 +1,102 @@
+package hron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEvalOptionsIterationLimit(t *testing.T) {
+	s := MustParse("every day at 09:00 except feb 2, feb 3 in UTC")
+	from := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	next, err := s.NextFromOptions(from, EvalOptions{MaxIterations: 2})
+	if next != nil || !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("NextFromOptions() = %v, %v, want ErrLimitExceeded", next, err)
+	}
+	var herr *HronError
+	if !errors.As(err, &herr) || herr.Kind != ErrorKindEval {
+		t.Errorf("NextFromOptions() error = %#v, want an EvalError", err)
+	}
+
+	next, err = s.NextFromOptions(from, EvalOptions{MaxIterations: 3})
+	if err != nil || next == nil || next.Day() != 4 {
+		t.Errorf("NextFromOptions() = %v, %v, want Feb 4", next, err)
+	}
+}
+
+func TestEvalOptionsHorizon(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expr    string
+		years   int
+		limited bool
+	}{
+		{"every year on feb 29 at 09:00 in UTC", 1, true},
+		{"every year on feb 29 at 09:00 in UTC", 2, false},
+		{"on 2030-01-01 at 09:00 in UTC", 3, true},
+		{"on 2030-01-01 at 09:00 in UTC", 4, false},
+		// A schedule that has ended is not a limit, whatever the horizon
+		{"every day at 09:00 until 2026-02-01 in UTC", 1, false},
+	}
+	for _, tc := range cases {
+		_, err := MustParse(tc.expr).NextFromOptions(from, EvalOptions{MaxHorizonYears: tc.years})
+		if got := errors.Is(err, ErrLimitExceeded); got != tc.limited || (err != nil && !got) {
+			t.Errorf("%q within %d years: error = %v, want limited %v", tc.expr, tc.years, err, tc.limited)
+		}
+	}
+}
+
+func TestWithEvalOptions(t *testing.T) {
+	s := MustParse("every day at 09:00 except feb 2, feb 3 in UTC")
+	limited := s.WithEvalOptions(EvalOptions{MaxIterations: 2})
+	from := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	if next := limited.NextFrom(from); next != nil {
+		t.Errorf("limited NextFrom() = %v, want nil", next)
+	}
+	if next := s.NextFrom(from); next == nil || next.Day() != 4 {
+		t.Errorf("NextFrom() = %v, want Feb 4", next)
+	}
+	if got := limited.EvalOptions().MaxIterations; got != 2 {
+		t.Errorf("EvalOptions().MaxIterations = %d, want 2", got)
+	}
+}
+
+func TestNextFromErrDistinguishesEndFromLimit(t *testing.T) {
+	from := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expr    string
+		limited bool
+	}{
+		{"every day at 09:00 until 2026-01-15 in UTC", false},
+		{"on 2026-01-10 at 09:00 in UTC", false},
+		{"every day at 09:00 except feb 2, feb 3 in UTC", true},
+	}
+	for _, tc := range cases {
+		s := MustParse(tc.expr).WithEvalOptions(EvalOptions{MaxIterations: 2})
+		next, err := s.NextFromErr(from)
+		if next != nil || errors.Is(err, ErrLimitExceeded) != tc.limited {
+			t.Errorf("%q NextFromErr() = %v, %v, want limited %v", tc.expr, next, err, tc.limited)
+		}
+	}
+}
+
+func TestPreviousFromErr(t *testing.T) {
+	from := time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC)
+
+	s := MustParse("every day at 09:00 except feb 2, feb 3 in UTC").WithEvalOptions(EvalOptions{MaxIterations: 2})
+	if prev, err := s.PreviousFromErr(from); prev != nil || !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("PreviousFromErr() = %v, %v, want ErrLimitExceeded", prev, err)
+	}
+
+	s = MustParse("on 2026-03-01 at 09:00 in UTC")
+	if prev, err := s.PreviousFromErr(from); prev != nil || err != nil {
+		t.Errorf("PreviousFromErr() = %v, %v, want nil, nil", prev, err)
+	}
+
+	s = MustParse("every day at 09:00 starting 2026-02-01 in UTC")
+	if prev, err := s.PreviousFromErr(from); err != nil || prev == nil || prev.Day() != 3 {
+		t.Errorf("PreviousFromErr() = %v, %v, want Feb 3", prev, err)
+	}
+}
@@ -0,0 +1,234 @@
+package hron
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LintFinding is a style problem in an expression that parses: a construct with a
+// shorter or more conventional spelling. Rule names the check, as listed at Lint.
+type LintFinding struct {
+	Rule    string
+	Message string
+	Span    Span
+	Fix     string // The full expression with this finding fixed
+}
+
+// maxLintFixes bounds how many fixes LintFix applies, one finding at a time.
+const maxLintFixes = 16
+
+// Lint checks an expression for constructs that parse and mean what they say but are
+// spelled unconventionally. It returns nil for input that does not parse; use Parse for
+// its error. The rules are:
+//   - day-list: a day list naming exactly monday to friday, saturday and sunday, or all
+//     seven days, which weekday, weekend, or day says shorter
+//   - day-order: a day list out of calendar order or naming a day twice
+//   - week-repeat: every week on listed days, which is every on those days
+//   - time-order: an at list out of order or naming a time twice
+//   - during-order: during months out of calendar order or named twice
+//   - offset-unit: a plus or minus clause with a larger whole unit (plus 60 min)
+//   - deprecated: a keyword spelling deprecated with DeprecateKeyword, reported once per
+//     use, so the findings of this rule count the deprecated forms of the expression
+//
+// Each fix changes only the span of its finding and is checked to parse to a schedule
+// Equal to the original; findings whose fix would not are not reported.
+func Lint(input string) []LintFinding {
+	data, err := parseInput(input)
+	if err != nil {
+		return nil
+	}
+	tokens, _ := Tokenize(input)
+	l := &linter{data: data, tokens: tokens, input: input}
+	l.checkDayLists()
+	l.checkWeekRepeat()
+	l.checkTimes()
+	l.checkDuring()
+	l.checkOffset()
+	l.checkDeprecated()
+	slices.SortStableFunc(l.findings, func(a, b LintFinding) int { return a.Span.Start - b.Span.Start })
+	return l.findings
+}
+
+// LintFix applies the fixes of Lint until none are left and returns the result, or
+// input itself if it has no findings or does not parse.
+func LintFix(input string) string {
+	for range maxLintFixes {
+		findings := Lint(input)
+		if len(findings) == 0 {
+			break
+		}
+		input = findings[0].Fix
+	}
+	return input
+}
+
+// linter collects the findings of Lint.
+type linter struct {
+	data     *ScheduleData
+	tokens   []Token
+	input    string
+	findings []LintFinding
+}
+
+// add records a finding replacing span with replacement, if that yields an equal
+// schedule.
+func (l *linter) add(rule string, span Span, replacement, format string, args ...any) {
+	before, after := l.input[:span.Start], l.input[span.End:]
+	if replacement == "" {
+		before, after = strings.TrimRight(before, " "), strings.TrimLeft(after, " ")
+		if before != "" && after != "" {
+			replacement = " "
+		}
+	}
+	fix := before + replacement + after
+	data, err := parseInput(fix)
+	if err != nil || Display(Normalize(data)) != Display(Normalize(l.data)) {
+		return
+	}
+	l.findings = append(l.findings, LintFinding{Rule: rule, Message: fmt.Sprintf(format, args...), Span: span, Fix: fix})
+}
+
+func (l *linter) text(tok Token) string {
+	return l.input[tok.Span.Start:tok.Span.End]
+}
+
+// lists returns the comma-separated runs of tokens of kind among tokens.
+func lists(tokens []Token, kind TokenKind) [][]Token {
+	var runs [][]Token
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].Kind != kind {
+			continue
+		}
+		run := []Token{tokens[i]}
+		for i+2 < len(tokens) && tokens[i+1].Kind == TokenComma && tokens[i+2].Kind == kind {
+			run = append(run, tokens[i+2])
+			i += 2
+		}
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+func runSpan(run []Token) Span {
+	return Span{run[0].Span.Start, run[len(run)-1].Span.End}
+}
+
+// sortedRun returns the texts of run sorted by compare with repeats dropped, and whether
+// that differs from run.
+func (l *linter) sortedRun(run []Token, compare func(a, b Token) int) (string, bool) {
+	sorted := slices.SortedStableFunc(slices.Values(run), compare)
+	sorted = slices.CompactFunc(sorted, func(a, b Token) bool { return compare(a, b) == 0 })
+	texts := make([]string, len(sorted))
+	for i, tok := range sorted {
+		texts[i] = l.text(tok)
+	}
+	changed := len(sorted) != len(run)
+	for i := range sorted {
+		changed = changed || sorted[i].Span != run[i].Span
+	}
+	return strings.Join(texts, ", "), changed
+}
+
+func (l *linter) checkDayLists() {
+	kind := l.data.Expr.Kind
+	head := headTokens(l.tokens)
+	for _, run := range lists(head, TokenDayName) {
+		span := runSpan(run)
+		var days []Weekday
+		for _, tok := range run {
+			days = append(days, tok.DayNameVal)
+		}
+		slices.Sort(days)
+		days = slices.Compact(days)
+
+		word, names := "", ""
+		switch {
+		case kind != ScheduleExprKindDay && kind != ScheduleExprKindInterval:
+		case slices.Equal(days, []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday}):
+			word, names = "weekday", "monday to friday"
+		case slices.Equal(days, []Weekday{Saturday, Sunday}):
+			word, names = "weekend", "saturday and sunday"
+		case len(days) == 7:
+			word, names = "day", "all seven days"
+		}
+		switch {
+		case word == "day" && kind == ScheduleExprKindInterval:
+			// An interval repeat on every day has no day filter: drop `on` and the list
+			on := slices.IndexFunc(head, func(t Token) bool { return t.Span == run[0].Span }) - 1
+			l.add("day-list", Span{head[on].Span.Start, span.End}, "", "the list names all seven days; leave it out")
+			continue
+		case word != "":
+			l.add("day-list", span, word, "the list names %s; write '%s'", names, word)
+			continue
+		}
+		if fixed, changed := l.sortedRun(run, func(a, b Token) int { return cmp.Compare(a.DayNameVal, b.DayNameVal) }); changed {
+			l.add("day-order", span, fixed, "list the days in order, each once")
+		}
+	}
+}
+
+func (l *linter) checkWeekRepeat() {
+	expr := l.data.Expr
+	if expr.Kind != ScheduleExprKindWeek || expr.Interval != 1 || l.data.Alignment != AlignmentDefault {
+		return
+	}
+	head := headTokens(l.tokens)
+	for i := 0; i+2 < len(head); i++ {
+		if head[i].Kind == TokenEvery && head[i+1].Kind == TokenWeeks && head[i+2].Kind == TokenOn {
+			l.add("week-repeat", Span{head[i+1].Span.Start, head[i+2].Span.End}, "", "'every week on' the days is 'every' the days")
+			return
+		}
+	}
+}
+
+func (l *linter) checkTimes() {
+	for _, run := range lists(headTokens(l.tokens), TokenTime) {
+		fixed, changed := l.sortedRun(run, func(a, b Token) int {
+			return cmp.Or(cmp.Compare(a.TimeHour, b.TimeHour), cmp.Compare(a.TimeMinute, b.TimeMinute))
+		})
+		if changed {
+			l.add("time-order", runSpan(run), fixed, "list the times in order, each once")
+		}
+	}
+}
+
+func (l *linter) checkDuring() {
+	clause := clauseTokens(l.tokens, TokenDuring)
+	if clause == nil {
+		return
+	}
+	runs := lists(clause[1:], TokenMonthName)
+	// Only a clause of nothing but months is reordered; windows and quarters stay put
+	if len(runs) != 1 || 2*len(runs[0]) != len(clause) {
+		return
+	}
+	if fixed, changed := l.sortedRun(runs[0], func(a, b Token) int { return cmp.Compare(a.MonthNameVal, b.MonthNameVal) }); changed {
+		l.add("during-order", runSpan(runs[0]), fixed, "list the months in order, each once")
+	}
+}
+
+func (l *linter) checkOffset() {
+	clause := clauseTokens(l.tokens, TokenPlus)
+	if clause == nil {
+		clause = clauseTokens(l.tokens, TokenMinus)
+	}
+	if clause == nil {
+		return
+	}
+	offset, _ := offsetOf(l.data.Offset.Duration())
+	if offset == l.data.Offset {
+		return
+	}
+	fixed := displayOffset(offset)
+	l.add("offset-unit", runSpan(clause), fixed, "write the offset as '%s'", fixed)
+}
+
+func (l *linter) checkDeprecated() {
+	for _, w := range deprecationWarnings(l.input) {
+		// The suggestion has the replacement where the input has the deprecated word
+		replacement := w.Suggestion[w.Span.Start : len(w.Suggestion)-(len(l.input)-w.Span.End)]
+		l.add("deprecated", w.Span, replacement, "%s", w.Message)
+	}
+}
@@ -0,0 +1,51 @@
+package hron
+
+import "testing"
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		input string
+		rule  string
+		span  string
+		fix   string
+	}{
+		{"every monday, tuesday, wednesday, thursday, friday at 09:00", "day-list", "monday, tuesday, wednesday, thursday, friday", "every weekday at 09:00"},
+		{"every sunday, saturday at 09:00", "day-list", "sunday, saturday", "every weekend at 09:00"},
+		{"every 15 min from 09:00 to 17:00 on mon, tue, wed, thu, fri, sat, sun in UTC", "day-list", "on mon, tue, wed, thu, fri, sat, sun", "every 15 min from 09:00 to 17:00 in UTC"},
+		{"every friday, monday at 09:00", "day-order", "friday, monday", "every monday, friday at 09:00"},
+		{"every week on monday at 09:00", "week-repeat", "week on", "every monday at 09:00"},
+		{"every day at 17:00, 9am, 09:00", "time-order", "17:00, 9am, 09:00", "every day at 9am, 17:00"},
+		{"every day at 09:00 during mar, jan", "during-order", "mar, jan", "every day at 09:00 during jan, mar"},
+		{"every day at 09:00 minus 120 min", "offset-unit", "minus 120 min", "every day at 09:00 minus 2 hours"},
+	}
+	for _, tt := range tests {
+		findings := Lint(tt.input)
+		if len(findings) != 1 {
+			t.Errorf("%q: findings = %+v, want one", tt.input, findings)
+			continue
+		}
+		f := findings[0]
+		if f.Rule != tt.rule || tt.input[f.Span.Start:f.Span.End] != tt.span || f.Fix != tt.fix {
+			t.Errorf("%q: %s at %q fixed to %q, want %s at %q fixed to %q", tt.input, f.Rule, tt.input[f.Span.Start:f.Span.End], f.Fix, tt.rule, tt.span, tt.fix)
+		}
+	}
+
+	for _, input := range []string{
+		"every weekday at 09:00, 17:00",
+		"every 2 weeks on saturday, sunday at 09:00",
+		"every day at 09:00 during mar, q1",
+		"every day at 09:00 plus 90 min",
+		"every day at",
+	} {
+		if findings := Lint(input); len(findings) != 0 {
+			t.Errorf("%q: findings = %+v, want none", input, findings)
+		}
+	}
+}
+
+func TestLintFix(t *testing.T) {
+	input := "every week on friday, monday at 17:00, 09:00 plus 60 min during jun, jan"
+	if got, want := LintFix(input), "every monday, friday at 09:00, 17:00 plus 1 hour during jan, jun"; got != want {
+		t.Errorf("LintFix = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,265 @@
+package hron
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale is a keyword pack for writing expressions in another language. Only keywords
+// are translated: numbers, times, dates, timezones, and seeds are written as in English,
+// and English keywords remain valid alongside the pack's words.
+type Locale struct {
+	// Name identifies the locale (e.g., "es").
+	Name string
+	// Keywords maps a lowercase localized word or phrase to the English keywords it
+	// stands for. One phrase may stand for several keywords ("el" for "on the").
+	Keywords map[string]string
+	// Display maps English keywords, or keyword phrases, to their preferred localized
+	// form when rendering. Keywords missing from the map render in English.
+	Display map[string]string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{
+		"en":               {Name: "en"},
+		spanishLocale.Name: &spanishLocale,
+	}
+)
+
+// RegisterLocale makes a keyword pack available to ParseScheduleWithLocale and
+// DisplayLocale. Every Keywords value must consist of English keywords, and every
+// Display form must be a Keywords entry that maps back to the same keywords, so that
+// rendered schedules parse again. Registering an existing name replaces it.
+func RegisterLocale(locale *Locale) error {
+	if locale == nil || locale.Name == "" {
+		return fmt.Errorf("locale must have a name")
+	}
+	for phrase, english := range locale.Keywords {
+		if phrase != strings.ToLower(phrase) {
+			return fmt.Errorf("locale %s: keyword %q must be lowercase", locale.Name, phrase)
+		}
+		fields := strings.Fields(english)
+		if len(fields) == 0 {
+			return fmt.Errorf("locale %s: keyword %q has no English keywords", locale.Name, phrase)
+		}
+		for _, kw := range fields {
+			if _, ok := keywordMap[kw]; !ok {
+				return fmt.Errorf("locale %s: %q is not an English keyword", locale.Name, kw)
+			}
+		}
+	}
+	for english, phrase := range locale.Display {
+		if locale.Keywords[phrase] != english {
+			return fmt.Errorf("locale %s: display form %q does not parse back to %q", locale.Name, phrase, english)
+		}
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[locale.Name] = locale
+	return nil
+}
+
+func lookupLocale(name string) (*Locale, error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	locale, ok := locales[name]
+	if !ok {
+		return nil, EvalError(fmt.Sprintf("unknown locale: %s", name))
+	}
+	return locale, nil
+}
+
+// ParseScheduleWithLocale parses an expression written with a locale's keywords, such
+// as "cada día a las 09:00" for "es". The "en" locale is the same as ParseSchedule.
+func ParseScheduleWithLocale(input, locale string) (*Schedule, error) {
+	l, err := lookupLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.Keywords) == 0 {
+		return ParseSchedule(input)
+	}
+	tokens, err := (&lexer{input: input, locale: l}).tokenize()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parseTokens(tokens, input)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchedule(data)
+}
+
+// DisplayLocale renders the schedule like Display, with keywords in the given locale.
+// The result parses back to the same schedule with ParseScheduleWithLocale.
+func DisplayLocale(schedule *ScheduleData, locale string) (string, error) {
+	l, err := lookupLocale(locale)
+	if err != nil {
+		return "", err
+	}
+	// The timezone is appended untranslated so that zone names are never rewritten
+	withoutTZ := *schedule
+	withoutTZ.Timezone, withoutTZ.Timezones = "", nil
+	out := translateKeywords(Display(&withoutTZ), l.Display)
+	if schedule.Timezone != "" {
+		out += " " + translateKeywords("in", l.Display) + " " + strings.Join(schedule.AllTimezones(), ", ")
+	}
+	return out, nil
+}
+
+// StringIn renders the schedule with keywords in the given locale.
+func (s *Schedule) StringIn(locale string) (string, error) {
+	return DisplayLocale(s.data, locale)
+}
+
+// translateKeywords replaces English keywords and keyword phrases in a canonical string,
+// preferring the longest phrase and leaving quoted seeds untouched.
+func translateKeywords(english string, display map[string]string) string {
+	if len(display) == 0 {
+		return english
+	}
+	maxWords := 1
+	for phrase := range display {
+		maxWords = max(maxWords, len(strings.Fields(phrase)))
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(english); {
+		switch {
+		case english[i] == '"':
+			end := strings.IndexByte(english[i+1:], '"')
+			if end < 0 {
+				sb.WriteString(english[i:])
+				return sb.String()
+			}
+			sb.WriteString(english[i : i+end+2])
+			i += end + 2
+		case isAlpha(english[i]):
+			words, ends := wordsAt(english, i, maxWords)
+			n := len(words)
+			for ; n > 0; n-- {
+				if local, ok := display[strings.Join(words[:n], " ")]; ok {
+					sb.WriteString(local)
+					break
+				}
+			}
+			if n == 0 {
+				n = 1
+				sb.WriteString(words[0])
+			}
+			i = ends[n-1]
+		default:
+			sb.WriteByte(english[i])
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// wordsAt returns up to limit words starting at pos that are separated by single spaces,
+// with the end offset of each.
+func wordsAt(s string, pos, limit int) ([]string, []int) {
+	var words []string
+	var ends []int
+	for len(words) < limit {
+		end := pos
+		for end < len(s) && isAlphanumeric(s[end]) {
+			end++
+		}
+		words = append(words, s[pos:end])
+		ends = append(ends, end)
+		if end+1 >= len(s) || s[end] != ' ' || !isAlpha(s[end+1]) {
+			break
+		}
+		pos = end + 1
+	}
+	return words, ends
+}
+
+// --- Lexing ---
+
+// localizedPhrase finds the longest locale phrase at the current position and returns
+// its English keywords and end offset.
+func (l *lexer) localizedPhrase() (string, int, bool) {
+	var words []string
+	var ends []int
+	pos := l.pos
+	for len(words) < 4 {
+		end := pos
+		for end < len(l.input) {
+			r, size := utf8.DecodeRuneInString(l.input[end:])
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+				break
+			}
+			end += size
+		}
+		if end == pos {
+			break
+		}
+		words = append(words, strings.ToLower(l.input[pos:end]))
+		ends = append(ends, end)
+		pos = end
+		for pos < len(l.input) && isWhitespace(l.input[pos]) {
+			pos++
+		}
+	}
+	for n := len(words); n > 0; n-- {
+		if english, ok := l.locale.Keywords[strings.Join(words[:n], " ")]; ok {
+			return english, ends[n-1], true
+		}
+	}
+	return "", 0, false
+}
+
+// lexLocalized lexes a locale phrase into the tokens of its English keywords, all
+// sharing the phrase's span.
+func (l *lexer) lexLocalized() ([]Token, bool, error) {
+	english, end, ok := l.localizedPhrase()
+	if !ok {
+		if l.input[l.pos] >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+			if unicode.IsLetter(r) {
+				end := l.pos + size
+				for end < len(l.input) {
+					r, size := utf8.DecodeRuneInString(l.input[end:])
+					if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+						break
+					}
+					end += size
+				}
+				return nil, false, LexError("unknown keyword '"+l.input[l.pos:end]+"'", Span{l.pos, end}, l.input)
+			}
+		}
+		return nil, false, nil
+	}
+	span := Span{l.pos, end}
+	l.pos = end
+	var tokens []Token
+	for _, kw := range strings.Fields(english) {
+		tok := keywordMap[kw]
+		tok.Span = span
+		if tok.Kind == TokenIn {
+			l.afterIn = true
+		}
+		tokens = append(tokens, tok)
+	}
+	return tokens, true, nil
+}
+
+// atThe reports whether the next word is "the" or a locale phrase starting with it,
+// so "in the second week" is not lexed as a timezone.
+func (l *lexer) atThe() bool {
+	if l.atWord("the") {
+		return true
+	}
+	if l.locale == nil {
+		return false
+	}
+	english, _, ok := l.localizedPhrase()
+	return ok && strings.HasPrefix(english, "the")
+}
@@ -0,0 +1,156 @@
+package hron
+
+// spanishLocale is the built-in Spanish keyword pack.
+var spanishLocale = Locale{
+	Name: "es",
+	Keywords: map[string]string{
+		"cada":            "every",
+		"día":             "day",
+		"dia":             "day",
+		"días":            "days",
+		"dias":            "days",
+		"día laborable":   "weekday",
+		"dia laborable":   "weekday",
+		"días laborables": "weekdays",
+		"fin de semana":   "weekend",
+		"semana":          "week",
+		"semanas":         "weeks",
+		"mes":             "month",
+		"meses":           "months",
+		"año":             "year",
+		"años":            "years",
+		"trimestre":       "quarter",
+		"trimestres":      "quarters",
+		"a las":           "at",
+		"a la":            "at",
+		"el":              "on the",
+		"los":             "on",
+		"la":              "the",
+		"desde":           "from",
+		"hasta":           "to",
+		"hasta el":        "until",
+		"en":              "in",
+		"de":              "of",
+		"último":          "last",
+		"ultimo":          "last",
+		"última":          "last",
+		"excepto":         "except",
+		"a partir del":    "starting",
+		"durante":         "during",
+		"alineado a":      "aligned to",
+		"festivos":        "holidays",
+		"mediodía":        "noon",
+		"medianoche":      "midnight",
+		"minuto":          "minute",
+		"minutos":         "minutes",
+		"hora":            "hour",
+		"horas":           "hours",
+		"segundos":        "seconds",
+		"antes de":        "before",
+		"más":             "plus",
+		"mas":             "plus",
+		"menos":           "minus",
+		"amanecer":        "sunrise",
+		"atardecer":       "sunset",
+		"alba":            "dawn",
+		"anochecer":       "dusk",
+		"después de":      "after",
+		"despues de":      "after",
+		"primer":          "first",
+		"primero":         "first",
+		"segundo":         "second",
+		"tercer":          "third",
+		"tercero":         "third",
+		"cuarto":          "fourth",
+		"quinto":          "fifth",
+		// Day names
+		"lunes":     "monday",
+		"martes":    "tuesday",
+		"miércoles": "wednesday",
+		"miercoles": "wednesday",
+		"jueves":    "thursday",
+		"viernes":   "friday",
+		"sábado":    "saturday",
+		"sabado":    "saturday",
+		"domingo":   "sunday",
+		// Month names
+		"enero":      "jan",
+		"febrero":    "feb",
+		"marzo":      "mar",
+		"abril":      "apr",
+		"mayo":       "may",
+		"junio":      "jun",
+		"julio":      "jul",
+		"agosto":     "aug",
+		"septiembre": "sep",
+		"octubre":    "oct",
+		"noviembre":  "nov",
+		"diciembre":  "dec",
+	},
+	Display: map[string]string{
+		"every":      "cada",
+		"day":        "día",
+		"days":       "días",
+		"weekday":    "día laborable",
+		"weekend":    "fin de semana",
+		"week":       "semana",
+		"weeks":      "semanas",
+		"month":      "mes",
+		"months":     "meses",
+		"year":       "año",
+		"years":      "años",
+		"quarter":    "trimestre",
+		"quarters":   "trimestres",
+		"at":         "a las",
+		"on the":     "el",
+		"on":         "los",
+		"the":        "la",
+		"from":       "desde",
+		"to":         "hasta",
+		"until":      "hasta el",
+		"in":         "en",
+		"of":         "de",
+		"last":       "último",
+		"except":     "excepto",
+		"starting":   "a partir del",
+		"during":     "durante",
+		"aligned to": "alineado a",
+		"holidays":   "festivos",
+		"minute":     "minuto",
+		"hour":       "hora",
+		"hours":      "horas",
+		"seconds":    "segundos",
+		"before":     "antes de",
+		"plus":       "más",
+		"minus":      "menos",
+		"sunrise":    "amanecer",
+		"sunset":     "atardecer",
+		"dawn":       "alba",
+		"dusk":       "anochecer",
+		"after":      "después de",
+		"first":      "primer",
+		"second":     "segundo",
+		"third":      "tercer",
+		"fourth":     "cuarto",
+		"fifth":      "quinto",
+		"monday":     "lunes",
+		"tuesday":    "martes",
+		"wednesday":  "miércoles",
+		"thursday":   "jueves",
+		"friday":     "viernes",
+		"saturday":   "sábado",
+		"sunday":     "domingo",
+		"jan":        "enero",
+		"feb":        "febrero",
+		"mar":        "marzo",
+		"apr":        "abril",
+		"may":        "mayo",
+		"jun":        "junio",
+		"jul":        "julio",
+		"aug":        "agosto",
+		"sep":        "septiembre",
+		"oct":        "octubre",
+		"nov":        "noviembre",
+		"dec":        "diciembre",
+	},
+}
@@ -0,0 +1,112 @@
+package hron
+
+import (
+	"testing"
+)
+
+func TestParseScheduleWithLocaleSpanish(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"cada día a las 09:00", "every day at 09:00"},
+		{"Cada Día Laborable a las 9:00 en Europe/Madrid", "every weekday at 09:00 in Europe/Madrid"},
+		{"cada 2 semanas los lunes, viernes a las 10:00", "every 2 weeks on monday, friday at 10:00"},
+		{"cada mes el primer lunes a las 09:00", "every month on the first monday at 09:00"},
+		{"cada año el 25th de diciembre a las 00:00", "every year on the 25th of dec at 00:00"},
+		{"cada 30 minutos desde 09:00 hasta 17:00", "every 30 min from 09:00 to 17:00"},
+		{"cada día a las 09:00 excepto dec 25 hasta el 2026-12-31", "every day at 09:00 except dec 25 until 2026-12-31"},
+		{"cada mes en la segundo semana los martes a las 10:00", "every month in the second week on tuesday at 10:00"},
+		{"every day at 09:00 durante junio", "every day at 09:00 during jun"},
+	}
+	for _, tt := range tests {
+		s, err := ParseScheduleWithLocale(tt.input, "es")
+		if err != nil {
+			t.Errorf("ParseScheduleWithLocale(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseScheduleWithLocale(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseScheduleWithLocaleErrors(t *testing.T) {
+	if _, err := ParseScheduleWithLocale("cada día a las 09:00", "xx"); err == nil {
+		t.Error("unknown locale succeeded, want error")
+	}
+	if _, err := ParseScheduleWithLocale("cada díaz a las 09:00", "es"); err == nil {
+		t.Error("unknown localized word succeeded, want error")
+	}
+	if _, err := ParseScheduleWithLocale("cada día a las 09:00", "en"); err == nil {
+		t.Error("spanish input parsed with en, want error")
+	}
+}
+
+func TestDisplayLocaleRoundtrip(t *testing.T) {
+	for _, input := range []string{
+		"every day at 09:00",
+		"every weekday at 09:00 in Europe/Madrid",
+		"every 2 weeks on monday, friday at 10:00",
+		"every month on the last day at 17:00",
+		"every month on the second to last friday at 09:00",
+		"every year on the first monday of sep at 09:00",
+		"every 30 min from 09:00 to 17:00 on weekday",
+		"every day at 09:00 except dec 25 until 2026-12-31 during jun, jul",
+		"every 3 days at 09:00 aligned to month start",
+		"every month in the second week on tuesday at 10:00 in America/New_York",
+		`one random weekday each week at 09:00 seeded by "the day"`,
+	} {
+		s := MustParse(input)
+		local, err := s.StringIn("es")
+		if err != nil {
+			t.Errorf("StringIn(%q) error: %v", input, err)
+			continue
+		}
+		back, err := ParseScheduleWithLocale(local, "es")
+		if err != nil {
+			t.Errorf("ParseScheduleWithLocale(%q) error: %v", local, err)
+			continue
+		}
+		if back.String() != s.String() {
+			t.Errorf("roundtrip of %q via %q = %q", input, local, back.String())
+		}
+	}
+
+	got, _ := MustParse("every weekday at 09:00 in Europe/Madrid").StringIn("es")
+	if want := "cada día laborable a las 09:00 en Europe/Madrid"; got != want {
+		t.Errorf("StringIn = %q, want %q", got, want)
+	}
+	if got, _ := MustParse("every day at 09:00").StringIn("en"); got != "every day at 09:00" {
+		t.Errorf("StringIn(en) = %q", got)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	if err := RegisterLocale(&spanishLocale); err != nil {
+		t.Errorf("built-in spanish pack is invalid: %v", err)
+	}
+	bad := []*Locale{
+		{},
+		{Name: "xx", Keywords: map[string]string{"tous": "everything"}},
+		{Name: "xx", Keywords: map[string]string{"Chaque": "every"}},
+		{Name: "xx", Keywords: map[string]string{"chaque": "every"}, Display: map[string]string{"every": "tous"}},
+	}
+	for _, l := range bad {
+		if err := RegisterLocale(l); err == nil {
+			t.Errorf("RegisterLocale(%+v) succeeded, want error", l)
+		}
+	}
+
+	fr := &Locale{
+		Name:     "fr-test",
+		Keywords: map[string]string{"chaque": "every", "jour": "day", "à": "at"},
+		Display:  map[string]string{"every": "chaque", "day": "jour", "at": "à"},
+	}
+	if err := RegisterLocale(fr); err != nil {
+		t.Fatalf("RegisterLocale: %v", err)
+	}
+	s, err := ParseScheduleWithLocale("chaque jour à 09:00", "fr-test")
+	if err != nil || s.String() != "every day at 09:00" {
+		t.Errorf("ParseScheduleWithLocale(fr-test) = %v, %v", s, err)
+	}
+}
@@ -0,0 +1,35 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMatchesWithin(t *testing.T) {
+	tests := []struct {
+		expr      string
+		dt        time.Time
+		tolerance time.Duration
+		want      bool
+	}{
+		// A trigger a little early or late matches within the tolerance
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 8, 59, 45, 0, time.UTC), 30 * time.Second, true},
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 8, 59, 15, 0, time.UTC), 30 * time.Second, false},
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 9, 0, 0, 500_000_000, time.UTC), 0, true},
+		// Late beyond the matched minute
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 9, 1, 10, 0, time.UTC), 90 * time.Second, true},
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 9, 1, 10, 0, time.UTC), 30 * time.Second, false},
+		// Exclusions still apply to the occurrence found
+		{"every weekday at 09:00 in UTC", time.Date(2026, 3, 14, 8, 59, 50, 0, time.UTC), 30 * time.Second, false},
+		{"every 90 seconds from 09:00 to 10:00 in UTC", time.Date(2026, 3, 10, 9, 1, 28, 0, time.UTC), 5 * time.Second, true},
+		{"every 90 seconds from 09:00 to 10:00 in UTC", time.Date(2026, 3, 10, 9, 1, 28, 0, time.UTC), time.Second, false},
+		// A negative tolerance is exact matching
+		{"every day at 09:00 in UTC", time.Date(2026, 3, 10, 8, 59, 59, 0, time.UTC), -time.Minute, false},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr)
+		if got := s.MatchesWithin(tt.dt, tt.tolerance); got != tt.want {
+			t.Errorf("%q MatchesWithin(%v, %v) = %v, want %v", tt.expr, tt.dt, tt.tolerance, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package hron
+
+import "fmt"
+
+// Migration is the outcome of migrating a single stored expression.
+type Migration struct {
+	Input    string
+	Output   string // Canonical form; equal to Input when migration failed
+	Changed  bool
+	Warnings []Warning // Deprecated forms that were rewritten
+	Err      error     // Non-nil if the expression could not be migrated
+}
+
+// MigrateExpressions canonicalizes stored expressions for the given target version,
+// rewriting deprecated forms. Every item gets a Migration with its own diagnostics, so
+// one bad expression doesn't abort a batch. An empty targetVersion means the current Version.
+func MigrateExpressions(in []string, targetVersion string) ([]Migration, error) {
+	if targetVersion != "" && targetVersion != Version {
+		return nil, fmt.Errorf("unsupported target version %q (supported: %s)", targetVersion, Version)
+	}
+
+	out := make([]Migration, len(in))
+	for i, input := range in {
+		out[i] = migrateExpression(input)
+	}
+	return out, nil
+}
+
+func migrateExpression(input string) Migration {
+	m := Migration{Input: input, Output: input}
+
+	data, warnings, err := ParseWithWarnings(input)
+	if err != nil {
+		m.Err = err
+		return m
+	}
+	if _, err := NewSchedule(data); err != nil {
+		m.Err = err
+		return m
+	}
+	m.Warnings = warnings
+
+	if err := CheckRoundtrip(data); err != nil {
+		m.Err = err
+		return m
+	}
+
+	canonical := Display(data)
+	m.Output = canonical
+	m.Changed = canonical != input
+	return m
+}
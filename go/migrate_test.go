@@ -0,0 +1,44 @@
+package hron
+
+import (
+	"testing"
+)
+
+func TestMigrateExpressions(t *testing.T) {
+	deprecateHrs(t)
+
+	got, err := MigrateExpressions([]string{
+		"every 2 hrs from 09:00 to 17:00",
+		"every weekday at 9:00",
+		"every day at 09:00",
+		"every day at 25:00",
+		"every day at 09:00 in Not/AZone",
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got[0].Output != "every 2 hours from 09:00 to 17:00" || !got[0].Changed || len(got[0].Warnings) != 1 {
+		t.Errorf("deprecated form: %+v", got[0])
+	}
+	if got[1].Output != "every weekday at 09:00" || !got[1].Changed {
+		t.Errorf("non-canonical form: %+v", got[1])
+	}
+	if got[2].Changed || got[2].Err != nil {
+		t.Errorf("canonical form: %+v", got[2])
+	}
+	for _, m := range got[3:] {
+		if m.Err == nil || m.Output != m.Input {
+			t.Errorf("invalid input %q: %+v", m.Input, m)
+		}
+	}
+}
+
+func TestMigrateExpressionsUnsupportedVersion(t *testing.T) {
+	if _, err := MigrateExpressions([]string{"every day at 09:00"}, "0.1.0"); err == nil {
+		t.Error("expected error for unsupported target version")
+	}
+	if _, err := MigrateExpressions(nil, Version); err != nil {
+		t.Errorf("MigrateExpressions(nil, Version): %v", err)
+	}
+}
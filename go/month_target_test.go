@@ -0,0 +1,229 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrdinalWeekdayList(t *testing.T) {
+	tests := []struct {
+		input     string
+		canonical string
+	}{
+		{"every month on the first, third monday at 9:00", "every month on the first, third monday at 09:00"},
+		{"every month on the first monday, last friday at 9:00", "every month on the first monday, last friday at 09:00"},
+		{"first, third monday of every month at 09:00", "every month on the first, third monday at 09:00"},
+		{"last friday of every 2 months at 17:00", "every 2 months on the last friday at 17:00"},
+	}
+	for _, tc := range tests {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tc.input, err)
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+		if _, err := ParseSchedule(tc.canonical); err != nil {
+			t.Errorf("roundtrip %q: %v", tc.canonical, err)
+		}
+	}
+}
+
+func TestOrdinalWeekdayListEval(t *testing.T) {
+	s := MustParse("every month on the first monday, last friday at 09:00")
+
+	from := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	got := s.NextNFrom(from, 3)
+	want := []time.Time{
+		time.Date(2026, 2, 27, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 27, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	prev := s.PreviousFrom(from)
+	if wantPrev := time.Date(2026, 2, 2, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(wantPrev) {
+		t.Errorf("PreviousFrom() = %v, want %v", prev, wantPrev)
+	}
+	if !s.Matches(want[1]) || s.Matches(time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() disagrees with ordinal list")
+	}
+}
+
+func TestWeekOfMonthParseDisplay(t *testing.T) {
+	cases := []string{
+		"every month in the second week on tuesday at 10:00",
+		"every 2 months in the last week on monday, friday at 10:00",
+		"every month in the first week on monday at 09:00 in America/New_York",
+	}
+	for _, input := range cases {
+		s, err := ParseSchedule(input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", input, err)
+		}
+		if got := s.String(); got != input {
+			t.Errorf("ParseSchedule(%q).String() = %q", input, got)
+		}
+	}
+	if _, err := ParseSchedule("every month in the second week at 10:00"); err == nil {
+		t.Error("expected error for week of month without days")
+	}
+}
+
+func TestWeekOfMonthEval(t *testing.T) {
+	week := MustParse("every month in the second week on tuesday at 10:00")
+	ordinal := MustParse("every month on the second tuesday at 10:00")
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got, want := week.NextFrom(from), time.Date(2026, 1, 6, 10, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("week NextFrom() = %v, want %v", got, want)
+	}
+	if got, want := ordinal.NextFrom(from), time.Date(2026, 1, 13, 10, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("ordinal NextFrom() = %v, want %v", got, want)
+	}
+}
+
+func TestWeekOfMonthCrossesMonthBoundary(t *testing.T) {
+	s := MustParse("every month in the first week on monday at 09:00")
+
+	// January 2026's first week starts on Monday, December 29, 2025.
+	jan := time.Date(2025, 12, 29, 9, 0, 0, 0, time.UTC)
+	if got := s.NextFrom(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)); got == nil || !got.Equal(jan) {
+		t.Errorf("NextFrom() = %v, want %v", got, jan)
+	}
+	if got := s.PreviousFrom(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)); got == nil || !got.Equal(jan) {
+		t.Errorf("PreviousFrom() = %v, want %v", got, jan)
+	}
+	if !s.Matches(jan) {
+		t.Errorf("Matches(%v) = false", jan)
+	}
+
+	last := MustParse("every month in the last week on monday at 09:00")
+	if !last.Matches(time.Date(2025, 12, 22, 9, 0, 0, 0, time.UTC)) || last.Matches(jan) {
+		t.Error("last week Matches() should follow the month owning the week's Thursday")
+	}
+
+	during := MustParse("every month in the first week on monday at 09:00 during jan")
+	if got := during.NextFrom(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)); got == nil || !got.Equal(jan) {
+		t.Errorf("during NextFrom() = %v, want %v", got, jan)
+	}
+}
+
+func TestWeekOfMonthToCron(t *testing.T) {
+	if _, err := MustParse("every month in the second week on tuesday at 10:00").ToCron(); err == nil {
+		t.Error("expected ToCron error for week of month")
+	}
+}
+
+type holidaySet map[time.Time]bool
+
+func (h holidaySet) IsHoliday(date time.Time) bool { return h[date] }
+
+func TestBusinessDayParseDisplay(t *testing.T) {
+	cases := []struct{ input, canonical string }{
+		{"every month on the 3rd business day at 09:00", "every month on the 3rd business day at 09:00"},
+		{"every month on the first business day at 09:00", "every month on the 1st business day at 09:00"},
+		{"every 3 months on the last business day at 17:00", "every 3 months on the last business day at 17:00"},
+	}
+	for _, tc := range cases {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tc.input, err)
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+	}
+	if _, err := ParseSchedule("every month on the 24th business day at 09:00"); err == nil {
+		t.Error("expected error for business day beyond 23")
+	}
+}
+
+func TestBusinessDayEval(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	third := MustParse("every month on the 3rd business day at 09:00")
+	last := MustParse("every month on the last business day at 17:00")
+
+	if got, want := third.NextFrom(from), time.Date(2026, 2, 4, 9, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom() = %v, want %v", got, want)
+	}
+	if got, want := last.NextFrom(from), time.Date(2026, 2, 27, 17, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("last NextFrom() = %v, want %v", got, want)
+	}
+
+	cal := holidaySet{
+		time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC):  true,
+		time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC): true,
+	}
+	withCal := third.WithHolidayCalendar(cal)
+	want := time.Date(2026, 2, 5, 9, 0, 0, 0, time.UTC)
+	if got := withCal.NextFrom(from); got == nil || !got.Equal(want) {
+		t.Errorf("with calendar NextFrom() = %v, want %v", got, want)
+	}
+	if !withCal.Matches(want) || withCal.Matches(time.Date(2026, 2, 4, 9, 0, 0, 0, time.UTC)) {
+		t.Error("with calendar Matches() should skip holidays")
+	}
+	if got := withCal.PreviousFrom(time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)); got == nil || !got.Equal(want) {
+		t.Errorf("with calendar PreviousFrom() = %v, want %v", got, want)
+	}
+	if got, want := last.WithHolidayCalendar(cal).NextFrom(from), time.Date(2026, 2, 26, 17, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("last with calendar NextFrom() = %v, want %v", got, want)
+	}
+	if got := third.NextFrom(from); got == nil || got.Day() != 4 {
+		t.Errorf("WithHolidayCalendar modified the original schedule: %v", got)
+	}
+}
+
+func TestOrdinalFromEndParseDisplay(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every month on the second to last friday at 09:00", "every month on the second to last friday at 09:00"},
+		{"every month on the third to last, last monday at 09:00", "every month on the third to last, last monday at 09:00"},
+		{"second to last thursday of every month at 10:00", "every month on the second to last thursday at 10:00"},
+		{"every year on the second to last monday of may at 09:00", "every year on the second to last monday of may at 09:00"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+	if _, err := ParseSchedule("every month on the first to last friday at 09:00"); err == nil {
+		t.Error("first to last parsed, want error")
+	}
+}
+
+func TestOrdinalFromEndEval(t *testing.T) {
+	s := MustParse("every month on the second to last friday at 09:00 in UTC")
+	// Fridays in january 2026: 2, 9, 16, 23, 30
+	next := s.NextFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 1, 23, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+	if !s.Matches(time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches feb 20 2026 = false, want true")
+	}
+
+	// June 2026 is the first month after march with five mondays
+	s = MustParse("every month on the fifth to last monday at 09:00 in UTC")
+	next = s.NextFrom(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom fifth to last = %v, want %v", next, want)
+	}
+
+	if _, err := MustParse("every month on the second to last friday at 09:00").ToCron(); err == nil {
+		t.Error("ToCron succeeded, want error")
+	}
+}
@@ -0,0 +1,189 @@
+package hron
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+)
+
+// Normalize returns a copy of the schedule in normal form, so that schedules with the
+// same meaning have the same ScheduleData and canonical string:
+//   - time, weekday, date, year target, exception, during, and timezone lists are sorted
+//     with duplicates removed
+//   - days of the month are merged, and runs of consecutive days become ranges
+//   - day lists naming exactly monday to friday, saturday and sunday, or all seven days
+//     become weekday, weekend, or every day (no filter on an interval repeat)
+//   - every week on listed days becomes a day repeat on those days
+//   - plus and minus clauses use the largest whole unit (plus 60 min is plus 1 hour)
+//
+// The input is not modified.
+func Normalize(schedule *ScheduleData) *ScheduleData {
+	out := *schedule
+	expr := schedule.Expr
+	if expr.Kind == ScheduleExprKindWeek && expr.Interval == 1 && schedule.Alignment == AlignmentDefault {
+		expr = NewDayRepeat(1, NewDayFilterDays(expr.WeekDays), expr.Times)
+	}
+	out.Expr = normalizeExpr(expr)
+	out.Offset, _ = offsetOf(schedule.Offset.Duration())
+	out.Except = sortedUnique(schedule.Except, compareExceptions)
+	out.During = sortedUnique(schedule.During, cmp.Compare)
+	out.DuringQuarters = sortedUnique(schedule.DuringQuarters, cmp.Compare)
+	out.DuringHalves = sortedUnique(schedule.DuringHalves, cmp.Compare)
+	if zones := sortedUnique(schedule.Timezones, cmp.Compare); zones != nil {
+		out.Timezone, out.Timezones = zones[0], zones
+	}
+	return &out
+}
+
+// WithNormalize makes ParseSchedule and ParseWithWarnings return the schedule in normal
+// form, so its canonical string is the same for every spelling of the schedule and a
+// stored string changes only when the meaning does.
+func WithNormalize() ParseOption {
+	return func(c *parseConfig) { c.normalize = true }
+}
+
+// Normalize returns a copy of the schedule in normal form; see the Normalize function.
+// The holiday calendar and timezone binding are kept.
+func (s *Schedule) Normalize() *Schedule {
+	c := *s
+	c.data = Normalize(s.data)
+	return c.withPlan()
+}
+
+// Equal reports whether two schedules have the same meaning: whether their expressions
+// are the same after Normalize. Attached holiday calendars and timezone bindings are not
+// compared.
+func Equal(a, b *Schedule) bool {
+	return Display(Normalize(a.data)) == Display(Normalize(b.data))
+}
+
+// Fingerprint returns a stable hash of the normalized schedule as 64 hex digits. Equal
+// schedules have the same fingerprint, so it can key a schedule store for deduplication
+// and change detection. Fingerprints only change when the canonical grammar does.
+func (s *Schedule) Fingerprint() string {
+	sum := sha256.Sum256([]byte(Display(Normalize(s.data))))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeExpr(expr ScheduleExpr) ScheduleExpr {
+	expr.Times = sortedUnique(expr.Times, compareTimes)
+	expr.WeekDays = sortedUnique(expr.WeekDays, cmp.Compare)
+	if targets := sortedUnique(expr.YearTargets, compareYearTargets); targets != nil {
+		expr = NewYearRepeatTargets(expr.Interval, targets, expr.Times)
+	}
+	if dates := sortedUnique(expr.DateSpecs, compareDateSpecs); dates != nil {
+		expr = NewSingleDatesExpr(dates, expr.Times)
+	}
+	expr.Days = normalizeDayFilter(expr.Days)
+	if expr.DayFilter != nil {
+		// An interval filter matching every day is the same as no filter
+		if f := normalizeDayFilter(*expr.DayFilter); f.Kind == DayFilterKindEvery {
+			expr.DayFilter = nil
+		} else {
+			expr.DayFilter = &f
+		}
+	}
+	switch expr.MonthTarget.Kind {
+	case MonthTargetKindDays:
+		expr.MonthTarget.Specs = normalizeDaySpecs(expr.MonthTarget.Specs)
+	case MonthTargetKindWeekOfMonth:
+		expr.MonthTarget.WeekDays = sortedUnique(expr.MonthTarget.WeekDays, cmp.Compare)
+	}
+	return expr
+}
+
+func normalizeDayFilter(f DayFilter) DayFilter {
+	if f.Kind != DayFilterKindDays {
+		return f
+	}
+	days := sortedUnique(f.Days, cmp.Compare)
+	switch {
+	case len(days) == 7:
+		return NewDayFilterEvery()
+	case slices.Equal(days, []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday}):
+		return NewDayFilterWeekday()
+	case slices.Equal(days, []Weekday{Saturday, Sunday}):
+		return NewDayFilterWeekend()
+	}
+	return NewDayFilterDays(days)
+}
+
+// normalizeDaySpecs merges single days and ranges into the fewest specs, writing runs of
+// two or more consecutive days as a range.
+func normalizeDaySpecs(specs []DayOfMonthSpec) []DayOfMonthSpec {
+	var present [32]bool
+	for _, spec := range specs {
+		if spec.Kind == DayOfMonthSpecKindRange {
+			for d := spec.Start; d <= spec.End && d <= 31; d++ {
+				present[d] = true
+			}
+		} else if spec.Day >= 1 && spec.Day <= 31 {
+			present[spec.Day] = true
+		}
+	}
+	var out []DayOfMonthSpec
+	for d := 1; d <= 31; d++ {
+		if !present[d] {
+			continue
+		}
+		end := d
+		for end+1 <= 31 && present[end+1] {
+			end++
+		}
+		if end > d {
+			out = append(out, NewDayRange(d, end))
+		} else {
+			out = append(out, NewSingleDay(d))
+		}
+		d = end
+	}
+	return out
+}
+
+func compareTimes(a, b TimeOfDay) int {
+	return cmp.Or(cmp.Compare(a.Hour, b.Hour), cmp.Compare(a.Minute, b.Minute))
+}
+
+// compareExceptions orders named dates by month and day, then ISO dates, then holidays.
+func compareExceptions(a, b ExceptionSpec) int {
+	return cmp.Or(
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Month, b.Month),
+		cmp.Compare(a.Day, b.Day),
+		cmp.Compare(a.Date, b.Date),
+	)
+}
+
+// compareYearTargets orders year targets by month, then by kind and day within it.
+func compareYearTargets(a, b YearTarget) int {
+	return cmp.Or(
+		cmp.Compare(a.Month, b.Month),
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Day, b.Day),
+		cmp.Compare(a.Ordinal, b.Ordinal),
+		cmp.Compare(a.Weekday, b.Weekday),
+	)
+}
+
+// compareDateSpecs orders named dates by month and day, then ISO dates, then events and
+// relative dates by their canonical form.
+func compareDateSpecs(a, b DateSpec) int {
+	return cmp.Or(
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Date, b.Date),
+		cmp.Compare(a.Month, b.Month),
+		cmp.Compare(a.Day, b.Day),
+		cmp.Compare(displayDateSpec(a), displayDateSpec(b)),
+	)
+}
+
+// sortedUnique returns a sorted copy of items without duplicates; nil stays nil.
+func sortedUnique[T any](items []T, compare func(a, b T) int) []T {
+	if items == nil {
+		return nil
+	}
+	out := slices.Clone(items)
+	slices.SortFunc(out, compare)
+	return slices.CompactFunc(out, func(a, b T) bool { return compare(a, b) == 0 })
+}
@@ -0,0 +1,87 @@
+package hron
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every day at 17:00, 09:00, 09:00", "every day at 09:00, 17:00"},
+		{"every friday, monday, tuesday, wednesday, thursday at 09:00", "every weekday at 09:00"},
+		{"every sunday, saturday at 10:00", "every weekend at 10:00"},
+		{"every monday to sunday at 09:00", "every day at 09:00"},
+		{"every wednesday, monday, wednesday at 09:00", "every monday, wednesday at 09:00"},
+		{"every 2 weeks on friday, monday at 09:00", "every 2 weeks on monday, friday at 09:00"},
+		{"every week on thursday, monday at 09:00", "every monday, thursday at 09:00"},
+		{"every week on weekdays at 09:00", "every weekday at 09:00"},
+		{"every 30 min from 09:00 to 17:00 on monday to sunday", "every 30 min from 09:00 to 17:00"},
+		{"every 30 min from 09:00 to 17:00 on sunday, saturday", "every 30 min from 09:00 to 17:00 on weekend"},
+		{"every month on the 3rd, 1st, 2nd, 15th at 09:00", "every month on the 1st to 3rd, 15th at 09:00"},
+		{"every month on the 1st to 5th, 3rd to 10th, 11th at 09:00", "every month on the 1st to 11th at 09:00"},
+		{"every month in the second week on friday, monday at 09:00", "every month in the second week on monday, friday at 09:00"},
+		{"every day at 09:00 except dec 25, 2026-01-05, jan 1, dec 25", "every day at 09:00 except jan 1, dec 25, 2026-01-05"},
+		{"every day at 09:00 during jul, jan, jul", "every day at 09:00 during jan, jul"},
+		{"every day at 09:00 during q3, q1", "every day at 09:00 during q1, q3"},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.input)
+		got := s.Normalize()
+		if got.String() != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.input, got.String(), tt.want)
+		}
+		if err := CheckRoundtrip(got.Data()); err != nil {
+			t.Errorf("Normalize(%q) does not roundtrip: %v", tt.input, err)
+		}
+		if s.String() != MustParse(tt.input).String() {
+			t.Errorf("Normalize(%q) modified the input schedule", tt.input)
+		}
+	}
+}
+
+// Schedules with the same meaning normalize to the same canonical string.
+func TestNormalizeEquivalent(t *testing.T) {
+	pairs := [][2]string{
+		{"every monday to friday at 9:00", "every weekday at 09:00"},
+		{"every month on the 1st, 2nd, 3rd at 09:00", "every month on the 1st to 3rd at 09:00"},
+		{"every day at 9am, 5pm except dec 25, jan 1", "every day at 17:00, 09:00 except jan 1, dec 25"},
+	}
+	for _, pair := range pairs {
+		a, b := MustParse(pair[0]).Normalize(), MustParse(pair[1]).Normalize()
+		if a.String() != b.String() {
+			t.Errorf("Normalize(%q) = %q, Normalize(%q) = %q", pair[0], a, pair[1], b)
+		}
+	}
+}
+
+func TestEqualAndFingerprint(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"every monday to friday at 9:00", "every weekday at 09:00", true},
+		{"every day at 9am, 5pm", "every day at 17:00, 09:00", true},
+		{"every month on the 1st, 2nd at 09:00", "every month on the 1st to 2nd at 09:00", true},
+		{"every week on monday, thursday at 09:00", "every monday, thursday at 09:00", true},
+		{"every 2 weeks on monday at 09:00", "every monday at 09:00", false},
+		{"every weekday at 09:00", "every weekday at 09:00 in UTC", false},
+		{"every weekday at 09:00", "every weekend at 09:00", false},
+	}
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		if got := Equal(a, b); got != tt.equal {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+		if got := a.Fingerprint() == b.Fingerprint(); got != tt.equal {
+			t.Errorf("Fingerprint(%q) == Fingerprint(%q) is %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+	}
+}
+
+// Fingerprints are persisted by callers, so they must not change between releases.
+func TestFingerprintStable(t *testing.T) {
+	got := MustParse("every weekday at 09:00").Fingerprint()
+	want := "9c28739b2dc15e3402e02f1954ad472dd73cabd6240c25d604b17a33c17aa846"
+	if got != want {
+		t.Errorf("Fingerprint() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,74 @@
+package hron
+
+import (
+	"iter"
+	"time"
+)
+
+// Occurrence is an occurrence with details of how it was evaluated, for logging and
+// debugging.
+type Occurrence struct {
+	// Time is the instant of the occurrence in the schedule's timezone, or for a schedule
+	// in several zones, the first listed zone it is an occurrence in.
+	Time time.Time
+	// WallClock is the time of day the schedule asked for. It differs from the local time
+	// of Time only when DSTShifted is set or a plus or minus clause moved the occurrence.
+	// For interval repeats it is the local time of the unmoved occurrence, to the minute.
+	WallClock TimeOfDay
+	// DSTShifted reports that WallClock did not exist on that day, falling in a
+	// spring-forward gap, and Time was moved forward past the gap.
+	DSTShifted bool
+	// TimeIndex is the position of WallClock in the expression's `at` times as written,
+	// or -1 for interval repeats, which have none.
+	TimeIndex int
+}
+
+// OccurrencesDetailed returns a lazy iterator of the occurrences Occurrences yields,
+// numbered from 0 and each with its evaluation details.
+func OccurrencesDetailed(schedule *Schedule, from time.Time) iter.Seq2[int, Occurrence] {
+	return func(yield func(int, Occurrence) bool) {
+		i := 0
+		offset := schedule.data.Offset.Duration()
+		for t := range Occurrences(schedule, from) {
+			loc := schedule.occurrenceLocation(t)
+			occ := describeOccurrence(schedule.data.Expr, loc, t.Add(-offset))
+			occ.Time = t.In(loc)
+			if !yield(i, occ) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// OccurrencesDetailed returns a lazy iterator of numbered occurrences after `from` with
+// their evaluation details.
+func (s *Schedule) OccurrencesDetailed(from time.Time) iter.Seq2[int, Occurrence] {
+	return OccurrencesDetailed(s, from)
+}
+
+// describeOccurrence recovers which of the expression's times produced t. A time that
+// lands exactly on the local clock is preferred over one shifted onto it by a DST gap.
+func describeOccurrence(expr ScheduleExpr, loc *time.Location, t time.Time) Occurrence {
+	local := t.In(loc)
+	clock := TimeOfDay{Hour: local.Hour(), Minute: local.Minute()}
+	occ := Occurrence{Time: local, WallClock: clock, TimeIndex: -1}
+	if expr.Kind == ScheduleExprKindInterval {
+		return occ
+	}
+
+	day := dateOnly(local)
+	for i, tod := range expr.Times {
+		if tod == clock && atTimeOnDate(day, tod, loc).Equal(t) {
+			occ.TimeIndex = i
+			return occ
+		}
+	}
+	for i, tod := range expr.Times {
+		if atTimeOnDate(day, tod, loc).Equal(t) {
+			occ.WallClock, occ.DSTShifted, occ.TimeIndex = tod, true, i
+			return occ
+		}
+	}
+	return occ
+}
@@ -0,0 +1,41 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOccurrencesDetailed(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	s := MustParse("every day at 09:00, 02:30 in America/New_York")
+	from := time.Date(2026, 3, 7, 12, 0, 0, 0, ny)
+
+	want := []Occurrence{
+		{time.Date(2026, 3, 8, 3, 30, 0, 0, ny), TimeOfDay{2, 30}, true, 1},
+		{time.Date(2026, 3, 8, 9, 0, 0, 0, ny), TimeOfDay{9, 0}, false, 0},
+		{time.Date(2026, 3, 9, 2, 30, 0, 0, ny), TimeOfDay{2, 30}, false, 1},
+	}
+	for i, occ := range s.OccurrencesDetailed(from) {
+		if i == len(want) {
+			break
+		}
+		w := want[i]
+		if !occ.Time.Equal(w.Time) || occ.WallClock != w.WallClock || occ.DSTShifted != w.DSTShifted || occ.TimeIndex != w.TimeIndex {
+			t.Errorf("occurrence %d = %+v, want %+v", i, occ, w)
+		}
+	}
+}
+
+func TestOccurrencesDetailedInterval(t *testing.T) {
+	s := MustParse("every 30 min from 09:00 to 10:00 in UTC")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, occ := range s.OccurrencesDetailed(from) {
+		if i == 2 {
+			break
+		}
+		if want := (TimeOfDay{9, 30 * i}); occ.WallClock != want || occ.TimeIndex != -1 || occ.DSTShifted {
+			t.Errorf("occurrence %d = %+v, want wall clock %v and no time index", i, occ, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOffsetClause(t *testing.T) {
+	tests := []struct {
+		expr string
+		now  time.Time
+		next time.Time
+	}{
+		// Remind an hour before month-end close
+		{"every month on the last weekday at 17:00 minus 1 hour in UTC",
+			time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 16, 0, 0, 0, time.UTC)},
+		// A shift may cross midnight
+		{"every day at 00:30 minus 1 hour in UTC",
+			time.Date(2026, 3, 10, 23, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)},
+		{"every 30 min from 09:00 to 17:00 plus 15 min in UTC",
+			time.Date(2026, 3, 10, 17, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 17, 15, 0, 0, time.UTC)},
+		// Except applies to the unshifted occurrence: the 23:30 reminder for the 11th is skipped
+		{"every day at 00:30 minus 1 hour except 2026-03-11 in UTC",
+			time.Date(2026, 3, 10, 23, 0, 0, 0, time.UTC), time.Date(2026, 3, 11, 23, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr)
+		if got, ok := s.NextTime(tt.now); !ok || !got.Equal(tt.next) {
+			t.Errorf("%q NextTime(%v) = %v, want %v", tt.expr, tt.now, got, tt.next)
+		}
+		if got := s.PreviousFrom(tt.next.Add(time.Second)); got == nil || !got.Equal(tt.next) {
+			t.Errorf("%q PreviousFrom = %v, want %v", tt.expr, got, tt.next)
+		}
+		if !s.Matches(tt.next) {
+			t.Errorf("%q does not match %v", tt.expr, tt.next)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("%q: %v", tt.expr, err)
+		}
+	}
+}
+
+func TestOffsetClauseSyntax(t *testing.T) {
+	for _, expr := range []string{
+		"every day at 09:00 plus 1 second",
+		"every day at 09:00 minus 1 minute",
+		"every day at 09:00 plus 90 min except dec 25 in UTC",
+	} {
+		if got := MustParse(expr).String(); got != expr {
+			t.Errorf("Parse(%q).String() = %q", expr, got)
+		}
+	}
+	for _, expr := range []string{
+		"every day at 09:00 plus 0 min",
+		"every day at 09:00 minus 2 days",
+		"every day at 09:00 plus 1 hour minus 5 min",
+		"every day at 09:00 plus",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestOffsetClauseOutputs(t *testing.T) {
+	s := MustParse("every month on the last weekday at 17:00 minus 1 hour in UTC")
+	if got, want := s.Describe(), "Runs at 5:00 PM on the last weekday of each month, shifted 1 hour earlier, in UTC"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if _, err := s.ToCron(); err == nil {
+		t.Error("ToCron accepted an offset")
+	}
+	if _, warnings, err := s.ToCronApprox(); err != nil || len(warnings) != 1 || !strings.Contains(warnings[0], "minus 1 hour") {
+		t.Errorf("ToCronApprox warnings = %v, %v", warnings, err)
+	}
+	if got := MustParse("every day at 09:00 plus 60 min").Normalize().String(); got != "every day at 09:00 plus 1 hour" {
+		t.Errorf("Normalize() = %q, want plus 1 hour", got)
+	}
+	start, _ := MustParse("every day at 09:00 minus 10 hours starting 2026-03-10 in UTC").Starts()
+	if want := time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("Starts() = %v, want %v", start, want)
+	}
+}
+
+func TestShifted(t *testing.T) {
+	base := MustParse("every month on the last weekday at 17:00 in UTC")
+	s, err := base.Shifted(-30 * time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.String(), "every month on the last weekday at 17:00 minus 30 min in UTC"; got != want {
+		t.Errorf("Shifted(-30m) = %q, want %q", got, want)
+	}
+	// Shifts add up
+	if s, _ = s.Shifted(-30 * time.Minute); s.String() != "every month on the last weekday at 17:00 minus 1 hour in UTC" {
+		t.Errorf("Shifted twice = %q, want minus 1 hour", s.String())
+	}
+	if s, _ = s.Shifted(time.Hour); s.String() != base.String() {
+		t.Errorf("Shifted back = %q, want %q", s.String(), base.String())
+	}
+	if _, err := base.Shifted(1500 * time.Millisecond); err == nil {
+		t.Error("Shifted accepted a fraction of a second")
+	}
+}
@@ -0,0 +1,61 @@
+package hron
+
+import "testing"
+
+func TestParseAll(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"every day at 09:00 except dec 25", nil},
+		{"evry day at 9:00 excpt dec 25", []string{"unknown keyword 'evry'", "unknown keyword 'excpt'"}},
+		{"every day 9:00 except dec 32 in", []string{
+			"expected 'at'",
+			"invalid day number 32 (must be 1-31)",
+			"expected timezone after 'in'",
+		}},
+		{"every day at 9:00 in UTC extra", []string{"unknown keyword 'extra'"}},
+		{"every day at 9:00 aligned to month start until 2026-13-01", []string{
+			"'aligned to' requires a day, week, month, or year interval greater than 1",
+			"invalid date: 2026-13-01",
+		}},
+		{"", []string{"empty expression"}},
+	}
+	for _, tt := range tests {
+		data, errs := ParseAll(tt.input)
+		if len(errs) != len(tt.want) {
+			t.Errorf("ParseAll(%q) returned %d errors %v, want %d", tt.input, len(errs), errs, len(tt.want))
+			continue
+		}
+		for i, err := range errs {
+			if err.Message != tt.want[i] {
+				t.Errorf("ParseAll(%q) error %d = %q, want %q", tt.input, i, err.Message, tt.want[i])
+			}
+		}
+		if (data == nil) != (len(tt.want) > 0) {
+			t.Errorf("ParseAll(%q) data = %v with %d errors", tt.input, data, len(errs))
+		}
+	}
+}
+
+// ParseAll agrees with Parse on the first error and on valid input.
+func TestParseAllMatchesParse(t *testing.T) {
+	for _, input := range []string{
+		"every weekday at 09:00 in America/New_York",
+		"every month on the 1st at 9:00",
+		"every 2 weeks on monday at 09:00 starting 2026-13-01",
+		"on feb 30 at 09:00",
+	} {
+		want, wantErr := Parse(input)
+		got, errs := ParseAll(input)
+		if wantErr == nil {
+			if len(errs) != 0 || Display(got) != Display(want) {
+				t.Errorf("ParseAll(%q) = %v, %v; want %q", input, got, errs, Display(want))
+			}
+			continue
+		}
+		if len(errs) == 0 || errs[0].Message != wantErr.(*HronError).Message {
+			t.Errorf("ParseAll(%q) errors = %v, want first %q", input, errs, wantErr)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package hron
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -10,15 +12,97 @@ type parser struct {
 	tokens []Token
 	pos    int
 	input  string
+	errs   *[]*HronError // Collects errors and resumes at the next clause when set (ParseAll)
+
+	// solar is the solar event an `at` list named instead of times, and solarOffset the
+	// shift written before it (30 min before sunset), applied as the offset clause.
+	solar       SolarEvent
+	solarOffset Offset
 }
 
-// Parse parses an hron expression string into a ScheduleData.
+// Parse parses an hron expression string into a ScheduleData. Lex and parse errors carry
+// a Suggestion with a corrected expression when a small repair makes the input parse.
 func Parse(input string) (*ScheduleData, error) {
+	if cache := parseCache.Load(); cache != nil {
+		return cachedParse(cache, input)
+	}
+	data, err := parseInput(input)
+	if err != nil {
+		return nil, withSuggestion(input, err)
+	}
+	return data, nil
+}
+
+// ParseAll parses input like Parse but reports every error it finds rather than stopping
+// at the first, for editors that show all problems at once. After an error the lexer
+// resumes at the next word and the parser at the next trailing clause, so mistakes
+// that follow an earlier one in the same clause are not reported. The schedule is nil
+// whenever errors are returned.
+func ParseAll(input string) (*ScheduleData, []*HronError) {
+	var errs []*HronError
+	tokens, _ := (&lexer{input: input, errs: &errs}).tokenize()
+	return parseCollecting(tokens, errs, input)
+}
+
+// parseCollecting parses tokens lexed from input in error-collecting mode, following the
+// lex errors errs, as ParseAll does.
+func parseCollecting(tokens []Token, errs []*HronError, input string) (*ScheduleData, []*HronError) {
+	if len(tokens) == 0 && len(errs) == 0 {
+		return nil, []*HronError{ParseError("empty expression", Span{0, 0}, input, "")}
+	}
+
+	p := &parser{tokens: tokens, input: input, errs: &errs}
+	schedule, err := p.parseExpression()
+	if err == nil && p.peek() != nil {
+		errs = append(errs, ParseError("unexpected tokens after expression", p.currentSpan(), input, ""))
+	}
+	if len(errs) > 0 {
+		return nil, dropCascadingErrors(errs, tokens, len(input))
+	}
+	return schedule, nil
+}
+
+// dropCascadingErrors removes parse errors caused by a word the lexer already rejected:
+// those with no lexed token between them and a lex error. The rest are ordered by
+// position.
+func dropCascadingErrors(errs []*HronError, tokens []Token, inputLen int) []*HronError {
+	var out []*HronError
+	for _, e := range errs {
+		if e.Kind != ErrorKindParse || !followsLexError(e, errs, tokens, inputLen) {
+			out = append(out, e)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b *HronError) int { return a.Span.Start - b.Span.Start })
+	return out
+}
+
+func followsLexError(e *HronError, errs []*HronError, tokens []Token, inputLen int) bool {
+	next := inputLen
+	for _, tok := range tokens {
+		if tok.Span.Start >= e.Span.End {
+			next = tok.Span.Start
+			break
+		}
+	}
+	for _, lex := range errs {
+		if lex.Kind == ErrorKindLex && lex.Span.Start >= e.Span.Start && lex.Span.Start <= next {
+			return true
+		}
+	}
+	return false
+}
+
+// parseInput parses input without computing suggestions.
+func parseInput(input string) (*ScheduleData, error) {
 	tokens, err := Tokenize(input)
 	if err != nil {
 		return nil, err
 	}
+	return parseTokens(tokens, input)
+}
 
+// parseTokens parses the tokens lexed from input into a schedule.
+func parseTokens(tokens []Token, input string) (*ScheduleData, error) {
 	if len(tokens) == 0 {
 		return nil, ParseError("empty expression", Span{0, 0}, input, "")
 	}
@@ -51,6 +135,13 @@ func (p *parser) peekKind() TokenKind {
 	return -1
 }
 
+func (p *parser) peekKindAt(offset int) TokenKind {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset].Kind
+	}
+	return -1
+}
+
 func (p *parser) advance() *Token {
 	tok := p.peek()
 	if tok != nil {
@@ -113,76 +204,261 @@ func (p *parser) parseExpression() (*ScheduleData, error) {
 	case TokenOn:
 		p.advance()
 		expr, err = p.parseOn()
+	case TokenOrdinal, TokenLast:
+		expr, err = p.parseOrdinalOfEvery()
+	case TokenOne:
+		p.advance()
+		expr, err = p.parseRandomPick()
 	default:
-		return nil, p.error("expected 'every' or 'on'", span)
+		err = p.error("expected 'every', 'on', 'one random', or an ordinal weekday", span)
 	}
 
-	if err != nil {
+	if err != nil && !p.skipToClause(err) {
 		return nil, err
 	}
+	if p.solar != 0 {
+		expr.Times, expr.Solar = nil, p.solar
+	}
 
 	return p.parseTrailingClauses(expr)
 }
 
 func (p *parser) parseTrailingClauses(expr ScheduleExpr) (*ScheduleData, error) {
 	schedule := NewScheduleData(expr)
-
-	// except
-	if p.peekKind() == TokenExcept {
+	schedule.Offset = p.solarOffset
+
+	clauses := []struct {
+		kind  TokenKind
+		parse func(*ScheduleData) error
+	}{
+		{TokenPlus, p.parseOffsetClause(1)},
+		{TokenMinus, p.parseOffsetClause(-1)},
+		{TokenAligned, p.parseAlignedClause},
+		{TokenExcept, p.parseExceptClause},
+		{TokenUntil, p.parseUntilClause},
+		{TokenStarting, p.parseStartingClause},
+		{TokenDuring, p.parseDuringList},
+		{TokenIn, p.parseTimezoneClause},
+	}
+	for _, clause := range clauses {
+		if p.peekKind() != clause.kind {
+			continue
+		}
 		p.advance()
-		exceptions, err := p.parseExceptionList()
-		if err != nil {
+		if err := clause.parse(schedule); err != nil && !p.skipToClause(err) {
 			return nil, err
 		}
-		schedule.Except = exceptions
 	}
 
-	// until
-	if p.peekKind() == TokenUntil {
+	return schedule, nil
+}
+
+// skipToClause returns false unless the parser is collecting errors. When collecting, it
+// records err and skips to the next trailing clause keyword so parsing can resume there.
+func (p *parser) skipToClause(err error) bool {
+	herr, ok := err.(*HronError)
+	if p.errs == nil || !ok {
+		return false
+	}
+	*p.errs = append(*p.errs, herr)
+	for p.peek() != nil {
+		switch p.peekKind() {
+		case TokenPlus, TokenMinus, TokenAligned, TokenExcept, TokenUntil, TokenStarting, TokenDuring, TokenIn:
+			return true
+		}
 		p.advance()
-		until, err := p.parseUntilSpec()
+	}
+	return true
+}
+
+// parseOffsetClause returns the parser of a plus (sign 1) or minus (sign -1) clause.
+func (p *parser) parseOffsetClause(sign int) func(*ScheduleData) error {
+	return func(schedule *ScheduleData) error {
+		if schedule.Offset != (Offset{}) {
+			return p.error("only one plus or minus clause is allowed", p.tokens[p.pos-1].Span)
+		}
+		numTok, err := p.consume("number", TokenNumber)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		schedule.Until = &until
+		if numTok.NumberVal < 1 {
+			return p.error("offset must be at least 1", numTok.Span)
+		}
+		unit, ok := p.peekIntervalUnit()
+		if !ok {
+			return p.error("expected 'min', 'hours', or 'seconds' after number", p.currentSpan())
+		}
+		p.advance()
+		schedule.Offset = NewOffset(sign*numTok.NumberVal, unit)
+		return nil
 	}
+}
 
-	// starting
-	if p.peekKind() == TokenStarting {
-		p.advance()
-		if p.peekKind() == TokenISODate {
-			if err := p.validateIsoDate(p.peek().ISODateVal); err != nil {
-				return nil, err
-			}
-			schedule.Anchor = p.peek().ISODateVal
-			p.advance()
-		} else {
-			return nil, p.error("expected ISO date (YYYY-MM-DD) after 'starting'", p.currentSpan())
-		}
+func (p *parser) parseAlignedClause(schedule *ScheduleData) error {
+	alignSpan := p.tokens[p.pos-1].Span
+	alignment, err := p.parseAlignment()
+	if err != nil {
+		return err
+	}
+	if err := p.validateAlignment(schedule.Expr, alignment, alignSpan); err != nil {
+		return err
+	}
+	schedule.Alignment = alignment
+	return nil
+}
+
+func (p *parser) parseExceptClause(schedule *ScheduleData) error {
+	exceptions, err := p.parseExceptionList()
+	if err != nil {
+		return err
+	}
+	schedule.Except = exceptions
+	return nil
+}
+
+func (p *parser) parseUntilClause(schedule *ScheduleData) error {
+	until, err := p.parseUntilSpec()
+	if err != nil {
+		return err
+	}
+	schedule.Until = &until
+	return nil
+}
+
+func (p *parser) parseStartingClause(schedule *ScheduleData) error {
+	if relative, ok, err := p.parseRelativeAnchor(); ok || err != nil {
+		schedule.AnchorRelative = &relative
+		return err
+	}
+	if p.peekKind() != TokenISODate {
+		return p.error("expected ISO date (YYYY-MM-DD) or relative date after 'starting'", p.currentSpan())
+	}
+	if err := p.validateIsoDate(p.peek().ISODateVal); err != nil {
+		return err
+	}
+	schedule.Anchor = p.peek().ISODateVal
+	p.advance()
+	return nil
+}
+
+// parseTimezoneClause parses the zone of an in clause, or a comma-separated list of
+// zones the schedule runs in each of.
+func (p *parser) parseTimezoneClause(schedule *ScheduleData) error {
+	if p.peekKind() != TokenTimezone {
+		return p.error("expected timezone after 'in'", p.currentSpan())
+	}
+	zone, err := p.parseTimezone()
+	if err != nil {
+		return err
+	}
+	schedule.Timezone = zone
+	if p.peekKind() != TokenComma {
+		return nil
 	}
 
-	// during
-	if p.peekKind() == TokenDuring {
+	zones := []string{schedule.Timezone}
+	for p.peekKind() == TokenComma {
 		p.advance()
-		months, err := p.parseMonthList()
+		if p.peekKind() != TokenTimezone {
+			return p.error("expected timezone after ','", p.currentSpan())
+		}
+		span := p.currentSpan()
+		zone, err := p.parseTimezone()
 		if err != nil {
-			return nil, err
+			return err
 		}
-		schedule.During = months
+		if slices.Contains(zones, zone) {
+			return p.error(fmt.Sprintf("timezone %s is listed twice", zone), span)
+		}
+		zones = append(zones, zone)
+	}
+	if slices.Contains(zones, LocalTimezone) {
+		return p.error("'local' cannot be listed with other timezones", p.currentSpan())
 	}
+	schedule.Timezones = zones
+	return nil
+}
 
-	// in <timezone>
-	if p.peekKind() == TokenIn {
+// parseTimezone reads one zone of the in clause, replacing a timezone abbreviation with
+// its IANA zone. An ambiguous abbreviation is an error naming the candidates, with the
+// first of them as the suggestion.
+func (p *parser) parseTimezone() (string, error) {
+	tok := p.advance()
+	candidates := timezoneAbbreviations[strings.ToUpper(tok.TimezoneVal)]
+	switch len(candidates) {
+	case 0:
+		return tok.TimezoneVal, nil
+	case 1:
+		return candidates[0], nil
+	}
+	quoted := make([]string, len(candidates))
+	for i, zone := range candidates {
+		quoted[i] = "'" + zone + "'"
+	}
+	last := len(quoted) - 1
+	list := quoted[0] + " or " + quoted[last]
+	if last > 1 {
+		list = strings.Join(quoted[:last], ", ") + ", or " + quoted[last]
+	}
+	suggestion := p.input[:tok.Span.Start] + candidates[0] + p.input[tok.Span.End:]
+	return "", ParseError(fmt.Sprintf("ambiguous timezone abbreviation '%s': use %s", tok.TimezoneVal, list), tok.Span, p.input, suggestion)
+}
+
+func (p *parser) parseAlignment() (AlignmentKind, error) {
+	if _, err := p.consume("'to'", TokenTo); err != nil {
+		return 0, err
+	}
+
+	switch p.peekKind() {
+	case TokenEpoch:
 		p.advance()
-		if p.peekKind() == TokenTimezone {
-			schedule.Timezone = p.peek().TimezoneVal
-			p.advance()
-		} else {
-			return nil, p.error("expected timezone after 'in'", p.currentSpan())
+		return AlignmentEpoch, nil
+	case TokenISO:
+		p.advance()
+		if _, err := p.consume("'weeks'", TokenWeeks); err != nil {
+			return 0, err
 		}
+		return AlignmentISOWeeks, nil
 	}
 
-	return schedule, nil
+	var alignment AlignmentKind
+	switch p.peekKind() {
+	case TokenWeeks:
+		alignment = AlignmentWeekStart
+	case TokenMonth:
+		alignment = AlignmentMonthStart
+	case TokenYear:
+		alignment = AlignmentYearStart
+	default:
+		return 0, p.error("expected 'epoch', 'iso weeks', 'week start', 'month start', or 'year start' after 'aligned to'", p.currentSpan())
+	}
+	p.advance()
+	if _, err := p.consume("'start'", TokenStart); err != nil {
+		return 0, err
+	}
+	return alignment, nil
+}
+
+// validateAlignment rejects alignment modes that have no meaning for the expression.
+func (p *parser) validateAlignment(expr ScheduleExpr, alignment AlignmentKind, span Span) error {
+	span.End = p.currentSpan().Start
+	if expr.Kind == ScheduleExprKindInterval || expr.Kind == ScheduleExprKindSingleDate || expr.Interval <= 1 {
+		return p.error("'aligned to' requires a day, week, month, or year interval greater than 1", span)
+	}
+
+	valid := alignment == AlignmentEpoch
+	switch expr.Kind {
+	case ScheduleExprKindDay:
+		valid = valid || alignment == AlignmentWeekStart || alignment == AlignmentMonthStart || alignment == AlignmentYearStart
+	case ScheduleExprKindWeek:
+		valid = valid || alignment == AlignmentISOWeeks
+	case ScheduleExprKindMonth:
+		valid = valid || alignment == AlignmentYearStart
+	}
+	if !valid {
+		return p.error(fmt.Sprintf("cannot align this expression to %s", alignment), span)
+	}
+	return nil
 }
 
 func (p *parser) parseExceptionList() ([]ExceptionSpec, error) {
@@ -229,12 +505,36 @@ func (p *parser) parseException() (ExceptionSpec, error) {
 			return ExceptionSpec{}, err
 		}
 		return NewNamedException(month, day), nil
+	case TokenHolidays:
+		p.advance()
+		return NewHolidaysException(), nil
 	default:
-		return ExceptionSpec{}, p.error("expected ISO date or month-day in exception", p.currentSpan())
+		return ExceptionSpec{}, p.error("expected ISO date, month-day, or 'holidays' in exception", p.currentSpan())
 	}
 }
 
+// parseUntilSpec parses an until date and its optional time of day, written after the
+// date with or without `at` (until 2026-06-30 17:00).
 func (p *parser) parseUntilSpec() (UntilSpec, error) {
+	until, err := p.parseUntilDate()
+	if err != nil {
+		return UntilSpec{}, err
+	}
+	switch p.peekKind() {
+	case TokenAt:
+		p.advance()
+	case TokenTime:
+	default:
+		return until, nil
+	}
+	t, err := p.parseTime()
+	if err != nil {
+		return UntilSpec{}, err
+	}
+	return until.At(t), nil
+}
+
+func (p *parser) parseUntilDate() (UntilSpec, error) {
 	tok := p.peek()
 	if tok == nil {
 		return UntilSpec{}, p.errorAtEnd("expected until date")
@@ -260,8 +560,56 @@ func (p *parser) parseUntilSpec() (UntilSpec, error) {
 		}
 		return NewNamedUntil(month, day), nil
 	default:
-		return UntilSpec{}, p.error("expected ISO date or month-day after 'until'", p.currentSpan())
+		if relative, ok, err := p.parseRelativeAnchor(); ok || err != nil {
+			return NewRelativeUntil(relative), err
+		}
+		return UntilSpec{}, p.error("expected ISO date, month-day, or relative date after 'until'", p.currentSpan())
+	}
+}
+
+// parseRelativeAnchor parses today, tomorrow, next <weekday>, next week/month/year, or
+// end of week/month/year. It reports false without consuming anything at other tokens.
+func (p *parser) parseRelativeAnchor() (RelativeAnchor, bool, error) {
+	switch p.peekKind() {
+	case TokenToday:
+		p.advance()
+		return RelativeAnchor{Kind: RelativeAnchorToday}, true, nil
+	case TokenTomorrow:
+		p.advance()
+		return RelativeAnchor{Kind: RelativeAnchorTomorrow}, true, nil
+	case TokenNext:
+		p.advance()
+		if tok := p.peek(); tok != nil && tok.Kind == TokenDayName {
+			p.advance()
+			return RelativeAnchor{Kind: RelativeAnchorNextWeekday, Weekday: tok.DayNameVal}, true, nil
+		}
+		period, err := p.parseAnchorPeriod("expected weekday, week, month, or year after 'next'")
+		return RelativeAnchor{Kind: RelativeAnchorNextPeriod, Period: period}, true, err
+	case TokenEnd:
+		p.advance()
+		if _, err := p.consume("'of'", TokenOf); err != nil {
+			return RelativeAnchor{}, true, err
+		}
+		period, err := p.parseAnchorPeriod("expected week, month, or year after 'end of'")
+		return RelativeAnchor{Kind: RelativeAnchorEndOfPeriod, Period: period}, true, err
+	}
+	return RelativeAnchor{}, false, nil
+}
+
+func (p *parser) parseAnchorPeriod(msg string) (AnchorPeriod, error) {
+	var period AnchorPeriod
+	switch p.peekKind() {
+	case TokenWeeks:
+		period = AnchorPeriodWeek
+	case TokenMonth:
+		period = AnchorPeriodMonth
+	case TokenYear:
+		period = AnchorPeriodYear
+	default:
+		return 0, p.error(msg, p.currentSpan())
 	}
+	p.advance()
+	return period, nil
 }
 
 func (p *parser) validateDayNumber(n int) error {
@@ -318,6 +666,9 @@ func (p *parser) parseEvery() (ScheduleExpr, error) {
 	case TokenYear:
 		p.advance()
 		return p.parseYearRepeat(1)
+	case TokenMonthName:
+		// every jan 15 and jul 15 is a year repeat without `year on`
+		return p.parseYearTargets(1)
 	case TokenDay:
 		return p.parseDayRepeat(1, NewDayFilterEvery())
 	case TokenWeekday:
@@ -338,8 +689,26 @@ func (p *parser) parseEvery() (ScheduleExpr, error) {
 	case TokenMonth:
 		p.advance()
 		return p.parseMonthRepeat(1)
+	case TokenQuarter:
+		p.advance()
+		return p.parsePeriodMonthRepeat(1, MonthPeriodQuarter)
+	case TokenFiscal:
+		p.advance()
+		return p.parseFiscalRepeat()
+	case TokenHalf:
+		p.advance()
+		if _, err := p.consume("'year'", TokenYear); err != nil {
+			return ScheduleExpr{}, err
+		}
+		return p.parsePeriodMonthRepeat(1, MonthPeriodHalfYear)
 	case TokenNumber:
 		return p.parseNumberRepeat()
+	case TokenOther:
+		// "every other week" is sugar for "every 2 weeks"
+		p.advance()
+		return p.parseCountedRepeat(2)
+	case TokenIntervalUnit:
+		return p.parseIntervalRepeat(1)
 	default:
 		return ScheduleExpr{}, p.error(
 			"expected day, weekday, weekend, year, day name, month, or number after 'every'",
@@ -357,13 +726,71 @@ func (p *parser) parseDayRepeat(interval int, days DayFilter) (ScheduleExpr, err
 	if _, err := p.consume("'at'", TokenAt); err != nil {
 		return ScheduleExpr{}, err
 	}
-	times, err := p.parseTimeList()
+	if p.peekSolarTime() {
+		return NewDayRepeat(interval, days, []TimeOfDay{{}}), p.parseSolarTime()
+	}
+	windowStart := p.currentSpan().Start
+	first, err := p.parseTime()
 	if err != nil {
 		return ScheduleExpr{}, err
 	}
+	if p.peekKind() == TokenTo {
+		return p.parseInlineWindow(interval, days, first, windowStart)
+	}
+	times := []TimeOfDay{first}
+	for p.peekKind() == TokenComma {
+		p.advance()
+		t, err := p.parseTime()
+		if err != nil {
+			return ScheduleExpr{}, err
+		}
+		times = append(times, t)
+	}
 	return NewDayRepeat(interval, days, times), nil
 }
 
+// parseInlineWindow parses `to 17:00 every 30 min` after "every weekday at 09:00" and
+// desugars it into an interval repeat restricted to the day filter.
+func (p *parser) parseInlineWindow(dayInterval int, days DayFilter, fromTime TimeOfDay, windowStart int) (ScheduleExpr, error) {
+	if dayInterval > 1 {
+		return ScheduleExpr{}, p.error("a time window with 'every' steps requires a daily repeat, not every N days", p.currentSpan())
+	}
+	p.advance() // 'to'
+	toTime, overnight, err := p.parseWindowEnd()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	windowSpan := Span{windowStart, p.tokens[p.pos-1].Span.End}
+
+	if _, err := p.consume("'every'", TokenEvery); err != nil {
+		return ScheduleExpr{}, err
+	}
+	interval := 1
+	if p.peekKind() == TokenNumber {
+		interval = p.peek().NumberVal
+		if interval == 0 {
+			return ScheduleExpr{}, p.error("interval must be at least 1", p.currentSpan())
+		}
+		p.advance()
+	}
+	unit, ok := p.peekIntervalUnit()
+	if !ok {
+		return ScheduleExpr{}, p.error("expected 'seconds', 'min', 'minutes', 'hour', or 'hours' after 'every'", p.currentSpan())
+	}
+	p.advance()
+	if err := p.validateIntervalWindow(fromTime, toTime, overnight, windowSpan); err != nil {
+		return ScheduleExpr{}, err
+	}
+
+	var dayFilter *DayFilter
+	if days.Kind != DayFilterKindEvery {
+		dayFilter = &days
+	}
+	expr := NewIntervalRepeat(interval, unit, fromTime, toTime, dayFilter)
+	expr.Overnight = overnight
+	return expr, nil
+}
+
 func (p *parser) parseNumberRepeat() (ScheduleExpr, error) {
 	span := p.currentSpan()
 	tok := p.peek()
@@ -372,18 +799,27 @@ func (p *parser) parseNumberRepeat() (ScheduleExpr, error) {
 		return ScheduleExpr{}, p.error("interval must be at least 1", span)
 	}
 	p.advance()
+	return p.parseCountedRepeat(num)
+}
+
+// parseCountedRepeat parses the unit and body of "every N <unit>" once N is known.
+func (p *parser) parseCountedRepeat(num int) (ScheduleExpr, error) {
+	if _, ok := p.peekIntervalUnit(); ok {
+		return p.parseIntervalRepeat(num)
+	}
 
 	switch p.peekKind() {
 	case TokenWeeks:
 		p.advance()
 		return p.parseWeekRepeat(num)
-	case TokenIntervalUnit:
-		return p.parseIntervalRepeat(num)
 	case TokenDay:
 		return p.parseDayRepeat(num, NewDayFilterEvery())
 	case TokenMonth:
 		p.advance()
 		return p.parseMonthRepeat(num)
+	case TokenQuarter:
+		p.advance()
+		return p.parsePeriodMonthRepeat(num, MonthPeriodQuarter)
 	case TokenYear:
 		p.advance()
 		return p.parseYearRepeat(num)
@@ -395,23 +831,51 @@ func (p *parser) parseNumberRepeat() (ScheduleExpr, error) {
 	}
 }
 
-func (p *parser) parseIntervalRepeat(interval int) (ScheduleExpr, error) {
+// peekIntervalUnit reports the interval unit at the current token. "second" lexes as an
+// ordinal, so it is accepted here as the singular of "seconds".
+func (p *parser) peekIntervalUnit() (IntervalUnit, bool) {
 	tok := p.peek()
-	unit := tok.UnitVal
+	switch {
+	case tok == nil:
+		return 0, false
+	case tok.Kind == TokenIntervalUnit:
+		return tok.UnitVal, true
+	case tok.Kind == TokenOrdinal && tok.OrdinalVal == Second:
+		return IntervalSeconds, true
+	}
+	return 0, false
+}
+
+func (p *parser) parseIntervalRepeat(interval int) (ScheduleExpr, error) {
+	unit, _ := p.peekIntervalUnit()
 	p.advance()
 
-	if _, err := p.consume("'from'", TokenFrom); err != nil {
-		return ScheduleExpr{}, err
-	}
-	fromTime, err := p.parseTime()
-	if err != nil {
-		return ScheduleExpr{}, err
-	}
-	if _, err := p.consume("'to'", TokenTo); err != nil {
-		return ScheduleExpr{}, err
+	// Without `to` the window runs to the end of the day, and without `from` it is the
+	// whole day; either way the canonical form spells the window out. `starting at` is
+	// another way to write `from`.
+	fromTime, toTime := TimeOfDay{0, 0}, TimeOfDay{23, 59}
+	overnight := false
+	windowSpan := p.tokens[p.pos-1].Span
+	startingAt := p.peekKind() == TokenStarting && p.peekKindAt(1) == TokenAt
+	if p.peekKind() == TokenFrom || startingAt {
+		if startingAt {
+			p.advance()
+		}
+		p.advance()
+		windowStart := p.currentSpan().Start
+		var err error
+		if fromTime, err = p.parseTime(); err != nil {
+			return ScheduleExpr{}, err
+		}
+		if p.peekKind() == TokenTo {
+			p.advance()
+			if toTime, overnight, err = p.parseWindowEnd(); err != nil {
+				return ScheduleExpr{}, err
+			}
+		}
+		windowSpan = Span{windowStart, p.tokens[p.pos-1].Span.End}
 	}
-	toTime, err := p.parseTime()
-	if err != nil {
+	if err := p.validateIntervalWindow(fromTime, toTime, overnight, windowSpan); err != nil {
 		return ScheduleExpr{}, err
 	}
 
@@ -425,17 +889,58 @@ func (p *parser) parseIntervalRepeat(interval int) (ScheduleExpr, error) {
 		dayFilter = &df
 	}
 
-	return NewIntervalRepeat(interval, unit, fromTime, toTime, dayFilter), nil
+	expr := NewIntervalRepeat(interval, unit, fromTime, toTime, dayFilter)
+	expr.Overnight = overnight
+	return expr, nil
 }
 
-func (p *parser) parseWeekRepeat(interval int) (ScheduleExpr, error) {
-	if _, err := p.consume("'on'", TokenOn); err != nil {
+// parseWindowEnd parses the end of an interval window after 'to', with an optional
+// `next day` for a window that runs past midnight.
+func (p *parser) parseWindowEnd() (TimeOfDay, bool, error) {
+	to, err := p.parseTime()
+	if err != nil {
+		return TimeOfDay{}, false, err
+	}
+	if p.peekKind() != TokenNext || p.peekKindAt(1) != TokenDay {
+		return to, false, nil
+	}
+	p.advance() // 'next'
+	p.advance() // 'day'
+	return to, true, nil
+}
+
+// validateIntervalWindow rejects a `next day` window that does not end before it starts.
+// Windows that never fire or fire only once still parse, as the spec requires of
+// contradictory schedules; ValidateDetailed reports them.
+func (p *parser) validateIntervalWindow(from, to TimeOfDay, overnight bool, span Span) error {
+	if overnight && to.TotalMinutes() >= from.TotalMinutes() {
+		return p.error(fmt.Sprintf("a 'next day' window must end before it starts (%s to %s)", from, to), span)
+	}
+	return nil
+}
+
+// parseRandomPick parses `random weekday each week at 09:00 seeded by "team-a"` after "one".
+func (p *parser) parseRandomPick() (ScheduleExpr, error) {
+	if _, err := p.consume("'random'", TokenRandom); err != nil {
 		return ScheduleExpr{}, err
 	}
-	days, err := p.parseDayList()
+	days, err := p.parseDayTarget()
 	if err != nil {
 		return ScheduleExpr{}, err
 	}
+	if _, err := p.consume("'each'", TokenEach); err != nil {
+		return ScheduleExpr{}, err
+	}
+	var period RandomPeriod
+	switch p.peekKind() {
+	case TokenWeeks:
+		period = RandomPeriodWeek
+	case TokenMonth:
+		period = RandomPeriodMonth
+	default:
+		return ScheduleExpr{}, p.error("expected 'week' or 'month'", p.currentSpan())
+	}
+	p.advance()
 	if _, err := p.consume("'at'", TokenAt); err != nil {
 		return ScheduleExpr{}, err
 	}
@@ -443,57 +948,154 @@ func (p *parser) parseWeekRepeat(interval int) (ScheduleExpr, error) {
 	if err != nil {
 		return ScheduleExpr{}, err
 	}
-	return NewWeekRepeat(interval, days, times), nil
-}
-
-func (p *parser) parseMonthRepeat(interval int) (ScheduleExpr, error) {
-	if _, err := p.consume("'on'", TokenOn); err != nil {
+	if _, err := p.consume("'seeded'", TokenSeeded); err != nil {
 		return ScheduleExpr{}, err
 	}
-	if _, err := p.consume("'the'", TokenThe); err != nil {
+	if _, err := p.consume("'by'", TokenBy); err != nil {
 		return ScheduleExpr{}, err
 	}
+	if p.peekKind() != TokenString {
+		return ScheduleExpr{}, p.error("expected quoted seed", p.currentSpan())
+	}
+	tok := p.peek()
+	if tok.StringVal == "" {
+		return ScheduleExpr{}, p.error("seed must not be empty", tok.Span)
+	}
+	p.advance()
+	return NewRandomPick(days, period, tok.StringVal, times), nil
+}
 
-	var target MonthTarget
-
+func (p *parser) parseWeekRepeat(interval int) (ScheduleExpr, error) {
+	if _, err := p.consume("'on'", TokenOn); err != nil {
+		return ScheduleExpr{}, err
+	}
+	var days []Weekday
 	switch p.peekKind() {
-	case TokenLast:
+	case TokenWeekday:
+		// Week repeats list their days, so weekday and weekend expand like day ranges
 		p.advance()
-		switch p.peekKind() {
+		days = []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday}
+	case TokenWeekend:
+		p.advance()
+		days = []Weekday{Saturday, Sunday}
+	default:
+		var err error
+		if days, err = p.parseDayList(); err != nil {
+			return ScheduleExpr{}, err
+		}
+	}
+	if _, err := p.consume("'at'", TokenAt); err != nil {
+		return ScheduleExpr{}, err
+	}
+	times, err := p.parseTimeList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	return NewWeekRepeat(interval, days, times), nil
+}
+
+// parsePeriodMonthRepeat parses a month repeat written in quarters or half years.
+func (p *parser) parsePeriodMonthRepeat(count int, period MonthPeriod) (ScheduleExpr, error) {
+	expr, err := p.parseMonthRepeat(count * period.Months())
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	expr.MonthPeriod = period
+	return expr, nil
+}
+
+// parseFiscalRepeat parses a repeat in fiscal periods after `every fiscal`. It takes the
+// targets of a month repeat that count from either end of a period.
+func (p *parser) parseFiscalRepeat() (ScheduleExpr, error) {
+	var unit FiscalUnit
+	switch p.peekKind() {
+	case TokenMonth:
+		unit = FiscalMonth
+	case TokenQuarter:
+		unit = FiscalQuarter
+	case TokenYear:
+		unit = FiscalYear
+	default:
+		return ScheduleExpr{}, p.error("expected month, quarter, or year after 'fiscal'", p.currentSpan())
+	}
+	p.advance()
+	if p.peekKind() == TokenIn {
+		return ScheduleExpr{}, p.error("weeks of a fiscal period are not supported", p.currentSpan())
+	}
+	targetSpan := p.currentSpan()
+	expr, err := p.parseMonthRepeat(1)
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	if expr.MonthTarget.Kind == MonthTargetKindNearestWeekday {
+		return ScheduleExpr{}, p.error("nearest weekdays of a fiscal period are not supported", targetSpan)
+	}
+	return NewFiscalRepeat(unit, expr.MonthTarget, expr.Times), nil
+}
+
+func (p *parser) parseMonthRepeat(interval int) (ScheduleExpr, error) {
+	if p.peekKind() == TokenIn {
+		p.advance()
+		return p.parseWeekOfMonthRepeat(interval)
+	}
+	if _, err := p.consume("'on'", TokenOn); err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'the'", TokenThe); err != nil {
+		return ScheduleExpr{}, err
+	}
+
+	var target MonthTarget
+
+	switch p.peekKind() {
+	case TokenLast:
+		switch p.peekKindAt(1) {
 		case TokenDay:
-			p.advance()
+			p.pos += 2
 			target = NewLastDayTarget()
 		case TokenWeekday:
-			p.advance()
+			p.pos += 2
 			target = NewLastWeekdayTarget()
-		case TokenDayName:
-			// "last monday" etc.
-			tok := p.peek()
-			weekday := tok.DayNameVal
-			p.advance()
-			target = NewOrdinalWeekdayTarget(Last, weekday)
+		case TokenBusiness:
+			p.pos += 2
+			if _, err := p.consume("'day'", TokenDay); err != nil {
+				return ScheduleExpr{}, err
+			}
+			target = NewLastBusinessDayTarget()
+		case TokenDayName, TokenComma:
+			// "last monday", "last, first friday" etc.
+			pairs, err := p.parseOrdinalWeekdayList()
+			if err != nil {
+				return ScheduleExpr{}, err
+			}
+			target = NewOrdinalWeekdaysTarget(pairs)
 		default:
-			return ScheduleExpr{}, p.error("expected 'day', 'weekday', or day name after 'last'", p.currentSpan())
+			p.advance()
+			return ScheduleExpr{}, p.error("expected 'day', 'weekday', 'business day', or day name after 'last'", p.currentSpan())
 		}
-	case TokenOrdinal:
-		// "first monday", "second tuesday", etc.
-		ordinal, err := p.parseOrdinalPosition()
-		if err != nil {
-			return ScheduleExpr{}, err
+	case TokenOrdinal, TokenOrdinalNumber:
+		if p.peekKindAt(1) == TokenBusiness {
+			var err error
+			target, err = p.parseBusinessDayTarget()
+			if err != nil {
+				return ScheduleExpr{}, err
+			}
+			break
 		}
-		if p.peekKind() != TokenDayName {
-			return ScheduleExpr{}, p.error("expected day name after ordinal", p.currentSpan())
+		if p.peekKind() == TokenOrdinalNumber {
+			specs, err := p.parseOrdinalDayList()
+			if err != nil {
+				return ScheduleExpr{}, err
+			}
+			target = NewDaysTarget(specs)
+			break
 		}
-		tok := p.peek()
-		weekday := tok.DayNameVal
-		p.advance()
-		target = NewOrdinalWeekdayTarget(ordinal, weekday)
-	case TokenOrdinalNumber:
-		specs, err := p.parseOrdinalDayList()
+		// "first monday", "first, third monday", "first monday, last friday", etc.
+		pairs, err := p.parseOrdinalWeekdayList()
 		if err != nil {
 			return ScheduleExpr{}, err
 		}
-		target = NewDaysTarget(specs)
+		target = NewOrdinalWeekdaysTarget(pairs)
 	case TokenNext, TokenPrevious, TokenNearest:
 		var err error
 		target, err = p.parseNearestWeekdayTarget()
@@ -517,6 +1119,123 @@ func (p *parser) parseMonthRepeat(interval int) (ScheduleExpr, error) {
 	return NewMonthRepeat(interval, target, times), nil
 }
 
+// parseBusinessDayTarget parses "3rd business day" or "first business day".
+func (p *parser) parseBusinessDayTarget() (MonthTarget, error) {
+	tok := p.peek()
+	n := tok.NumberVal
+	if tok.Kind == TokenOrdinal {
+		n = tok.OrdinalVal.ToN()
+	}
+	if n < 1 || n > 23 {
+		return MonthTarget{}, p.error(fmt.Sprintf("invalid business day %d (must be 1-23)", n), p.currentSpan())
+	}
+	p.pos += 2
+	if _, err := p.consume("'day'", TokenDay); err != nil {
+		return MonthTarget{}, err
+	}
+	return NewBusinessDayTarget(n), nil
+}
+
+// parseWeekOfMonthRepeat parses "the second week on tuesday at 10:00" after "every month in".
+func (p *parser) parseWeekOfMonthRepeat(interval int) (ScheduleExpr, error) {
+	if _, err := p.consume("'the'", TokenThe); err != nil {
+		return ScheduleExpr{}, err
+	}
+	week, err := p.parseOrdinalPosition()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'week'", TokenWeeks); err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'on'", TokenOn); err != nil {
+		return ScheduleExpr{}, err
+	}
+	days, err := p.parseDayList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'at'", TokenAt); err != nil {
+		return ScheduleExpr{}, err
+	}
+	times, err := p.parseTimeList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	return NewMonthRepeat(interval, NewWeekOfMonthTarget(week, days), times), nil
+}
+
+// parseOrdinalWeekdayList parses "first, third monday, last friday" into (ordinal, weekday) pairs.
+func (p *parser) parseOrdinalWeekdayList() ([]OrdinalWeekday, error) {
+	var pairs []OrdinalWeekday
+	for {
+		var ordinals []OrdinalPosition
+		for {
+			ordinal, err := p.parseOrdinalPosition()
+			if err != nil {
+				return nil, err
+			}
+			ordinals = append(ordinals, ordinal)
+			if p.peekKind() != TokenComma || !p.isOrdinalAt(1) {
+				break
+			}
+			p.advance()
+		}
+
+		if p.peekKind() != TokenDayName {
+			return nil, p.error("expected day name after ordinal", p.currentSpan())
+		}
+		weekday := p.advance().DayNameVal
+		for _, ordinal := range ordinals {
+			pairs = append(pairs, OrdinalWeekday{Ordinal: ordinal, Weekday: weekday})
+		}
+
+		if p.peekKind() != TokenComma || !p.isOrdinalAt(1) {
+			return pairs, nil
+		}
+		p.advance()
+	}
+}
+
+func (p *parser) isOrdinalAt(offset int) bool {
+	kind := p.peekKindAt(offset)
+	return kind == TokenOrdinal || kind == TokenLast
+}
+
+// parseOrdinalOfEvery parses "first, third monday of every month at 09:00" (sugar for a month repeat).
+func (p *parser) parseOrdinalOfEvery() (ScheduleExpr, error) {
+	pairs, err := p.parseOrdinalWeekdayList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'of'", TokenOf); err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'every'", TokenEvery); err != nil {
+		return ScheduleExpr{}, err
+	}
+
+	interval := 1
+	if p.peekKind() == TokenNumber {
+		interval = p.peek().NumberVal
+		if interval == 0 {
+			return ScheduleExpr{}, p.error("interval must be at least 1", p.currentSpan())
+		}
+		p.advance()
+	}
+	if _, err := p.consume("'month'", TokenMonth); err != nil {
+		return ScheduleExpr{}, err
+	}
+	if _, err := p.consume("'at'", TokenAt); err != nil {
+		return ScheduleExpr{}, err
+	}
+	times, err := p.parseTimeList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	return NewMonthRepeat(interval, NewOrdinalWeekdaysTarget(pairs), times), nil
+}
+
 func (p *parser) parseNearestWeekdayTarget() (MonthTarget, error) {
 	// Optional direction: "next" or "previous"
 	direction := NearestNone
@@ -556,17 +1275,40 @@ func (p *parser) parseYearRepeat(interval int) (ScheduleExpr, error) {
 	if _, err := p.consume("'on'", TokenOn); err != nil {
 		return ScheduleExpr{}, err
 	}
+	return p.parseYearTargets(interval)
+}
 
-	var target YearTarget
+// parseYearTargets parses the targets of a year repeat, separated by commas or `and`,
+// and its times.
+func (p *parser) parseYearTargets(interval int) (ScheduleExpr, error) {
+	var targets []YearTarget
+	for {
+		target, err := p.parseYearTarget()
+		if err != nil {
+			return ScheduleExpr{}, err
+		}
+		targets = append(targets, target)
+		if k := p.peekKind(); k != TokenComma && k != TokenAnd {
+			break
+		}
+		p.advance()
+	}
 
+	if _, err := p.consume("'at'", TokenAt); err != nil {
+		return ScheduleExpr{}, err
+	}
+	times, err := p.parseTimeList()
+	if err != nil {
+		return ScheduleExpr{}, err
+	}
+	return NewYearRepeatTargets(interval, targets, times), nil
+}
+
+func (p *parser) parseYearTarget() (YearTarget, error) {
 	switch p.peekKind() {
 	case TokenThe:
 		p.advance()
-		var err error
-		target, err = p.parseYearTargetAfterThe()
-		if err != nil {
-			return ScheduleExpr{}, err
-		}
+		return p.parseYearTargetAfterThe()
 	case TokenMonthName:
 		tok := p.peek()
 		month := tok.MonthNameVal
@@ -574,27 +1316,18 @@ func (p *parser) parseYearRepeat(interval int) (ScheduleExpr, error) {
 		dayPos := p.currentSpan().Start
 		day, err := p.parseDayNumber("expected day number after month name")
 		if err != nil {
-			return ScheduleExpr{}, err
+			return YearTarget{}, err
 		}
 		if err := p.validateNamedDate(month, day, dayPos); err != nil {
-			return ScheduleExpr{}, err
+			return YearTarget{}, err
 		}
-		target = NewYearDateTarget(month, day)
+		return NewYearDateTarget(month, day), nil
 	default:
-		return ScheduleExpr{}, p.error(
+		return YearTarget{}, p.error(
 			"expected month name or 'the' after 'every year on'",
 			p.currentSpan(),
 		)
 	}
-
-	if _, err := p.consume("'at'", TokenAt); err != nil {
-		return ScheduleExpr{}, err
-	}
-	times, err := p.parseTimeList()
-	if err != nil {
-		return ScheduleExpr{}, err
-	}
-	return NewYearRepeat(interval, target, times), nil
 }
 
 func (p *parser) parseYearTargetAfterThe() (YearTarget, error) {
@@ -657,6 +1390,14 @@ func (p *parser) parseYearTargetAfterThe() (YearTarget, error) {
 	case TokenOrdinalNumber:
 		tok := p.peek()
 		day := tok.NumberVal
+		if p.peekKindAt(1) == TokenDay {
+			if day < 1 || day > 366 {
+				return YearTarget{}, p.error(fmt.Sprintf("invalid day of the year %d (must be 1-366)", day), p.currentSpan())
+			}
+			p.advance()
+			p.advance()
+			return NewYearDayOfYearTarget(day), nil
+		}
 		if day < 1 || day > 31 {
 			return YearTarget{}, p.error(fmt.Sprintf("invalid day number %d (must be 1-31)", day), p.currentSpan())
 		}
@@ -697,7 +1438,15 @@ func (p *parser) parseOrdinalPosition() (OrdinalPosition, error) {
 	case TokenOrdinal:
 		tok := p.peek()
 		p.advance()
-		return tok.OrdinalVal, nil
+		if p.peekKind() != TokenTo || p.peekKindAt(1) != TokenLast {
+			return tok.OrdinalVal, nil
+		}
+		// "second to last", counted from the end of the month
+		if tok.OrdinalVal == First {
+			return 0, p.error("use 'last' instead of 'first to last'", span)
+		}
+		p.pos += 2
+		return -tok.OrdinalVal, nil
 	case TokenLast:
 		p.advance()
 		return Last, nil
@@ -706,10 +1455,20 @@ func (p *parser) parseOrdinalPosition() (OrdinalPosition, error) {
 	}
 }
 
+// parseOn parses one date or a list of dates, separated by commas or `and`, and their
+// times.
 func (p *parser) parseOn() (ScheduleExpr, error) {
-	date, err := p.parseDateTarget()
-	if err != nil {
-		return ScheduleExpr{}, err
+	var dates []DateSpec
+	for {
+		date, err := p.parseDateTarget()
+		if err != nil {
+			return ScheduleExpr{}, err
+		}
+		dates = append(dates, date)
+		if k := p.peekKind(); k != TokenComma && k != TokenAnd {
+			break
+		}
+		p.advance()
 	}
 	if _, err := p.consume("'at'", TokenAt); err != nil {
 		return ScheduleExpr{}, err
@@ -718,7 +1477,7 @@ func (p *parser) parseOn() (ScheduleExpr, error) {
 	if err != nil {
 		return ScheduleExpr{}, err
 	}
-	return NewSingleDateExpr(date, times), nil
+	return NewSingleDatesExpr(dates, times), nil
 }
 
 func (p *parser) validateIsoDate(dateStr string) error {
@@ -754,9 +1513,55 @@ func (p *parser) parseDateTarget() (DateSpec, error) {
 			return DateSpec{}, err
 		}
 		return NewNamedDate(month, day), nil
+	case TokenEvent:
+		p.advance()
+		return NewEventDate(tok.EventVal), nil
+	case TokenThe, TokenNumber:
+		return p.parseRelativeDate()
+	default:
+		return DateSpec{}, p.error("expected date (ISO date, month name, or event)", p.currentSpan())
+	}
+}
+
+// parseRelativeDate parses "the weekday before dec 25" or "2 days after easter".
+func (p *parser) parseRelativeDate() (DateSpec, error) {
+	offset := 1
+	if tok := p.peek(); tok.Kind == TokenNumber {
+		if tok.NumberVal < 1 {
+			return DateSpec{}, p.error("relative date offset must be at least 1", tok.Span)
+		}
+		offset = tok.NumberVal
+	}
+	p.advance()
+
+	var unit RelativeUnit
+	switch p.peekKind() {
+	case TokenDay:
+		unit = RelativeDays
+	case TokenWeekday:
+		unit = RelativeWeekdays
+	default:
+		return DateSpec{}, p.error("expected 'day' or 'weekday'", p.currentSpan())
+	}
+	p.advance()
+
+	switch p.peekKind() {
+	case TokenBefore:
+		offset = -offset
+	case TokenAfter:
 	default:
-		return DateSpec{}, p.error("expected date (ISO date or month name)", p.currentSpan())
+		return DateSpec{}, p.error("expected 'before' or 'after'", p.currentSpan())
+	}
+	p.advance()
+
+	if k := p.peekKind(); k == TokenThe || k == TokenNumber {
+		return DateSpec{}, p.error("relative dates cannot be nested", p.currentSpan())
 	}
+	base, err := p.parseDateTarget()
+	if err != nil {
+		return DateSpec{}, err
+	}
+	return NewRelativeDate(offset, unit, base), nil
 }
 
 func (p *parser) parseDayTarget() (DayFilter, error) {
@@ -781,27 +1586,51 @@ func (p *parser) parseDayTarget() (DayFilter, error) {
 	}
 }
 
+// parseDayList parses a comma-separated list of day names and day ranges. Ranges
+// (monday to thursday, fri to mon) expand to the explicit days they cover.
 func (p *parser) parseDayList() ([]Weekday, error) {
 	if p.peekKind() != TokenDayName {
 		return nil, p.error("expected day name", p.currentSpan())
 	}
-	tok := p.peek()
-	days := []Weekday{tok.DayNameVal}
-	p.advance()
+	days, err := p.parseDayListItem(nil)
+	if err != nil {
+		return nil, err
+	}
 
 	for p.peekKind() == TokenComma {
 		p.advance()
 		if p.peekKind() != TokenDayName {
 			return nil, p.error("expected day name after ','", p.currentSpan())
 		}
-		tok := p.peek()
-		days = append(days, tok.DayNameVal)
-		p.advance()
+		if days, err = p.parseDayListItem(days); err != nil {
+			return nil, err
+		}
 	}
 
 	return days, nil
 }
 
+// parseDayListItem appends a day name, or every day of a day range, to days.
+func (p *parser) parseDayListItem(days []Weekday) ([]Weekday, error) {
+	from := p.advance().DayNameVal
+	if p.peekKind() != TokenTo || p.peekKindAt(1) != TokenDayName {
+		return append(days, from), nil
+	}
+	p.advance()
+	span := p.currentSpan()
+	to := p.advance().DayNameVal
+	if to == from {
+		return nil, p.error("day range must end on a different day", span)
+	}
+	// Ranges wrap over the week (friday to monday)
+	for d := from; ; d = d%7 + 1 {
+		days = append(days, d)
+		if d == to {
+			return days, nil
+		}
+	}
+}
+
 func (p *parser) parseOrdinalDayList() ([]DayOfMonthSpec, error) {
 	spec, err := p.parseOrdinalDaySpec()
 	if err != nil {
@@ -852,26 +1681,156 @@ func (p *parser) parseOrdinalDaySpec() (DayOfMonthSpec, error) {
 	return NewSingleDay(start), nil
 }
 
-func (p *parser) parseMonthList() ([]MonthName, error) {
-	month, err := p.parseMonthNameToken()
+// parseDuringList parses a comma-separated list of whole months (dec), quarters (q1),
+// half years (h2), date windows (jun 15 to aug 31), ISO week ranges (weeks 10 to 20, 40),
+// and year ranges (2026 to 2028).
+func (p *parser) parseDuringList(schedule *ScheduleData) error {
+	for {
+		switch p.peekKind() {
+		case TokenWeeks:
+			p.advance()
+			for {
+				r, err := p.parseWeekRange()
+				if err != nil {
+					return err
+				}
+				schedule.DuringWeeks = append(schedule.DuringWeeks, r)
+				// Week numbers after a comma continue the week list; larger numbers are years
+				if p.peekKind() != TokenComma || p.peekKindAt(1) != TokenNumber || p.tokens[p.pos+1].NumberVal > 53 {
+					break
+				}
+				p.advance()
+			}
+		case TokenNumber:
+			r, err := p.parseYearRange()
+			if err != nil {
+				return err
+			}
+			schedule.DuringYears = append(schedule.DuringYears, r)
+		case TokenQuarterName:
+			schedule.DuringQuarters = append(schedule.DuringQuarters, p.advance().NumberVal)
+		case TokenHalfName:
+			schedule.DuringHalves = append(schedule.DuringHalves, p.advance().NumberVal)
+		default:
+			month, err := p.parseMonthNameToken()
+			if err != nil {
+				return err
+			}
+			if k := p.peekKind(); k == TokenNumber || k == TokenOrdinalNumber {
+				window, err := p.parseDateWindow(month)
+				if err != nil {
+					return err
+				}
+				schedule.DuringDates = append(schedule.DuringDates, window)
+			} else {
+				schedule.During = append(schedule.During, month)
+			}
+		}
+
+		if p.peekKind() != TokenComma {
+			return nil
+		}
+		p.advance()
+	}
+}
+
+// parseWeekRange parses an ISO week number or range (10, 10 to 20) in a during clause.
+func (p *parser) parseWeekRange() (WeekRange, error) {
+	from, err := p.parseWeekNumber()
 	if err != nil {
-		return nil, err
+		return WeekRange{}, err
+	}
+	if p.peekKind() != TokenTo {
+		return NewWeekRange(from, from), nil
 	}
-	months := []MonthName{month}
+	p.advance()
+	to, err := p.parseWeekNumber()
+	if err != nil {
+		return WeekRange{}, err
+	}
+	return NewWeekRange(from, to), nil
+}
 
-	for p.peekKind() == TokenComma {
-		p.advance()
-		month, err := p.parseMonthNameToken()
-		if err != nil {
-			return nil, err
-		}
-		months = append(months, month)
+// parseYearRange parses a year or year range (2026, 2026 to 2028) in a during clause.
+func (p *parser) parseYearRange() (YearRange, error) {
+	from, err := p.parseYearNumber()
+	if err != nil {
+		return YearRange{}, err
+	}
+	if p.peekKind() != TokenTo {
+		return NewYearRange(from, from), nil
+	}
+	p.advance()
+	span := p.currentSpan()
+	to, err := p.parseYearNumber()
+	if err != nil {
+		return YearRange{}, err
+	}
+	if to < from {
+		return YearRange{}, p.error(fmt.Sprintf("year range ends before it starts (%d to %d)", from, to), span)
+	}
+	return NewYearRange(from, to), nil
+}
+
+func (p *parser) parseYearNumber() (int, error) {
+	span := p.currentSpan()
+	if p.peekKind() != TokenNumber {
+		return 0, p.error("expected year in during", span)
+	}
+	n := p.peek().NumberVal
+	if n < 1000 || n > 9999 {
+		return 0, p.error(fmt.Sprintf("invalid year %d in during (expected four digits)", n), span)
+	}
+	p.advance()
+	return n, nil
+}
+
+func (p *parser) parseWeekNumber() (int, error) {
+	span := p.currentSpan()
+	if p.peekKind() != TokenNumber {
+		return 0, p.error("expected ISO week number after 'weeks'", span)
 	}
+	n := p.peek().NumberVal
+	if n < 1 || n > 53 {
+		return 0, p.error(fmt.Sprintf("invalid ISO week number %d (must be 1-53)", n), span)
+	}
+	p.advance()
+	return n, nil
+}
 
-	return months, nil
+// parseDateWindow parses the rest of a during window after its first month name.
+func (p *parser) parseDateWindow(fromMonth MonthName) (DateWindow, error) {
+	fromPos := p.currentSpan().Start
+	fromDay, err := p.parseDayNumber("expected day number after month name in during")
+	if err != nil {
+		return DateWindow{}, err
+	}
+	if err := p.validateNamedDate(fromMonth, fromDay, fromPos); err != nil {
+		return DateWindow{}, err
+	}
+	if _, err := p.consume("'to'", TokenTo); err != nil {
+		return DateWindow{}, err
+	}
+	toMonth, err := p.parseMonthNameToken()
+	if err != nil {
+		return DateWindow{}, err
+	}
+	toPos := p.currentSpan().Start
+	toDay, err := p.parseDayNumber("expected day number after month name in during")
+	if err != nil {
+		return DateWindow{}, err
+	}
+	if err := p.validateNamedDate(toMonth, toDay, toPos); err != nil {
+		return DateWindow{}, err
+	}
+	return NewDateWindow(fromMonth, fromDay, toMonth, toDay), nil
 }
 
 func (p *parser) parseTimeList() ([]TimeOfDay, error) {
+	if p.peekSolarTime() {
+		// A solar event stands for the whole list; the expression gets no times
+		return []TimeOfDay{{}}, p.parseSolarTime()
+	}
 	t, err := p.parseTime()
 	if err != nil {
 		return nil, err
@@ -890,10 +1849,59 @@ func (p *parser) parseTimeList() ([]TimeOfDay, error) {
 	return times, nil
 }
 
+// peekSolarTime reports whether a solar event, or the shift to one, comes next.
+func (p *parser) peekSolarTime() bool {
+	return p.peekKind() == TokenSolar || (p.peekKind() == TokenNumber && p.peekKindAt(1) == TokenIntervalUnit)
+}
+
+// parseSolarTime parses a solar event, optionally after the shift to it: sunset, or
+// 30 min before sunset.
+func (p *parser) parseSolarTime() error {
+	if numTok := p.peek(); numTok.Kind == TokenNumber {
+		p.advance()
+		if numTok.NumberVal < 1 {
+			return p.error("offset must be at least 1", numTok.Span)
+		}
+		unit, ok := p.peekIntervalUnit()
+		if !ok {
+			return p.error("expected 'min', 'hours', or 'seconds' after number", p.currentSpan())
+		}
+		p.advance()
+		var sign int
+		switch p.peekKind() {
+		case TokenBefore:
+			sign = -1
+		case TokenAfter:
+			sign = 1
+		default:
+			return p.error("expected 'before' or 'after'", p.currentSpan())
+		}
+		p.advance()
+		p.solarOffset = NewOffset(sign*numTok.NumberVal, unit)
+	}
+	tok, err := p.consume("sunrise, sunset, dawn, or dusk", TokenSolar)
+	if err != nil {
+		return err
+	}
+	p.solar = tok.SolarVal
+	return nil
+}
+
 func (p *parser) parseTime() (TimeOfDay, error) {
 	span := p.currentSpan()
+	if p.peekKind() == TokenEnd {
+		// "end of day" is the last minute of the day
+		p.advance()
+		if _, err := p.consume("'of'", TokenOf); err != nil {
+			return TimeOfDay{}, err
+		}
+		if _, err := p.consume("'day'", TokenDay); err != nil {
+			return TimeOfDay{}, err
+		}
+		return TimeOfDay{Hour: 23, Minute: 59}, nil
+	}
 	if p.peekKind() != TokenTime {
-		return TimeOfDay{}, p.error("expected time (HH:MM)", span)
+		return TimeOfDay{}, p.error("expected time (HH:MM, noon, midnight, or end of day)", span)
 	}
 	tok := p.peek()
 	p.advance()
@@ -0,0 +1,154 @@
+package hron
+
+import "time"
+
+// evalPlan holds what evaluating a schedule derives from its data and location, worked
+// out once when the schedule is built rather than on every NextFrom call: the anchor
+// dates parsed, the during clause summarized, and the timezone resolved.
+type evalPlan struct {
+	source *ScheduleData // The schedule's own data
+	data   *ScheduleData // The data evaluated: source with relative dates resolved
+	loc    *time.Location
+	ref    time.Time
+
+	// anchor is the date interval offsets are counted from, or the zero time for the
+	// epoch. It is unset when an aligned-to clause replaces the starting anchor.
+	anchor time.Time
+	// startDay is the starting anchor's date, and start the first instant of that day in
+	// loc; hasStart is false without a starting clause or a bound timezone. floorAtStart
+	// is set when the starting date only marks where the schedule begins, so nothing
+	// before start occurs: an aligned-to clause has taken over the alignment, or the date
+	// is relative, like `starting tomorrow`.
+	startDay     time.Time
+	start        time.Time
+	hasStart     bool
+	floorAtStart bool
+
+	hasExceptions bool
+	hasDuring     bool
+	// duringInternally is set for month targets that can cross month boundaries, which
+	// apply a month-only during filter themselves; duringMonths is that filter.
+	duringInternally bool
+	duringMonths     []MonthName
+
+	// offset is the plus or minus clause: occurrences are found unshifted, by the other
+	// clauses, and moved by it last.
+	offset time.Duration
+
+	// filter is the predicate set with Filtered that every occurrence must pass, or nil.
+	filter func(time.Time) bool
+
+	// For a schedule at a solar event, solarDays is the plan of the days it runs on,
+	// evaluated at midnight, and sun the provider of the event's time on each of them.
+	solarDays *evalPlan
+	sun       SolarProvider
+
+	// fiscal is the calendar fiscal repeats run in, or nil.
+	fiscal *FiscalCalendar
+	// events is the source event dates resolve against.
+	events EventSource
+
+	// For a schedule in several zones, zones holds its plan in each; evaluation takes the
+	// union of their occurrences.
+	zones []*evalPlan
+}
+
+// compilePlan builds the evaluation plan of schedule in loc, resolving relative dates
+// against ref. loc may be nil for an `in local` schedule without a bound zone, and ref
+// zero for relative dates without a reference time; such a plan is never evaluated.
+func compilePlan(source *ScheduleData, loc *time.Location, ref time.Time) *evalPlan {
+	schedule := source
+	if hasRelativeDates(source) && loc != nil {
+		if ref.IsZero() {
+			return &evalPlan{source: source, data: source, loc: loc}
+		}
+		schedule = resolveRelativeDates(source, ref.In(loc))
+	}
+	p := &evalPlan{
+		source:        source,
+		data:          schedule,
+		loc:           loc,
+		ref:           ref,
+		hasExceptions: len(schedule.Except) > 0,
+		hasDuring:     hasDuringClause(schedule),
+		offset:        schedule.Offset.Duration(),
+	}
+	if anchor := alignmentAnchor(schedule); anchor != "" {
+		p.anchor, _ = parseISODate(anchor)
+	}
+	if schedule.Anchor != "" {
+		if d, err := parseISODate(schedule.Anchor); err == nil {
+			p.startDay = d
+			if loc != nil {
+				p.start, p.hasStart = dayStart(schedule.Expr, d, loc), true
+				p.floorAtStart = schedule.Alignment != AlignmentDefault || source.AnchorRelative != nil
+			}
+		}
+	}
+	if schedule.Expr.Solar != 0 {
+		days := *schedule
+		days.Expr.Times, days.Expr.Solar = []TimeOfDay{{0, 0}}, 0
+		days.Offset = Offset{}
+		p.solarDays = compilePlan(&days, loc, ref)
+	}
+	if schedule.Expr.Kind == ScheduleExprKindMonth && crossesMonthBoundary(schedule.Expr.MonthTarget) && duringMonthsOnly(schedule) {
+		p.duringInternally = true
+		p.duringMonths = duringMonths(schedule)
+	}
+	return p
+}
+
+// plan returns the schedule's evaluation plan. Copies that change the data, timezone,
+// or reference time recompile theirs through withPlan; a stale plan is never used.
+func (s *Schedule) plan() *evalPlan {
+	if p := s.compiled; p != nil && p.source == s.data && p.loc == s.location && p.ref.Equal(s.reference) {
+		return p
+	}
+	return s.compile()
+}
+
+// withPlan recompiles the plan of a schedule copy after its data, timezone, reference
+// time, filter, solar provider, fiscal calendar, or event source changed.
+func (s *Schedule) withPlan() *Schedule {
+	s.compiled = s.compile()
+	return s
+}
+
+func (s *Schedule) compile() *evalPlan {
+	p := compilePlan(s.data, s.location, s.reference).attach(s.filter, s.solar, s.fiscal, s.eventSource())
+	if s.zones != nil {
+		p.zones = s.zonePlans()
+	}
+	return p
+}
+
+// attach sets what the plan takes from the schedule rather than its data. The days plan
+// of a solar schedule needs the fiscal calendar and event source too, but not the filter.
+func (p *evalPlan) attach(filter func(time.Time) bool, sun SolarProvider, fiscal *FiscalCalendar, events EventSource) *evalPlan {
+	p.filter, p.sun, p.fiscal, p.events = filter, sun, fiscal, events
+	if p.solarDays != nil {
+		p.solarDays.fiscal, p.solarDays.events = fiscal, events
+	}
+	return p
+}
+
+// hasRelativeDates reports whether the starting or until clause names a relative date.
+func hasRelativeDates(schedule *ScheduleData) bool {
+	return schedule.AnchorRelative != nil || (schedule.Until != nil && schedule.Until.Kind == UntilSpecKindRelative)
+}
+
+// resolveRelativeDates returns a copy of schedule with its relative dates replaced by
+// the ISO dates they name at ref.
+func resolveRelativeDates(schedule *ScheduleData, ref time.Time) *ScheduleData {
+	out := *schedule
+	if r := schedule.AnchorRelative; r != nil {
+		out.Anchor = r.Resolve(ref).Format(time.DateOnly)
+		out.AnchorRelative = nil
+	}
+	if u := schedule.Until; u != nil && u.Kind == UntilSpecKindRelative {
+		until := NewISOUntil(u.Relative.Resolve(ref).Format(time.DateOnly))
+		until.Time = u.Time
+		out.Until = &until
+	}
+	return &out
+}
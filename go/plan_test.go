@@ -0,0 +1,41 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlanFollowsCopies(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	base := MustParse("every 2 days at 09:00 starting 2026-03-02 in local")
+
+	bound, err := base.WithTimezone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+	want := time.Date(2026, 3, 2, 9, 0, 0, 0, ny)
+	if next, ok := bound.NextTime(from); !ok || !next.Equal(want) {
+		t.Errorf("WithTimezone: NextTime = %v, %v, want %v", next, ok, want)
+	}
+	if start, ok := bound.Starts(); !ok || !start.Equal(want.Add(-9*time.Hour)) {
+		t.Errorf("WithTimezone: Starts = %v, %v", start, ok)
+	}
+
+	sharded, err := MustParse("every 2 days at 09:00 starting 2026-03-02").ShardOccurrences(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	if next, ok := sharded.NextTime(from); !ok || !next.Equal(want) {
+		t.Errorf("ShardOccurrences: NextTime = %v, %v, want %v", next, ok, want)
+	}
+
+	// A copy built without withPlan still evaluates its own data
+	c := *MustParse("every day at 09:00")
+	c.data = MustParse("every 2 days at 10:00 aligned to month start starting 2026-03-05").data
+	want = time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	if next, ok := c.NextTime(from); !ok || !next.Equal(want) {
+		t.Errorf("stale plan: NextTime = %v, %v, want %v", next, ok, want)
+	}
+}
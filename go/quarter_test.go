@@ -0,0 +1,79 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuarterRoundtrip(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every quarter on the 1st at 09:00", "every quarter on the 1st at 09:00"},
+		{"every 2 quarters on the last day at 17:00", "every 2 quarters on the last day at 17:00"},
+		{"every half year on the first monday at 09:00", "every half year on the first monday at 09:00"},
+		{"every 3 months on the 1st at 09:00", "every 3 months on the 1st at 09:00"},
+		{"every day at 09:00 during q1, q3", "every day at 09:00 during q1, q3"},
+		{"every day at 09:00 during dec, h1, q3 in UTC", "every day at 09:00 during q3, h1, dec in UTC"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestQuarterRepeatMatchesMonths(t *testing.T) {
+	quarter := MustParse("every quarter on the 1st at 09:00")
+	months := MustParse("every 3 months on the 1st at 09:00")
+	now := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+
+	got, want := quarter.NextNFrom(now, 4), months.NextNFrom(now, 4)
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom returned %d results, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if first := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC); !got[0].Equal(first) {
+		t.Errorf("first occurrence = %v, want %v", got[0], first)
+	}
+}
+
+func TestDuringQuarterEval(t *testing.T) {
+	s := MustParse("every day at 09:00 during q2, h2 in UTC")
+
+	next := s.NextFrom(time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom in q1 = %v, want %v", next, want)
+	}
+	prev := s.PreviousFrom(time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC); prev == nil || !prev.Equal(want) {
+		t.Errorf("PreviousFrom in q1 = %v, want %v", prev, want)
+	}
+	if !s.Matches(time.Date(2026, 9, 30, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches in h2 = false, want true")
+	}
+	if s.Matches(time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches in q1 = true, want false")
+	}
+}
+
+func TestQuarterErrors(t *testing.T) {
+	for _, input := range []string{
+		"every half on the 1st at 09:00",
+		"every quarter at 09:00",
+		"every day at 09:00 during q5",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package hron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// maxRandomPeriods bounds the search for a period whose pool has an eligible day.
+const maxRandomPeriods = 60
+
+// randomPeriodStart returns the first day of the period containing d:
+// the ISO week's Monday or the first of the month.
+func randomPeriodStart(period RandomPeriod, d time.Time) time.Time {
+	d = dateOnly(d)
+	if period == RandomPeriodMonth {
+		return time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return d.AddDate(0, 0, 1-isoWeekday(d))
+}
+
+func nextRandomPeriod(period RandomPeriod, start time.Time, step int) time.Time {
+	if period == RandomPeriodMonth {
+		return start.AddDate(0, step, 0)
+	}
+	return start.AddDate(0, 0, 7*step)
+}
+
+// randomPick returns the day picked for the period containing d. The pick hashes the
+// seed with the period's start date (FNV-1a), so it is reproducible across processes.
+func randomPick(expr ScheduleExpr, d time.Time) (time.Time, bool) {
+	start := randomPeriodStart(expr.Period, d)
+	end := nextRandomPeriod(expr.Period, start, 1)
+
+	var pool []time.Time
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if matchesDayFilter(day, expr.Days) {
+			pool = append(pool, day)
+		}
+	}
+	if len(pool) == 0 {
+		return time.Time{}, false
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%s", expr.Seed, start.Format("2006-01-02"))
+	return pool[h.Sum64()%uint64(len(pool))], true
+}
+
+func nextRandomPick(expr ScheduleExpr, loc *time.Location, now time.Time) *time.Time {
+	start := randomPeriodStart(expr.Period, now.In(loc))
+	for i := 0; i < maxRandomPeriods; i++ {
+		if pick, ok := randomPick(expr, start); ok {
+			if candidate := earliestFutureAtTimes(pick, expr.Times, loc, now); candidate != nil {
+				return candidate
+			}
+		}
+		start = nextRandomPeriod(expr.Period, start, 1)
+	}
+	return nil
+}
+
+func prevRandomPick(expr ScheduleExpr, loc *time.Location, now time.Time) *time.Time {
+	nowInTz := now.In(loc)
+	nowDate := dateOnly(nowInTz)
+	start := randomPeriodStart(expr.Period, nowInTz)
+	for i := 0; i < maxRandomPeriods; i++ {
+		if pick, ok := randomPick(expr, start); ok && !pick.After(nowDate) {
+			if pick.Equal(nowDate) {
+				if candidate := latestPastAtTimes(pick, expr.Times, loc, now); candidate != nil {
+					return candidate
+				}
+			} else {
+				return latestAtTimes(pick, expr.Times, loc)
+			}
+		}
+		start = nextRandomPeriod(expr.Period, start, -1)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRandomPickParseDisplay(t *testing.T) {
+	for _, input := range []string{
+		`one random weekday each week at 09:00 seeded by "team-a"`,
+		`one random day each month at 09:00, 15:00 seeded by "fire drill"`,
+		`one random monday, wednesday, friday each week at 10:00 seeded by "audit" except 2026-01-14 in UTC`,
+	} {
+		s, err := ParseSchedule(input)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", input, err)
+		}
+		if got := s.String(); got != input {
+			t.Errorf("ParseSchedule(%q).String() = %q", input, got)
+		}
+	}
+
+	for _, input := range []string{
+		`one random weekday each week at 09:00`,
+		`one random weekday each week at 09:00 seeded by ""`,
+		`one random weekday each week at 09:00 seeded by "open`,
+		`one random weekday each year at 09:00 seeded by "x"`,
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestRandomPickIsDeterministic(t *testing.T) {
+	s := MustParse(`one random weekday each week at 09:00 seeded by "team-a"`)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Golden values: the pick must not change across processes or releases
+	want := []time.Time{
+		time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 22, 9, 0, 0, 0, time.UTC),
+	}
+	got := s.NextNFrom(from, 4)
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if prev := s.PreviousFrom(want[2]); prev == nil || !prev.Equal(want[1]) {
+		t.Errorf("PreviousFrom() = %v, want %v", prev, want[1])
+	}
+	if !s.Matches(want[2]) || s.Matches(time.Date(2026, 1, 13, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches() disagrees with the pick")
+	}
+}
+
+func TestRandomPickOnePerPeriod(t *testing.T) {
+	s := MustParse(`one random weekday each week at 09:00 seeded by "drill"`)
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC) // a Monday
+	seen := map[int]bool{}
+	for _, occ := range s.NextNFrom(from, 52) {
+		if wd := isoWeekday(occ); wd > 5 {
+			t.Errorf("%v is not a weekday", occ)
+		}
+		_, week := occ.ISOWeek()
+		if seen[week] {
+			t.Errorf("two picks in ISO week %d", week)
+		}
+		seen[week] = true
+	}
+	if len(seen) != 52 {
+		t.Errorf("got picks in %d weeks, want 52", len(seen))
+	}
+
+	other := MustParse(`one random weekday each week at 09:00 seeded by "other"`)
+	same := true
+	a, b := s.NextNFrom(from, 10), other.NextNFrom(from, 10)
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			same = false
+		}
+	}
+	if same {
+		t.Error("different seeds produced identical picks for 10 weeks")
+	}
+}
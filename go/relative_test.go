@@ -0,0 +1,103 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeAnchorResolve(t *testing.T) {
+	ref := time.Date(2026, 3, 4, 23, 30, 0, 0, time.UTC) // wednesday
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"today", "2026-03-04"},
+		{"tomorrow", "2026-03-05"},
+		{"next monday", "2026-03-09"},
+		{"next wednesday", "2026-03-11"},
+		{"next thursday", "2026-03-05"},
+		{"next week", "2026-03-09"},
+		{"next month", "2026-04-01"},
+		{"next year", "2027-01-01"},
+		{"end of week", "2026-03-08"},
+		{"end of month", "2026-03-31"},
+		{"end of year", "2026-12-31"},
+	}
+	for _, tt := range tests {
+		data, err := Parse("every day at 09:00 until " + tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if got := data.Until.Relative.Resolve(ref).Format(time.DateOnly); got != tt.want {
+			t.Errorf("%s resolves to %s, want %s", tt.expr, got, tt.want)
+		}
+		if got := Display(data); got != "every day at 09:00 until "+tt.expr {
+			t.Errorf("Display = %q", got)
+		}
+	}
+}
+
+func TestRelativeDatesEvaluate(t *testing.T) {
+	// 2026-03-04 is a wednesday; it is already the 4th in Tokyo at 20:00 UTC on the 3rd
+	ref := time.Date(2026, 3, 3, 20, 0, 0, 0, time.UTC)
+	s, err := ParseScheduleAt("every day at 09:00 until end of week starting tomorrow in Asia/Tokyo", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	got := s.NextNFrom(ref.AddDate(0, 0, -7), 10)
+	if len(got) != 4 || !got[0].Equal(time.Date(2026, 3, 5, 9, 0, 0, 0, tokyo)) || !got[3].Equal(time.Date(2026, 3, 8, 9, 0, 0, 0, tokyo)) {
+		t.Errorf("NextNFrom = %v, want mar 5 to mar 8 at 09:00", got)
+	}
+	if start, ok := s.Starts(); !ok || !start.Equal(time.Date(2026, 3, 5, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Starts() = %v, %v", start, ok)
+	}
+	if prev := s.PreviousFrom(ref.AddDate(0, 1, 0)); prev == nil || !prev.Equal(got[3]) {
+		t.Errorf("PreviousFrom = %v, want %v", prev, got[3])
+	}
+	if !s.Matches(got[1]) || s.Matches(got[0].AddDate(0, 0, -1)) {
+		t.Error("Matches should follow the resolved dates")
+	}
+	if got := s.String(); got != "every day at 09:00 until end of week starting tomorrow in Asia/Tokyo" {
+		t.Errorf("String() = %q keeps the relative dates", got)
+	}
+
+	// Without a reference, the schedule cannot be evaluated
+	unbound := MustParse("every day at 09:00 starting next month")
+	if err := unbound.Validate(); err == nil {
+		t.Error("Validate() should fail without a reference time")
+	}
+	if next, err := unbound.NextFromErr(ref); next != nil || err == nil {
+		t.Errorf("NextFromErr = %v, %v; want an error", next, err)
+	}
+	if _, ok := unbound.Anchor(); ok {
+		t.Error("Anchor() should be unknown without a reference time")
+	}
+}
+
+func TestRelativeDatesDescribeAndErrors(t *testing.T) {
+	tests := []struct {
+		locale, expr, want string
+	}{
+		{"en", "every day at 09:00 until end of month starting next monday", "Runs at 9:00 AM every day, until the end of the month, starting next Monday"},
+		{"es", "every day at 09:00 starting tomorrow", "Se ejecuta a las 9:00 todos los días, a partir de mañana"},
+	}
+	for _, tt := range tests {
+		got, err := MustParse(tt.expr).DescribeIn(tt.locale)
+		if err != nil || got != tt.want {
+			t.Errorf("DescribeIn(%s) = %q, %v; want %q", tt.locale, got, err, tt.want)
+		}
+	}
+
+	for _, input := range []string{
+		"every day at 09:00 starting next",
+		"every day at 09:00 starting next day",
+		"every day at 09:00 until end month",
+		"every day at 09:00 until end of",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) should fail", input)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package hron
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RoundtripError is a canonical string that parses back to a different schedule, or
+// displays differently once parsed again, as CheckRoundtrip reports it.
+type RoundtripError struct {
+	Canonical string // The canonical string of the schedule checked
+	Reparsed  string // The canonical string of what Canonical parses to
+}
+
+func (e *RoundtripError) Error() string {
+	if e.Reparsed != e.Canonical {
+		return fmt.Sprintf("canonical form %q displays as %q", e.Canonical, e.Reparsed)
+	}
+	return fmt.Sprintf("canonical form %q parses to a different schedule", e.Canonical)
+}
+
+// CheckRoundtrip reports whether the canonical string of data parses back to the same
+// ScheduleData, so the string can be stored and diffed without spurious rewrites. It
+// returns a parse error if the canonical string does not parse, and a *RoundtripError
+// if it parses to something else.
+func CheckRoundtrip(data *ScheduleData) error {
+	canonical := Display(data)
+	reparsed, err := Parse(canonical)
+	if err != nil {
+		return ParseError(fmt.Sprintf("canonical form %q does not parse: %v", canonical, err),
+			Span{0, len(canonical)}, canonical, "")
+	}
+	if again := Display(reparsed); again != canonical || !reflect.DeepEqual(reparsed, data) {
+		return &RoundtripError{Canonical: canonical, Reparsed: again}
+	}
+	return nil
+}
+
+// ParseCanonical parses input and rebuilds the schedule from its canonical string, failing
+// if the two disagree. The result is exactly what a stored String() will parse to later.
+func ParseCanonical(input string) (*Schedule, error) {
+	s, err := ParseSchedule(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckRoundtrip(s.data); err != nil {
+		return nil, err
+	}
+	canonical, err := ParseSchedule(s.String())
+	if err != nil {
+		return nil, err
+	}
+	return canonical, nil
+}
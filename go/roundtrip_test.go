@@ -0,0 +1,149 @@
+package hron
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// specExpressions returns every hron expression of the spec, valid or not.
+func specExpressions(t *testing.T) []string {
+	spec := loadSpec(t)
+	var out []string
+	for section, raw := range spec.Parse {
+		if section == "description" {
+			continue
+		}
+		var group ParseGroup
+		if err := json.Unmarshal(raw, &group); err != nil {
+			t.Fatalf("failed to parse section %s: %v", section, err)
+		}
+		for _, tc := range group.Tests {
+			out = append(out, tc.Input, tc.Canonical)
+		}
+	}
+	for section, raw := range spec.Eval {
+		var group struct {
+			Tests []struct {
+				Expression string `json:"expression"`
+			} `json:"tests"`
+		}
+		if json.Unmarshal(raw, &group) != nil {
+			continue // Not a group of tests, like "description"
+		}
+		if len(group.Tests) == 0 {
+			t.Fatalf("eval section %s has no tests", section)
+		}
+		for _, tc := range group.Tests {
+			out = append(out, tc.Expression)
+		}
+	}
+	for _, tc := range spec.ParseErrors.Tests {
+		out = append(out, tc.Input)
+	}
+	for _, tc := range spec.EvalErrors.Tests {
+		out = append(out, tc.Expression)
+	}
+	for _, tc := range spec.Cron.ToCron.Tests {
+		out = append(out, tc.Hron)
+	}
+	for _, tc := range spec.Cron.ToCronErrors.Tests {
+		out = append(out, tc.Hron)
+	}
+	for _, tc := range spec.Cron.FromCron.Tests {
+		out = append(out, tc.Hron)
+	}
+	for _, tc := range spec.Cron.Roundtrip.Tests {
+		out = append(out, tc.Hron)
+	}
+	return out
+}
+
+// Every expression in the spec must roundtrip structurally, not just as a string.
+func TestSpecExpressionsRoundtrip(t *testing.T) {
+	for _, input := range specExpressions(t) {
+		data, err := Parse(input)
+		if err != nil {
+			continue // parse error vectors
+		}
+		if err := CheckRoundtrip(data); err != nil {
+			t.Errorf("%q: %v", input, err)
+		}
+	}
+}
+
+func TestCheckRoundtripDetectsAsymmetry(t *testing.T) {
+	// A seed containing a quote cannot be written back out
+	data := NewScheduleData(NewRandomPick(NewDayFilterWeekday(), RandomPeriodWeek, `a"b`, []TimeOfDay{{9, 0}}))
+	var herr *HronError
+	if err := CheckRoundtrip(data); !errors.As(err, &herr) || herr.Kind != ErrorKindParse {
+		t.Errorf("CheckRoundtrip() = %v, want a parse error for an unrepresentable seed", err)
+	}
+
+	// An interval of 0 is written as every day, which parses to an interval of 1
+	data = NewScheduleData(NewDayRepeat(1, NewDayFilterEvery(), []TimeOfDay{{9, 0}}))
+	data.Expr.Interval = 0
+	var rt *RoundtripError
+	if err := CheckRoundtrip(data); !errors.As(err, &rt) || rt.Canonical != "every day at 09:00" {
+		t.Errorf("CheckRoundtrip() = %v, want a *RoundtripError", err)
+	}
+}
+
+// Units are singular in full and plural abbreviated as the spec's canonical forms write
+// them, wherever they appear.
+func TestUnitDisplay(t *testing.T) {
+	tests := []struct{ input, want string }{
+		{"every 1 min from 09:00 to 17:00", "every 1 minute from 09:00 to 17:00"},
+		{"every 30 minutes from 09:00 to 17:00", "every 30 min from 09:00 to 17:00"},
+		{"every 1 hr from 09:00 to 17:00", "every 1 hour from 09:00 to 17:00"},
+		{"every 2 hrs from 09:00 to 17:00", "every 2 hours from 09:00 to 17:00"},
+		{"every 1 sec from 09:00 to 10:00", "every 1 second from 09:00 to 10:00"},
+		{"every 90 secs from 09:00 to 10:00", "every 90 seconds from 09:00 to 10:00"},
+		{"every weekday at 09:00 to 17:00 every 1 hours", "every 1 hour from 09:00 to 17:00 on weekday"},
+		{"every day at 09:00 plus 1 minutes", "every day at 09:00 plus 1 minute"},
+		{"every day at 09:00 minus 15 minutes", "every day at 09:00 minus 15 min"},
+		{"every day at 09:00 plus 1 hours", "every day at 09:00 plus 1 hour"},
+	}
+	for _, tt := range tests {
+		data, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if got := Display(data); got != tt.want {
+			t.Errorf("%q displays as %q, want %q", tt.input, got, tt.want)
+		}
+		if err := CheckRoundtrip(data); err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+		}
+	}
+}
+
+func TestParseCanonical(t *testing.T) {
+	s, err := ParseCanonical("every 1 weeks on monday at 9:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); got != "every week on monday at 09:00" {
+		t.Errorf("String() = %q", got)
+	}
+	if _, err := ParseCanonical("every week on"); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestParseNormalized(t *testing.T) {
+	for _, input := range []string{
+		"every week on friday, monday at 17:00, 09:00",
+		"every monday, friday at 09:00, 17:00",
+		"every friday, monday at 9am, 5pm",
+	} {
+		s, err := ParseSchedule(input, WithNormalize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.String(); got != "every monday, friday at 09:00, 17:00" {
+			t.Errorf("%q: String() = %q", input, got)
+		}
+	}
+}
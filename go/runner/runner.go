@@ -0,0 +1,138 @@
+// Package runner runs functions at the occurrences of hron schedules, as an in-process
+// replacement for cron.
+//
+//	s := hron.MustParse("every weekday at 09:00 in America/New_York")
+//	err := runner.Schedule(ctx, s, func(t time.Time) {
+//	    sendReport(t)
+//	})
+package runner
+
+import (
+	"context"
+	"time"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// maxSleep bounds each wait so a change of the wall clock (suspend and resume, NTP
+// steps) is noticed within this long. Timers measure elapsed time, not wall time.
+const maxSleep = time.Minute
+
+// lateTolerance is how long after an occurrence the runner may wake and still treat it
+// as on time rather than missed. It matches the granularity of the wall-clock checks.
+const lateTolerance = maxSleep
+
+// Policy says what to do with occurrences missed while the process was down, suspended,
+// or busy with a previous run.
+type Policy int
+
+const (
+	// RunOnceImmediately runs once, right away, for the most recent missed occurrence.
+	RunOnceImmediately Policy = iota
+	// Skip drops missed occurrences and waits for the next one.
+	Skip
+	// RunAllMissed runs once for every missed occurrence, oldest first, right away.
+	RunAllMissed
+)
+
+// Option configures a job started with Schedule.
+type Option func(*job)
+
+type job struct {
+	policy  Policy
+	lastRun time.Time
+}
+
+// WithCatchUp sets the policy for missed occurrences. The default is RunOnceImmediately.
+func WithCatchUp(policy Policy) Option {
+	return func(j *job) { j.policy = policy }
+}
+
+// WithLastRun sets the scheduled time of the job's last run, typically persisted by a
+// previous process, so occurrences since then count as missed. By default the job
+// starts from the current time and has missed nothing.
+func WithLastRun(t time.Time) Option {
+	return func(j *job) { j.lastRun = t }
+}
+
+// Schedule calls fn at each occurrence of schedule, passing the scheduled time, until ctx
+// is done or the schedule has no more occurrences. It blocks, and calls fn from the
+// calling goroutine, so runs never overlap.
+//
+// Occurrences are instants computed by the schedule in its timezone, so DST changes
+// need no handling here. An occurrence is missed if the runner wakes for it more than a
+// minute late, because an earlier run overran, or the process was suspended or not
+// running (see WithLastRun) when it came due; the catch-up policy decides what happens.
+//
+// Schedule returns ctx.Err() once ctx is done, waiting for a run in progress to finish
+// first. It returns nil when the schedule ends and the schedule's error when it cannot
+// be evaluated or its search gives up (see hron.ErrLimitExceeded).
+func Schedule(ctx context.Context, schedule *hron.Schedule, fn func(t time.Time), opts ...Option) error {
+	j := job{}
+	for _, opt := range opts {
+		opt(&j)
+	}
+
+	cursor := time.Now()
+	if !j.lastRun.IsZero() {
+		cursor = j.lastRun
+	}
+	for {
+		next, err := schedule.NextFromCtx(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		if err := sleepUntil(ctx, *next); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		switch j.policy {
+		case RunAllMissed:
+			for _, t := range schedule.MissedBetween(cursor, now) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				fn(t)
+				cursor = t
+			}
+		case Skip:
+			cursor = latestDue(schedule, *next, now)
+			if now.Sub(cursor) <= lateTolerance {
+				fn(cursor)
+			}
+		default:
+			cursor = latestDue(schedule, *next, now)
+			fn(cursor)
+		}
+	}
+}
+
+// sleepUntil waits until the wall clock reaches t, or returns ctx.Err() once ctx is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	for {
+		d := time.Until(t)
+		if d <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(min(d, maxSleep))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// latestDue returns the most recent occurrence in [next, now], where next is the first
+// occurrence that came due.
+func latestDue(schedule *hron.Schedule, next, now time.Time) time.Time {
+	if prev := schedule.PreviousFrom(now.Add(time.Nanosecond)); prev != nil && prev.After(next) {
+		return *prev
+	}
+	return next
+}
@@ -0,0 +1,153 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/prasrvenkat/hron/go"
+)
+
+// Tests run in a synctest bubble, where the clock starts at 2000-01-01 00:00 UTC and
+// advances only when every goroutine is blocked.
+
+func day(d, hour int) time.Time {
+	return time.Date(2000, 1, d, hour, 0, 0, 0, time.UTC)
+}
+
+func TestScheduleRunsAtOccurrences(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var runs []time.Time
+		err := Schedule(ctx, hron.MustParse("every day at 09:00 in UTC"), func(at time.Time) {
+			if !time.Now().Equal(at) {
+				t.Errorf("run for %v started at %v", at, time.Now())
+			}
+			runs = append(runs, at)
+			if len(runs) == 3 {
+				cancel()
+			}
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Schedule() = %v, want context.Canceled", err)
+		}
+		if want := []time.Time{day(1, 9), day(2, 9), day(3, 9)}; !slices.EqualFunc(runs, want, time.Time.Equal) {
+			t.Errorf("runs = %v, want %v", runs, want)
+		}
+	})
+}
+
+func TestScheduleReturnsWhenScheduleEnds(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		count := 0
+		err := Schedule(context.Background(), hron.MustParse("every day at 09:00 until 2000-01-02 in UTC"), func(time.Time) {
+			count++
+		})
+		if err != nil || count != 2 {
+			t.Errorf("Schedule() = %v after %d runs, want nil after 2", err, count)
+		}
+	})
+}
+
+func TestScheduleCatchesUpOnceAfterLongRun(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var runs []time.Time
+		Schedule(ctx, hron.MustParse("every 1 hour from 00:00 to 23:59 in UTC"), func(at time.Time) {
+			runs = append(runs, at)
+			switch len(runs) {
+			case 1:
+				// Overrun the 02:00 and 03:00 occurrences
+				time.Sleep(150 * time.Minute)
+			case 3:
+				cancel()
+			}
+		})
+
+		want := []time.Time{day(1, 1), day(1, 3), day(1, 4)}
+		if !slices.EqualFunc(runs, want, time.Time.Equal) {
+			t.Errorf("runs = %v, want %v", runs, want)
+		}
+	})
+}
+
+func TestScheduleCancelWhileWaiting(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 4*time.Hour)
+		defer cancel()
+
+		err := Schedule(ctx, hron.MustParse("every day at 09:00 in UTC"), func(time.Time) {
+			t.Error("unexpected run")
+		})
+		if !errors.Is(err, context.DeadlineExceeded) || !time.Now().Equal(day(1, 4)) {
+			t.Errorf("Schedule() = %v at %v, want context.DeadlineExceeded at 04:00", err, time.Now())
+		}
+	})
+}
+
+func TestCatchUpPolicies(t *testing.T) {
+	at := func(d, hour int) time.Time { return day(d, hour).Add(30 * time.Minute) }
+	lastRun := time.Date(1999, 12, 31, 20, 30, 0, 0, time.UTC)
+	cases := []struct {
+		policy Policy
+		want   []time.Time
+	}{
+		{RunOnceImmediately, []time.Time{time.Date(1999, 12, 31, 23, 30, 0, 0, time.UTC), at(1, 0), at(1, 1)}},
+		{Skip, []time.Time{at(1, 0), at(1, 1)}},
+		{RunAllMissed, []time.Time{
+			time.Date(1999, 12, 31, 21, 30, 0, 0, time.UTC),
+			time.Date(1999, 12, 31, 22, 30, 0, 0, time.UTC),
+			time.Date(1999, 12, 31, 23, 30, 0, 0, time.UTC),
+			at(1, 0), at(1, 1),
+		}},
+	}
+	for _, tc := range cases {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var runs []time.Time
+			s := hron.MustParse("every 1 hour from 00:30 to 23:30 in UTC")
+			Schedule(ctx, s, func(t time.Time) {
+				runs = append(runs, t)
+				if t.Equal(at(1, 1)) {
+					cancel()
+				}
+			}, WithCatchUp(tc.policy), WithLastRun(lastRun))
+
+			if !slices.EqualFunc(runs, tc.want, time.Time.Equal) {
+				t.Errorf("policy %d: runs = %v, want %v", tc.policy, runs, tc.want)
+			}
+		})
+	}
+}
+
+func TestSkipAfterLongRun(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var runs []time.Time
+		Schedule(ctx, hron.MustParse("every 1 hour from 00:00 to 23:59 in UTC"), func(at time.Time) {
+			runs = append(runs, at)
+			switch len(runs) {
+			case 1:
+				time.Sleep(150 * time.Minute)
+			case 2:
+				cancel()
+			}
+		}, WithCatchUp(Skip))
+
+		if want := []time.Time{day(1, 1), day(1, 4)}; !slices.EqualFunc(runs, want, time.Time.Equal) {
+			t.Errorf("runs = %v, want %v", runs, want)
+		}
+	})
+}
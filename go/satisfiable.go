@@ -0,0 +1,237 @@
+package hron
+
+import (
+	"fmt"
+	"time"
+)
+
+// UnsatisfiableReason says why a schedule has no occurrence.
+type UnsatisfiableReason int
+
+const (
+	// UnsatisfiableInvalid is a schedule Validate rejects, which cannot be evaluated.
+	UnsatisfiableInvalid UnsatisfiableReason = iota
+	// UnsatisfiableEnded is a schedule whose until date is before its starting date or
+	// already past.
+	UnsatisfiableEnded
+	// UnsatisfiableImpossibleDay is a monthly repeat on days no during month has, such as
+	// the 31st during feb.
+	UnsatisfiableImpossibleDay
+	// UnsatisfiableDuring is a schedule none of whose days falls in its during clause, such
+	// as every saturday during a window of weekdays.
+	UnsatisfiableDuring
+	// UnsatisfiableExcepted is a schedule whose every day is excepted.
+	UnsatisfiableExcepted
+	// UnsatisfiableNoDay is a schedule that runs on no day for another reason, or whose
+	// days have no occurrence left once times, offsets, and filters are applied.
+	UnsatisfiableNoDay
+)
+
+func (r UnsatisfiableReason) String() string {
+	switch r {
+	case UnsatisfiableInvalid:
+		return "invalid"
+	case UnsatisfiableEnded:
+		return "ended"
+	case UnsatisfiableImpossibleDay:
+		return "impossible-day"
+	case UnsatisfiableDuring:
+		return "during"
+	case UnsatisfiableExcepted:
+		return "excepted"
+	default:
+		return "no-day"
+	}
+}
+
+// Unsatisfiability explains why a schedule has no occurrence.
+type Unsatisfiability struct {
+	Reason  UnsatisfiableReason
+	Message string
+	// Permanent is true when the schedule never runs again, and false when it was only
+	// shown to have no occurrence within the horizon.
+	Permanent bool
+}
+
+// gregorianCycle is the number of days after which the Gregorian calendar repeats, dates
+// and weekdays alike: 400 years, a whole number of weeks.
+const gregorianCycle = 146097
+
+// IsSatisfiable reports whether the schedule has an occurrence within horizon of now. It
+// decides by walking the days in the horizon rather than searching for the next
+// occurrence, so a schedule that never runs is reported as such instead of failing with
+// ErrLimitExceeded; UnsatisfiableFrom explains a false result.
+func (s *Schedule) IsSatisfiable(horizon time.Duration) bool {
+	return s.UnsatisfiableFrom(time.Now(), horizon) == nil
+}
+
+// UnsatisfiableFrom returns why the schedule has no occurrence in (now, now+horizon], or
+// nil if it has one.
+//
+// An until date before the starting date or before now, and monthly days past the end of
+// every during month, are found from the expression. Otherwise the days of the horizon
+// are checked against the schedule's days, during clause, and exceptions, and the first
+// that matches is searched from with NextFromErr, whose result decides. When no day
+// matches, a schedule whose days repeat with the calendar (one without a starting or
+// until date, interval, year bounds, or excepted dates) is checked over a whole 400-year
+// cycle, which proves the result permanent; so does a schedule with no next occurrence
+// at all, such as a single date in the past.
+func (s *Schedule) UnsatisfiableFrom(now time.Time, horizon time.Duration) *Unsatisfiability {
+	if err := s.Validate(); err != nil {
+		return &Unsatisfiability{UnsatisfiableInvalid, err.Error(), true}
+	}
+	ref, loc := s.reference, s.location
+	data := compilePlan(s.data, loc, ref).data
+	if u := ended(data, now.In(loc)); u != nil {
+		return u
+	}
+	if u := impossibleDays(data); u != nil {
+		return u
+	}
+
+	end := now.Add(horizon)
+	days := s.dayPlan(data, loc, ref)
+	today := dateOnly(now.In(loc))
+	last := dateOnly(end.In(loc))
+	if days == nil {
+		// Random picks and multi-zone schedules have no day plan; search directly
+		return s.confirm(now, end, today)
+	}
+	for d := today; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if s.runsOn(days, d) {
+			return s.confirm(now, end, d)
+		}
+	}
+
+	// No day in the horizon: explain with the clauses, and prove it over a cycle if the
+	// days repeat with the calendar
+	permanent := false
+	if cyclic(data) {
+		permanent = true
+		for d := last.AddDate(0, 0, 1); d.Before(today.AddDate(0, 0, gregorianCycle)); d = d.AddDate(0, 0, 1) {
+			if s.runsOn(days, d) {
+				permanent = false
+				break
+			}
+		}
+	}
+	within := fmt.Sprintf("within %v", horizon)
+	if permanent {
+		within = "in any year"
+	} else if next, err := s.NextFromErr(now); err == nil && next == nil {
+		permanent, within = true, "from now on"
+	}
+	without := func(change func(*ScheduleData)) bool {
+		relaxed := *data
+		change(&relaxed)
+		p := s.dayPlan(&relaxed, loc, ref)
+		for d := today; !d.After(last); d = d.AddDate(0, 0, 1) {
+			if s.runsOn(p, d) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case len(data.Except) > 0 && without(func(d *ScheduleData) { d.Except = nil }):
+		return &Unsatisfiability{UnsatisfiableExcepted, fmt.Sprintf("every day the schedule runs on is excepted %s", within), permanent}
+	case hasDuringClause(data) && without(func(d *ScheduleData) {
+		d.During, d.DuringDates, d.DuringWeeks, d.DuringQuarters, d.DuringHalves, d.DuringYears = nil, nil, nil, nil, nil, nil
+	}):
+		return &Unsatisfiability{UnsatisfiableDuring, fmt.Sprintf("no day the schedule runs on falls in its during clause %s", within), permanent}
+	default:
+		return &Unsatisfiability{UnsatisfiableNoDay, fmt.Sprintf("the schedule runs on no day %s", within), permanent}
+	}
+}
+
+// confirm returns nil if the schedule has an occurrence in (now, end] searching from day
+// d, or why not.
+func (s *Schedule) confirm(now, end, d time.Time) *Unsatisfiability {
+	from := now
+	if start := localMidnight(d, s.location).Add(-time.Nanosecond); start.After(from) {
+		from = start
+	}
+	next, err := s.NextFromErr(from)
+	switch {
+	case err != nil:
+		return &Unsatisfiability{UnsatisfiableNoDay, err.Error(), false}
+	case next == nil:
+		return &Unsatisfiability{UnsatisfiableNoDay, "the schedule has no occurrence left", true}
+	case next.After(end):
+		return &Unsatisfiability{UnsatisfiableNoDay, fmt.Sprintf("the next occurrence is %s, after the horizon", next.Format(time.RFC3339)), false}
+	}
+	return nil
+}
+
+// dayPlan returns the plan of the days data runs on, evaluated at midnight, or nil for a
+// schedule without one.
+func (s *Schedule) dayPlan(data *ScheduleData, loc *time.Location, ref time.Time) *evalPlan {
+	if data.Expr.Kind == ScheduleExprKindRandom || s.zones != nil {
+		return nil
+	}
+	days := *data
+	if days.Expr.Kind == ScheduleExprKindInterval {
+		filter := NewDayFilterEvery()
+		if days.Expr.DayFilter != nil {
+			filter = *days.Expr.DayFilter
+		}
+		days.Expr = NewDayRepeat(1, filter, nil)
+	}
+	days.Expr.Times, days.Expr.Solar = []TimeOfDay{{0, 0}}, 0
+	days.Offset = Offset{}
+	if days.Until != nil {
+		until := *days.Until
+		until.Time = nil
+		days.Until = &until
+	}
+	return compilePlan(&days, loc, ref).attach(nil, nil, s.fiscal, s.eventSource())
+}
+
+func (s *Schedule) runsOn(days *evalPlan, d time.Time) bool {
+	return matches(days, s.calendar, localMidnight(d, s.location))
+}
+
+// ended returns why a schedule whose until date is before its starting date or before
+// today never runs, or nil. Only ISO until dates are checked.
+func ended(data *ScheduleData, now time.Time) *Unsatisfiability {
+	until := data.Until
+	if until == nil || until.Kind != UntilSpecKindISO {
+		return nil
+	}
+	if data.Anchor != "" && until.Date < data.Anchor {
+		return &Unsatisfiability{UnsatisfiableEnded, fmt.Sprintf("until %s is before starting %s", until.Date, data.Anchor), true}
+	}
+	if until.Date < now.Format(time.DateOnly) {
+		return &Unsatisfiability{UnsatisfiableEnded, fmt.Sprintf("until %s has passed", until.Date), true}
+	}
+	return nil
+}
+
+// impossibleDays returns why a monthly repeat on days past the end of every during month
+// never runs, or nil.
+func impossibleDays(data *ScheduleData) *Unsatisfiability {
+	longest := longestDuringMonth(data)
+	if longest == 0 {
+		return nil
+	}
+	for _, day := range data.Expr.MonthTarget.ExpandDays() {
+		if day <= longest {
+			return nil
+		}
+	}
+	return &Unsatisfiability{UnsatisfiableImpossibleDay, fmt.Sprintf("no during month has the days it runs on (the longest has %d days)", longest), true}
+}
+
+// cyclic reports whether the days data runs on repeat every Gregorian cycle.
+func cyclic(data *ScheduleData) bool {
+	if data.Expr.Interval > 1 || data.Anchor != "" || data.Until != nil || len(data.DuringYears) > 0 ||
+		data.Expr.Kind == ScheduleExprKindSingleDate || data.Expr.Kind == ScheduleExprKindFiscal {
+		return false
+	}
+	for _, e := range data.Except {
+		if e.Kind != ExceptionSpecKindNamed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnsatisfiable(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	month := 31 * 24 * time.Hour
+	tests := []struct {
+		input     string
+		reason    UnsatisfiableReason
+		permanent bool
+	}{
+		{"every month on the 31st at 09:00 during feb", UnsatisfiableImpossibleDay, true},
+		{"every month on the 30th, 31st at 09:00 during feb", UnsatisfiableImpossibleDay, true},
+		{"every day at 09:00 until 2025-12-31", UnsatisfiableEnded, true},
+		{"every day at 09:00 until 2026-06-01 starting 2026-07-01", UnsatisfiableEnded, true},
+		{"first monday of every month at 09:00 during jan 10 to jan 20", UnsatisfiableDuring, true},
+		{"every saturday at 09:00 during mar 2 to mar 6", UnsatisfiableDuring, false},
+		{"every weekday at 09:00 except mar 2, mar 3, mar 4, mar 5, mar 6 during mar 2 to mar 6", UnsatisfiableExcepted, true},
+		{"every year on feb 29 at 09:00", UnsatisfiableNoDay, false},
+		{"on 2026-01-15 at 09:00", UnsatisfiableNoDay, true},
+		{"every day at 09:00 except holidays", UnsatisfiableInvalid, true},
+	}
+	for _, tt := range tests {
+		u := MustParse(tt.input).UnsatisfiableFrom(now, month)
+		if u == nil {
+			t.Errorf("%q: satisfiable, want %v", tt.input, tt.reason)
+			continue
+		}
+		if u.Reason != tt.reason || u.Permanent != tt.permanent {
+			t.Errorf("%q: %v (permanent %v) %q, want %v (permanent %v)", tt.input, u.Reason, u.Permanent, u.Message, tt.reason, tt.permanent)
+		}
+	}
+
+	for _, input := range []string{
+		"every day at 09:00",
+		"every month on the 30th at 09:00 during mar, apr",
+		"every year on mar 15 at 09:00",
+		"every 15 min from 09:00 to 10:00 on weekends",
+		"every weekday at 09:00 except mar 2, mar 3, mar 4, mar 5, mar 6",
+		"on 2026-03-20 at 09:00",
+	} {
+		if u := MustParse(input).UnsatisfiableFrom(now, month); u != nil {
+			t.Errorf("%q: %+v, want satisfiable", input, u)
+		}
+	}
+
+	if !MustParse("every day at 09:00").IsSatisfiable(24 * time.Hour) {
+		t.Error("IsSatisfiable(24h) = false for a daily schedule")
+	}
+}
@@ -0,0 +1,87 @@
+package hron
+
+import "slices"
+
+// SyntaxClass groups tokens for syntax highlighting.
+type SyntaxClass int
+
+const (
+	SyntaxKeyword     SyntaxClass = iota
+	SyntaxName                    // Day, month, quarter, and half names, events, and solar events
+	SyntaxNumber                  // Numbers and ordinals, spelled out or not
+	SyntaxTime                    // Times of day
+	SyntaxDate                    // ISO dates
+	SyntaxTimezone                // Zones of the in clause
+	SyntaxString                  // Quoted strings
+	SyntaxPunctuation             // Commas
+	SyntaxWhitespace              // Spaces between tokens
+	SyntaxError                   // Text the lexer rejected
+)
+
+// SyntaxToken is a token of Scan: a lexed token, whitespace, or rejected text, with the
+// text it covers.
+type SyntaxToken struct {
+	Token // Kind and value of a lexed token; for whitespace and errors only Span is set
+	Class SyntaxClass
+	Text  string
+}
+
+// Scan splits input into tokens for editor tooling such as syntax highlighting and hover
+// information. Unlike Tokenize, it keeps the whitespace between tokens and does not stop
+// at text the lexer rejects, so the texts of the tokens concatenate to input. Rejected
+// text is a SyntaxError token per word; Tokenize or ParseAll reports why.
+func Scan(input string) []SyntaxToken {
+	var errs []*HronError
+	tokens, _ := (&lexer{input: input, errs: &errs}).tokenize()
+	// Keywords the lexer substituted for misspelled words are reported as errors
+	tokens = slices.DeleteFunc(tokens, func(tok Token) bool {
+		return slices.ContainsFunc(errs, func(e *HronError) bool {
+			return e.Span != nil && tok.Span.Start >= e.Span.Start && tok.Span.Start < max(e.Span.End, e.Span.Start+1)
+		})
+	})
+
+	var out []SyntaxToken
+	pos := 0
+	gap := func(end int) {
+		for pos < end {
+			start := pos
+			white := isWhitespace(input[pos])
+			for pos < end && isWhitespace(input[pos]) == white {
+				pos++
+			}
+			class := SyntaxError
+			if white {
+				class = SyntaxWhitespace
+			}
+			out = append(out, SyntaxToken{Token: Token{Span: Span{start, pos}}, Class: class, Text: input[start:pos]})
+		}
+	}
+	for _, tok := range tokens {
+		gap(tok.Span.Start)
+		out = append(out, SyntaxToken{Token: tok, Class: syntaxClass(tok.Kind), Text: input[tok.Span.Start:tok.Span.End]})
+		pos = tok.Span.End
+	}
+	gap(len(input))
+	return out
+}
+
+func syntaxClass(kind TokenKind) SyntaxClass {
+	switch kind {
+	case TokenDayName, TokenMonthName, TokenQuarterName, TokenHalfName, TokenEvent, TokenSolar:
+		return SyntaxName
+	case TokenNumber, TokenOrdinalNumber, TokenOrdinal:
+		return SyntaxNumber
+	case TokenTime:
+		return SyntaxTime
+	case TokenISODate:
+		return SyntaxDate
+	case TokenTimezone:
+		return SyntaxTimezone
+	case TokenString:
+		return SyntaxString
+	case TokenComma:
+		return SyntaxPunctuation
+	default:
+		return SyntaxKeyword
+	}
+}
@@ -0,0 +1,45 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	input := " every mondy at 09:00,  17:00 in America/New_York "
+	tokens := Scan(input)
+
+	var text strings.Builder
+	var classes []SyntaxClass
+	for _, tok := range tokens {
+		if input[tok.Span.Start:tok.Span.End] != tok.Text {
+			t.Errorf("token %q has span %v", tok.Text, tok.Span)
+		}
+		text.WriteString(tok.Text)
+		if tok.Class != SyntaxWhitespace {
+			classes = append(classes, tok.Class)
+		}
+	}
+	if text.String() != input {
+		t.Errorf("token texts = %q, want the input", text.String())
+	}
+	want := []SyntaxClass{SyntaxKeyword, SyntaxError, SyntaxKeyword, SyntaxTime, SyntaxPunctuation, SyntaxTime, SyntaxKeyword, SyntaxTimezone}
+	if len(classes) != len(want) {
+		t.Fatalf("classes = %v, want %v", classes, want)
+	}
+	for i := range want {
+		if classes[i] != want[i] {
+			t.Errorf("classes = %v, want %v", classes, want)
+			break
+		}
+	}
+
+	for _, tok := range Scan("every monday at 9am") {
+		if tok.Text == "monday" && (tok.Kind != TokenDayName || tok.DayNameVal != Monday) {
+			t.Errorf("monday scanned as %+v", tok.Token)
+		}
+		if tok.Text == "9am" && (tok.Kind != TokenTime || tok.TimeHour != 9) {
+			t.Errorf("9am scanned as %+v", tok.Token)
+		}
+	}
+}
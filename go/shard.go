@@ -0,0 +1,62 @@
+package hron
+
+import "fmt"
+
+// ShardOccurrences returns a copy of the schedule for one of totalShards hosts running
+// it, with its times moved by an offset that spreads the fleet evenly, so that 1000 hosts
+// running "every 1 hour from 00:00 to 23:59" do not all start on the hour. Shard 0 keeps
+// the schedule's own times.
+//
+// Interval repeats move their whole window, and so every occurrence, later by less than
+// one step; a window ending near midnight may come to end the next day. Other schedules move
+// the minute of each `at` time by up to an hour, wrapping within the hour, so times never
+// move to another day. Offsets are whole minutes, so an interval shorter than a minute
+// cannot be sharded.
+func ShardOccurrences(schedule *Schedule, shardID, totalShards int) (*Schedule, error) {
+	if totalShards < 1 || shardID < 0 || shardID >= totalShards {
+		return nil, EvalError(fmt.Sprintf("invalid shard %d of %d", shardID, totalShards))
+	}
+
+	data := *schedule.data
+	expr := data.Expr
+	if usesSolar(&data) {
+		return nil, EvalError("cannot shard a schedule at a solar event")
+	}
+	if expr.Kind == ScheduleExprKindInterval {
+		step := expr.Unit.Seconds(expr.Interval) / 60
+		if step == 0 {
+			return nil, EvalError("cannot shard an interval shorter than a minute")
+		}
+		// A window ending before it starts without next day never opens, so stays put
+		if expr.Overnight || expr.FromTime.TotalMinutes() <= expr.ToTime.TotalMinutes() {
+			offset := shardID * step / totalShards
+			expr.FromTime = addMinutes(expr.FromTime, offset)
+			expr.ToTime = addMinutes(expr.ToTime, offset)
+			expr.Overnight = expr.ToTime.TotalMinutes() < expr.FromTime.TotalMinutes()
+		}
+	} else {
+		offset := shardID * 60 / totalShards
+		times := make([]TimeOfDay, len(expr.Times))
+		for i, t := range expr.Times {
+			times[i] = TimeOfDay{t.Hour, (t.Minute + offset) % 60}
+		}
+		expr.Times = times
+	}
+	data.Expr = expr
+
+	c := *schedule
+	c.data = &data
+	return c.withPlan(), nil
+}
+
+// ShardOccurrences returns a copy of the schedule for one of totalShards hosts, with its
+// times offset to spread the fleet evenly; see the ShardOccurrences function.
+func (s *Schedule) ShardOccurrences(shardID, totalShards int) (*Schedule, error) {
+	return ShardOccurrences(s, shardID, totalShards)
+}
+
+// addMinutes returns t moved later by minutes, wrapping past midnight.
+func addMinutes(t TimeOfDay, minutes int) TimeOfDay {
+	m := (t.TotalMinutes() + minutes) % (24 * 60)
+	return TimeOfDay{m / 60, m % 60}
+}
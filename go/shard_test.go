@@ -0,0 +1,69 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShardOccurrences(t *testing.T) {
+	cases := []struct {
+		expr         string
+		shard, total int
+		want         string
+	}{
+		{"every 1 hour from 00:00 to 23:59", 0, 4, "every 1 hour from 00:00 to 23:59"},
+		{"every 1 hour from 00:00 to 23:59", 1, 4, "every 1 hour from 00:15 to 00:14 next day"},
+		{"every 1 hour from 00:00 to 23:59", 3, 4, "every 1 hour from 00:45 to 00:44 next day"},
+		{"every 15 min from 09:00 to 17:00 on weekdays", 2, 3, "every 15 min from 09:10 to 17:10 on weekday"},
+		{"every 2 hours from 22:00 to 02:00 next day", 1, 2, "every 2 hours from 23:00 to 03:00 next day"},
+		{"every weekday at 09:00, 17:30", 1, 2, "every weekday at 09:30, 17:00"},
+		{"every month on the 1st at 23:50", 1, 6, "every month on the 1st at 23:00"},
+	}
+	for _, tc := range cases {
+		s, err := MustParse(tc.expr).ShardOccurrences(tc.shard, tc.total)
+		if err != nil {
+			t.Errorf("%q ShardOccurrences(%d, %d): %v", tc.expr, tc.shard, tc.total, err)
+			continue
+		}
+		if got := s.String(); got != tc.want {
+			t.Errorf("%q ShardOccurrences(%d, %d) = %q, want %q", tc.expr, tc.shard, tc.total, got, tc.want)
+		}
+		if _, err := ParseSchedule(s.String()); err != nil {
+			t.Errorf("%q ShardOccurrences(%d, %d) does not reparse: %v", tc.expr, tc.shard, tc.total, err)
+		}
+	}
+}
+
+func TestShardOccurrencesSpreadsFleet(t *testing.T) {
+	s := MustParse("every 1 hour from 00:00 to 23:59 in UTC")
+	from := time.Date(2026, 2, 1, 0, 30, 0, 0, time.UTC)
+
+	perMinute := make(map[int]int)
+	for shard := range 1000 {
+		sharded, err := s.ShardOccurrences(shard, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		perMinute[sharded.NextFrom(from).Minute()]++
+	}
+	if len(perMinute) != 60 {
+		t.Errorf("1000 shards use %d distinct minutes, want 60", len(perMinute))
+	}
+	for minute, n := range perMinute {
+		if n < 16 || n > 17 {
+			t.Errorf("minute %d has %d shards, want 16 or 17", minute, n)
+		}
+	}
+}
+
+func TestShardOccurrencesErrors(t *testing.T) {
+	s := MustParse("every day at 09:00")
+	for _, args := range [][2]int{{-1, 4}, {4, 4}, {0, 0}} {
+		if _, err := s.ShardOccurrences(args[0], args[1]); err == nil {
+			t.Errorf("ShardOccurrences(%d, %d) succeeded, want error", args[0], args[1])
+		}
+	}
+	if _, err := MustParse("every 30 seconds from 09:00 to 10:00").ShardOccurrences(1, 2); err == nil {
+		t.Error("sharding a 30 second interval succeeded, want error")
+	}
+}
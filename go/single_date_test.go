@@ -0,0 +1,70 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSingleDateListParseDisplay(t *testing.T) {
+	tests := []struct {
+		input, canonical string
+	}{
+		{"on 2026-03-01, 2026-06-01 at 10:00", "on 2026-03-01, 2026-06-01 at 10:00"},
+		{"on 2026-06-01 and 2026-03-01 at 10am", "on 2026-06-01, 2026-03-01 at 10:00"},
+		{"on feb 14, easter at 09:00", "on feb 14, easter at 09:00"},
+	}
+	for _, tc := range tests {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tc.input, err)
+			continue
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("ParseSchedule(%q) does not roundtrip: %v", tc.input, err)
+		}
+	}
+
+	for _, input := range []string{
+		"on 2026-03-01, at 10:00",
+		"on 2026-03-01, 2026-02-30 at 10:00",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestSingleDateListEval(t *testing.T) {
+	s := MustParse("on 2026-06-01, 2026-03-01 at 10:00 in UTC")
+	got := s.NextNFrom(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 5)
+	want := []time.Time{
+		time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	if prev := s.PreviousFrom(now); prev == nil || !prev.Equal(want[0]) {
+		t.Errorf("PreviousFrom(%v) = %v, want %v", now, prev, want[0])
+	}
+	if !s.Matches(want[1]) || s.Matches(time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Error("Matches does not follow the listed dates")
+	}
+}
+
+func TestSingleDateListNormalize(t *testing.T) {
+	got := MustParse("on 2026-06-01, feb 14, 2026-03-01, 2026-06-01 at 10:00").Normalize().String()
+	if want := "on feb 14, 2026-03-01, 2026-06-01 at 10:00"; got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+}
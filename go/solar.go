@@ -0,0 +1,177 @@
+package hron
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// SolarProvider reports when solar events happen at one place. Schedules at a solar
+// event (every day at sunset) evaluate against the provider attached with
+// WithSolarProvider or WithCoordinates.
+type SolarProvider interface {
+	// SolarEvent returns the instant of event on the given civil date (midnight UTC) in
+	// the schedule's timezone, or false if the sun does not reach it that day, as in a
+	// polar summer or winter.
+	SolarEvent(event SolarEvent, date time.Time) (time.Time, bool)
+}
+
+// WithSolarProvider returns a copy of the schedule that finds solar events with sp.
+func (s *Schedule) WithSolarProvider(sp SolarProvider) *Schedule {
+	c := *s
+	c.solar = sp
+	return c.withPlan()
+}
+
+// WithCoordinates returns a copy of the schedule that finds solar events with a
+// SolarCalculator at the given latitude and longitude in degrees, north and east positive.
+func (s *Schedule) WithCoordinates(latitude, longitude float64) *Schedule {
+	return s.WithSolarProvider(SolarCalculator{Latitude: latitude, Longitude: longitude})
+}
+
+// SolarProvider returns the provider attached to the schedule, or nil if none is set.
+func (s *Schedule) SolarProvider() SolarProvider {
+	return s.solar
+}
+
+// SolarCalculator is a SolarProvider computing solar events at a latitude and longitude
+// in degrees, north and east positive, with the sunrise equation. Times are accurate to
+// about a minute away from the poles and are rounded to the minute.
+type SolarCalculator struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// solarDepression is how far below the horizon the sun's center is at each event, in
+// degrees. Sunrise and sunset allow for refraction and the sun's radius.
+var solarDepression = map[SolarEvent]float64{
+	SolarSunrise: 0.833,
+	SolarSunset:  0.833,
+	SolarDawn:    6,
+	SolarDusk:    6,
+}
+
+// SolarEvent implements SolarProvider.
+func (c SolarCalculator) SolarEvent(event SolarEvent, date time.Time) (time.Time, bool) {
+	depression, ok := solarDepression[event]
+	if !ok {
+		return time.Time{}, false
+	}
+	const rad = math.Pi / 180
+
+	// Days from J2000 to local noon of the date
+	days := math.Floor(float64(date.Unix())/86400) + 2440588 - 2451545 + 0.0008 - c.Longitude/360
+	anomaly := math.Mod(357.5291+0.98560028*days, 360)
+	center := 1.9148*math.Sin(anomaly*rad) + 0.02*math.Sin(2*anomaly*rad) + 0.0003*math.Sin(3*anomaly*rad)
+	longitude := math.Mod(anomaly+center+180+102.9372, 360)
+	transit := 2451545 + days + 0.0053*math.Sin(anomaly*rad) - 0.0069*math.Sin(2*longitude*rad)
+	declination := math.Asin(math.Sin(longitude*rad) * math.Sin(23.4397*rad))
+
+	lat := c.Latitude * rad
+	cosHour := (math.Sin(-depression*rad) - math.Sin(lat)*math.Sin(declination)) / (math.Cos(lat) * math.Cos(declination))
+	if cosHour < -1 || cosHour > 1 {
+		return time.Time{}, false
+	}
+	hourAngle := math.Acos(cosHour) / rad / 360
+	julian := transit + hourAngle
+	if event == SolarSunrise || event == SolarDawn {
+		julian = transit - hourAngle
+	}
+	seconds := math.Round((julian - 2440587.5) * 86400)
+	return time.Unix(int64(seconds), 0).UTC().Round(time.Minute), true
+}
+
+// usesSolar reports whether the schedule runs at a solar event.
+func usesSolar(schedule *ScheduleData) bool {
+	return schedule.Expr.Solar != 0
+}
+
+// nextSolar finds the next solar event after now on a day the schedule runs, then moves
+// it by the offset and applies the filter as nextTimeCtx does. Days without the event
+// are skipped, each counting as an iteration.
+func nextSolar(ctx context.Context, p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (time.Time, bool, error) {
+	if p.sun == nil {
+		return time.Time{}, false, nil
+	}
+	loc, event := p.loc, p.data.Expr.Solar
+	var until untilBound
+	if p.data.Until != nil {
+		until = resolveUntilBound(*p.data.Until, now, loc)
+	}
+
+	// The event of now's own day may still be ahead
+	from := localMidnight(dateOnly(now.In(loc)), loc).Add(-time.Nanosecond)
+	for range opts.maxIterations() {
+		midnight, ok, err := nextTimeCtx(ctx, p.solarDays, cal, opts, from)
+		if !ok {
+			return time.Time{}, false, err
+		}
+		from = midnight
+		day := dateOnly(midnight.In(loc))
+		t, ok := p.sun.SolarEvent(event, day)
+		if !ok || !t.After(now) {
+			continue
+		}
+		if p.data.Until != nil && until.excludes(day, t) {
+			return time.Time{}, false, nil
+		}
+		shifted := t.In(loc).Add(p.offset)
+		if p.filter != nil && !p.filter(shifted) {
+			continue
+		}
+		return shifted, true, nil
+	}
+	return time.Time{}, false, iterationsError(opts)
+}
+
+// prevSolar finds the most recent solar event before now on a day the schedule runs,
+// like nextSolar.
+func prevSolar(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (*time.Time, error) {
+	if p.sun == nil {
+		return nil, nil
+	}
+	loc, event := p.loc, p.data.Expr.Solar
+	var until untilBound
+	if p.data.Until != nil {
+		until = resolveUntilBound(*p.data.Until, now, loc)
+	}
+
+	// The event of now's own day may already be past
+	from := localMidnight(dateOnly(now.In(loc)).AddDate(0, 0, 1), loc)
+	for range opts.maxIterations() {
+		midnight, err := previousFromErr(p.solarDays, cal, opts, from)
+		if midnight == nil {
+			return nil, err
+		}
+		from = *midnight
+		day := dateOnly(midnight.In(loc))
+		t, ok := p.sun.SolarEvent(event, day)
+		if !ok || !t.Before(now) || (p.data.Until != nil && until.excludes(day, t)) {
+			continue
+		}
+		shifted := t.In(loc).Add(p.offset)
+		if p.filter != nil && !p.filter(shifted) {
+			continue
+		}
+		return &shifted, nil
+	}
+	return nil, iterationsError(opts)
+}
+
+// matchesSolar checks if dt, already moved back by the offset, is in the minute of the
+// solar event of a day the schedule runs.
+func matchesSolar(p *evalPlan, cal HolidayCalendar, dt time.Time) bool {
+	if p.sun == nil {
+		return false
+	}
+	loc := p.loc
+	day := dateOnly(dt.In(loc))
+	t, ok := p.sun.SolarEvent(p.data.Expr.Solar, day)
+	if !ok || !t.Truncate(time.Minute).Equal(dt.Truncate(time.Minute)) {
+		return false
+	}
+	if p.data.Until != nil && resolveUntilBound(*p.data.Until, dt, loc).excludes(day, t) {
+		return false
+	}
+	return matches(p.solarDays, cal, localMidnight(day, loc))
+}
@@ -0,0 +1,171 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+// fixedSun is a SolarProvider with sunrise at 06:00 and sunset at 18:00 UTC, except on
+// the dates in dark, where the sun never rises.
+type fixedSun struct {
+	dark map[time.Time]bool
+}
+
+func (f fixedSun) SolarEvent(event SolarEvent, date time.Time) (time.Time, bool) {
+	if f.dark[date] {
+		return time.Time{}, false
+	}
+	if event == SolarSunrise {
+		return date.Add(6 * time.Hour), true
+	}
+	return date.Add(18 * time.Hour), true
+}
+
+func TestSolarCalculator(t *testing.T) {
+	denver, _ := time.LoadLocation("America/Denver")
+	c := SolarCalculator{Latitude: 39.7392, Longitude: -104.9903}
+	solstice := time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		event SolarEvent
+		want  time.Time
+	}{
+		{SolarSunrise, time.Date(2026, 6, 21, 5, 33, 0, 0, denver)},
+		{SolarSunset, time.Date(2026, 6, 21, 20, 32, 0, 0, denver)},
+		{SolarDawn, time.Date(2026, 6, 21, 5, 1, 0, 0, denver)},
+		{SolarDusk, time.Date(2026, 6, 21, 21, 5, 0, 0, denver)},
+	}
+	for _, tt := range tests {
+		if got, ok := c.SolarEvent(tt.event, solstice); !ok || !got.Equal(tt.want) {
+			t.Errorf("SolarEvent(%v) = %v, %v, want %v", tt.event, got.In(denver), ok, tt.want)
+		}
+	}
+
+	// The midnight sun never sets
+	if got, ok := (SolarCalculator{Latitude: 78.2, Longitude: 15.6}).SolarEvent(SolarSunset, solstice); ok {
+		t.Errorf("SolarEvent at Svalbard = %v, want no sunset", got)
+	}
+}
+
+func TestSolarSchedule(t *testing.T) {
+	dark := map[time.Time]bool{time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC): true}
+	sun := fixedSun{dark}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want []time.Time
+	}{
+		{"every day at sunset in UTC", []time.Time{
+			time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 11, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 13, 18, 0, 0, 0, time.UTC), // no sunset on the 12th
+		}},
+		{"every day at 30 min before sunrise in UTC", []time.Time{
+			time.Date(2026, 3, 11, 5, 30, 0, 0, time.UTC),
+			time.Date(2026, 3, 13, 5, 30, 0, 0, time.UTC),
+			time.Date(2026, 3, 14, 5, 30, 0, 0, time.UTC),
+		}},
+		{"every weekday at sunset except 2026-03-11 in UTC", []time.Time{
+			time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 13, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 16, 18, 0, 0, 0, time.UTC),
+		}},
+		{"every day at sunset until 2026-03-11 in UTC", []time.Time{
+			time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 11, 18, 0, 0, 0, time.UTC),
+		}},
+		{"every day at sunset until 2026-03-11 12:00 in UTC", []time.Time{
+			time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC),
+		}},
+	}
+	for _, tt := range tests {
+		s := MustParse(tt.expr).WithSolarProvider(sun)
+		got := s.NextNFrom(now, 3)
+		if len(got) != len(tt.want) {
+			t.Errorf("%q NextNFrom = %v, want %v", tt.expr, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equal(tt.want[i]) {
+				t.Errorf("%q NextNFrom[%d] = %v, want %v", tt.expr, i, got[i], tt.want[i])
+			}
+			if !s.Matches(got[i]) {
+				t.Errorf("%q does not match its occurrence %v", tt.expr, got[i])
+			}
+		}
+		last := tt.want[len(tt.want)-1]
+		if prev := s.PreviousFrom(last.Add(time.Second)); prev == nil || !prev.Equal(last) {
+			t.Errorf("%q PreviousFrom = %v, want %v", tt.expr, prev, last)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("%q: %v", tt.expr, err)
+		}
+	}
+
+	s := MustParse("every day at sunset in UTC").WithSolarProvider(sun)
+	if s.Matches(time.Date(2026, 3, 10, 18, 1, 0, 0, time.UTC)) {
+		t.Error("Matches accepted a minute after sunset")
+	}
+	if prev := s.PreviousFrom(time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)); prev == nil || !prev.Equal(time.Date(2026, 3, 11, 18, 0, 0, 0, time.UTC)) {
+		t.Errorf("PreviousFrom across a dark day = %v, want 2026-03-11 18:00", prev)
+	}
+}
+
+func TestSolarScheduleSyntax(t *testing.T) {
+	tests := []struct {
+		input     string
+		canonical string
+	}{
+		{"every day at sunset", "every day at sunset"},
+		{"every weekday at dawn in Europe/Oslo", "every weekday at dawn in Europe/Oslo"},
+		{"every day at 30 min before sunrise", "every day at sunrise minus 30 min"},
+		{"every week on sat at 1 hour after sunset", "every week on saturday at sunset plus 1 hour"},
+		{"every month on the 1st at dusk", "every month on the 1st at dusk"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.canonical)
+		}
+	}
+	for _, input := range []string{
+		"every day at sunset, 09:00",
+		"every day at 30 min before sunrise minus 5 min",
+		"every day at 30 min sunrise",
+		"every 30 min from sunrise to sunset",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", input)
+		}
+	}
+}
+
+func TestSolarScheduleOutputs(t *testing.T) {
+	s := MustParse("every day at 30 min before sunset in America/Denver")
+	if err := s.Validate(); err == nil {
+		t.Error("Validate accepted a solar schedule without a location")
+	}
+	if s.NextFrom(time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)) != nil {
+		t.Error("NextFrom found an occurrence without a location")
+	}
+	if err := s.WithCoordinates(39.7392, -104.9903).Validate(); err != nil {
+		t.Errorf("Validate with coordinates: %v", err)
+	}
+	if got, want := s.Describe(), "Runs at sunset every day, shifted 30 minutes earlier, in Denver time"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if _, err := s.ToCron(); err == nil {
+		t.Error("ToCron accepted a solar schedule")
+	}
+	if _, _, err := s.ToCronApprox(); err == nil {
+		t.Error("ToCronApprox accepted a solar schedule")
+	}
+	clock, err := s.WithTimes(TimeOfDay{19, 0})
+	if err != nil || clock.String() != "every day at 19:00 minus 30 min in America/Denver" {
+		t.Errorf("WithTimes = %v, %v", clock, err)
+	}
+}
@@ -0,0 +1,32 @@
+package hron
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the canonical expression as a string column.
+// As with MarshalText, the bound timezone, calendar, and evaluation options are not stored.
+// It has a value receiver so sql.Null[hron.Schedule] can write a schedule; the zero
+// Schedule has no expression and is an error.
+func (s Schedule) Value() (driver.Value, error) {
+	if s.data == nil {
+		return nil, EvalError("cannot store a zero Schedule (use sql.Null[hron.Schedule])")
+	}
+	return s.String(), nil
+}
+
+// Scan implements sql.Scanner for a string or []byte column holding an expression,
+// validating it by parsing. A NULL column is an error; scan nullable columns into
+// sql.Null[hron.Schedule].
+func (s *Schedule) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return s.UnmarshalText([]byte(v))
+	case []byte:
+		return s.UnmarshalText(v)
+	case nil:
+		return EvalError("cannot scan NULL into a Schedule (use sql.Null[hron.Schedule])")
+	}
+	return EvalError(fmt.Sprintf("cannot scan %T into a Schedule", src))
+}
@@ -0,0 +1,81 @@
+package hron
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Schedule{}
+	_ driver.Valuer = (*Schedule)(nil)
+	_ sql.Scanner   = (*Schedule)(nil)
+)
+
+func TestScheduleValue(t *testing.T) {
+	v, err := MustParse("every weekday at 9:00 in UTC").Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "every weekday at 09:00 in UTC" {
+		t.Errorf("Value() = %v, want the canonical expression", v)
+	}
+	if !driver.IsValue(v) {
+		t.Errorf("Value() = %T, not a driver.Value", v)
+	}
+	if _, err := (Schedule{}).Value(); err == nil {
+		t.Error("zero Schedule Value() succeeded, want error")
+	}
+}
+
+func TestScheduleValueNull(t *testing.T) {
+	for _, tt := range []struct {
+		arg  any
+		want driver.Value
+	}{
+		{sql.Null[Schedule]{V: *MustParse("every weekday at 9:00"), Valid: true}, "every weekday at 09:00"},
+		{sql.Null[Schedule]{}, nil},
+		{*MustParse("every day at 9:00"), "every day at 09:00"},
+	} {
+		// The conversion database/sql applies to each query argument.
+		got, err := driver.DefaultParameterConverter.ConvertValue(tt.arg)
+		if err != nil {
+			t.Errorf("ConvertValue(%v): %v", tt.arg, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ConvertValue(%v) = %v, want %v", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestScheduleScan(t *testing.T) {
+	for _, src := range []any{"every day at 09:00", []byte("every day at 09:00")} {
+		var s Schedule
+		if err := s.Scan(src); err != nil {
+			t.Errorf("Scan(%q): %v", src, err)
+			continue
+		}
+		if got := s.String(); got != "every day at 09:00" {
+			t.Errorf("Scan(%q) = %q", src, got)
+		}
+	}
+
+	for _, src := range []any{nil, 42, "every blue moon"} {
+		var s Schedule
+		if err := s.Scan(src); err == nil {
+			t.Errorf("Scan(%v) succeeded, want error", src)
+		}
+	}
+
+	var n sql.Null[Schedule]
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("sql.Null Scan(nil) = %v, valid %v", err, n.Valid)
+	}
+	if err := n.Scan("every monday at 08:00"); err != nil || !n.Valid {
+		t.Fatalf("sql.Null Scan = %v, valid %v", err, n.Valid)
+	}
+	if got := n.V.String(); got != "every monday at 08:00" {
+		t.Errorf("sql.Null Scan = %q", got)
+	}
+}
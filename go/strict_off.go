@@ -0,0 +1,7 @@
+//go:build !hron_strict
+
+package hron
+
+// strictRoundtrip makes NewSchedule verify CheckRoundtrip. Build with -tags hron_strict
+// to enable it in debug builds and test runs.
+const strictRoundtrip = false
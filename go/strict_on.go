@@ -0,0 +1,6 @@
+//go:build hron_strict
+
+package hron
+
+// strictRoundtrip makes NewSchedule verify CheckRoundtrip; see strict_off.go.
+const strictRoundtrip = true
@@ -0,0 +1,182 @@
+package hron
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxSuggestEdits bounds how many repairs a suggestion may combine.
+const maxSuggestEdits = 3
+
+// suggestConnectives are keywords commonly left out of expressions, tried as insertions
+// at the failing position after the keyword the parser expected.
+var suggestConnectives = []string{"at", "on", "on the", "every", "of every month", "to", "from"}
+
+var expectedKeywordRe = regexp.MustCompile(`^expected '([a-z ]+)'`)
+
+// withSuggestion attaches a corrected expression to a lex or parse error of input.
+func withSuggestion(input string, err error) error {
+	herr, ok := err.(*HronError)
+	if !ok || herr.Span == nil || herr.Suggestion != "" {
+		return err
+	}
+	herr.Suggestion = suggestFix(input, herr)
+	return err
+}
+
+// suggestFix repairs input one error at a time: an unknown word is replaced by the
+// nearest keyword, a keyword the parser expected is inserted, and common connectives are
+// tried where the parse failed. An edit is kept only if the parse gets further. When the
+// repaired input parses, the suggestion is its canonical form. Otherwise it is the input
+// with its unknown words corrected, or empty if there were none to correct.
+func suggestFix(input string, err *HronError) string {
+	current, corrected := input, input
+	for range maxSuggestEdits {
+		next, nextErr, ok := bestRepair(current, err)
+		if !ok {
+			break
+		}
+		if nextErr == nil {
+			data, _ := parseInput(next)
+			return Display(data)
+		}
+		if err.Kind == ErrorKindLex && current == corrected {
+			corrected = next
+		}
+		current, err = next, nextErr
+	}
+	if corrected == input {
+		return ""
+	}
+	return corrected
+}
+
+// bestRepair tries the candidate edits for err in order and returns the first that
+// parses, or else the first that gets further: past the unknown word for a lex error,
+// or further into the input for a parse error.
+func bestRepair(input string, err *HronError) (string, *HronError, bool) {
+	var progress string
+	var progressErr *HronError
+	for _, candidate := range repairCandidates(input, err) {
+		// A repair that makes a word the name of an unknown event does not count
+		if namesUnknownEvent(candidate) {
+			continue
+		}
+		data, perr := parseInput(candidate)
+		if perr == nil {
+			// A repair that turns a word into an unknown timezone does not count
+			if _, err := NewSchedule(data); err == nil {
+				return candidate, nil, true
+			}
+			continue
+		}
+		cerr, ok := perr.(*HronError)
+		if !ok || cerr.Span == nil || progress != "" {
+			continue
+		}
+		// Positions after the edit shift by the change in length
+		shifted := cerr.Span.Start - (len(candidate) - len(input))
+		if (err.Kind == ErrorKindLex && cerr.Kind != ErrorKindLex) || shifted > err.Span.Start {
+			progress, progressErr = candidate, cerr
+		}
+	}
+	return progress, progressErr, progress != ""
+}
+
+// namesUnknownEvent reports whether input names an event that is not registered.
+func namesUnknownEvent(input string) bool {
+	var errs []*HronError
+	tokens, _ := (&lexer{input: input, errs: &errs}).tokenize()
+	return slices.ContainsFunc(tokens, func(t Token) bool {
+		_, ok := lookupEvent(t.EventVal)
+		return t.Kind == TokenEvent && !ok
+	})
+}
+
+func repairCandidates(input string, err *HronError) []string {
+	start, end := err.Span.Start, err.Span.End
+	var out []string
+	insert := func(words string) {
+		out = append(out, strings.TrimSpace(input[:start]+" "+words+" "+input[start:]))
+	}
+
+	if m := expectedKeywordRe.FindStringSubmatch(err.Message); m != nil {
+		insert(m[1])
+	}
+	if word := input[start:end]; end > start && isAlpha(word[0]) {
+		for _, kw := range nearestKeywords(strings.ToLower(word)) {
+			out = append(out, input[:start]+kw+input[end:])
+		}
+	}
+	for _, words := range suggestConnectives {
+		insert(words)
+	}
+	return out
+}
+
+// nearestKeywords returns the keywords and event names within a small edit distance of
+// word, closest first.
+func nearestKeywords(word string) []string {
+	limit := 1
+	if len(word) > 4 {
+		limit = 2
+	}
+	candidates := make([]string, 0, len(keywordMap))
+	for kw := range keywordMap {
+		candidates = append(candidates, kw)
+	}
+	eventsMu.RLock()
+	for name := range events {
+		candidates = append(candidates, name)
+	}
+	eventsMu.RUnlock()
+
+	type match struct {
+		kw   string
+		dist int
+	}
+	var matches []match
+	for _, kw := range candidates {
+		if d := editDistance(word, kw); d > 0 && d <= limit && d < len(kw) {
+			matches = append(matches, match{kw, d})
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		if a.dist != b.dist {
+			return a.dist - b.dist
+		}
+		return strings.Compare(a.kw, b.kw)
+	})
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.kw
+	}
+	return out
+}
+
+// editDistance is the optimal string alignment distance between a and b: insertions,
+// deletions, substitutions, and transpositions of adjacent bytes each cost one.
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,52 @@
+package hron
+
+import "testing"
+
+func TestParseSuggestions(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every weekday 9:00", "every weekday at 09:00"},
+		{"evry day", "every day"},
+		{"evry day at 9:00", "every day at 09:00"},
+		{"evrey mondy 9am", "every monday at 09:00"},
+		{"every monday at 9:00 excpt dec 25", "every monday at 09:00 except dec 25"},
+		{"every month 1st 9:00", "every month on the 1st at 09:00"},
+		{"every 30 min 09:00 to 17:00", "every 30 min from 09:00 to 17:00"},
+		{"every day 9:00 untl 2026-01-01", "every day at 09:00 until 2026-01-01"},
+		// No repair helps
+		{"xyzzy", ""},
+		{"every day at 9:00 in", ""},
+		{"every day at 25:00", ""},
+	}
+	for _, tt := range tests {
+		_, err := ParseSchedule(tt.input)
+		herr, ok := err.(*HronError)
+		if !ok {
+			t.Errorf("ParseSchedule(%q) error = %v, want *HronError", tt.input, err)
+			continue
+		}
+		if herr.Suggestion != tt.want {
+			t.Errorf("ParseSchedule(%q) suggestion = %q, want %q", tt.input, herr.Suggestion, tt.want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"every", "every", 0},
+		{"evry", "every", 1},
+		{"evrey", "every", 1},
+		{"mondy", "monday", 1},
+		{"excpt", "except", 1},
+		{"abc", "", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package hron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimezoneAbbreviation(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"every day at 09:00 in EDT", "every day at 09:00 in America/New_York"},
+		{"every day at 09:00 in PDT", "every day at 09:00 in America/Los_Angeles"},
+		{"every day at 09:00 in jst", "every day at 09:00 in Asia/Tokyo"},
+		{"every day at 09:00 in CET, AEST", "every day at 09:00 in Europe/Paris, Australia/Sydney"},
+		{"every day at 09:00 in UTC", "every day at 09:00 in UTC"},
+	}
+	for _, tt := range tests {
+		data, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if got := Display(data); got != tt.want {
+			t.Errorf("%q: Display = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := Parse("every day at 09:00 in EDT, America/New_York"); err == nil {
+		t.Error("EDT listed with America/New_York parsed, want a duplicate error")
+	}
+}
+
+// EST, MST, and HST name fixed-offset IANA zones, so they keep their meaning and their name.
+func TestFixedTimezoneAbbreviation(t *testing.T) {
+	for _, tt := range []struct {
+		zone   string
+		offset int
+	}{{"EST", -5}, {"MST", -7}, {"HST", -10}} {
+		input := "every day at 09:00 in " + tt.zone
+		s, err := ParseSchedule(input)
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+			continue
+		}
+		if got := s.String(); got != input {
+			t.Errorf("%q: String = %q", input, got)
+		}
+		// No daylight saving time in July
+		next := s.NextFrom(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+		if want := time.Date(2026, 7, 1, 9-tt.offset, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+			t.Errorf("%q: NextFrom = %v, want %v", input, next, want)
+		}
+	}
+}
+
+func TestAmbiguousTimezoneAbbreviation(t *testing.T) {
+	input := "every day at 09:00 except dec 25 in IST"
+	_, err := Parse(input)
+	herr, ok := err.(*HronError)
+	if !ok {
+		t.Fatalf("Parse(%q) error = %v, want a HronError", input, err)
+	}
+	for _, zone := range []string{"Asia/Kolkata", "Asia/Jerusalem", "Europe/Dublin"} {
+		if !strings.Contains(herr.Message, zone) {
+			t.Errorf("message %q does not list %s", herr.Message, zone)
+		}
+	}
+	if herr.Span == nil || input[herr.Span.Start:herr.Span.End] != "IST" {
+		t.Errorf("span = %v, want the abbreviation", herr.Span)
+	}
+	if want := "every day at 09:00 except dec 25 in Asia/Kolkata"; herr.Suggestion != want {
+		t.Errorf("Suggestion = %q, want %q", herr.Suggestion, want)
+	}
+
+	_, errs := ParseAll("every day at 09:00 in UTC, BST")
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, "Europe/London") {
+		t.Errorf("ParseAll errors = %v, want the ambiguous BST", errs)
+	}
+}
@@ -0,0 +1,60 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalTimezonePlaceholder(t *testing.T) {
+	s := MustParse("every weekday at 09:00 in local")
+	if got := s.String(); got != "every weekday at 09:00 in local" {
+		t.Errorf("String() = %q", got)
+	}
+	if s.Timezone() != LocalTimezone {
+		t.Errorf("Timezone() = %q, want %q", s.Timezone(), LocalTimezone)
+	}
+
+	// Unbound schedules fail at evaluation, not construction
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	if err := s.Validate(); err == nil {
+		t.Error("expected Validate error for an unbound local timezone")
+	}
+	if s.NextFrom(now) != nil {
+		t.Error("NextFrom should return nil for an unbound local timezone")
+	}
+
+	tokyo, err := s.WithTimezone("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := s.WithTimezone("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tokyo.String(); got != "every weekday at 09:00 in local" {
+		t.Errorf("bound String() = %q, want the stored expression", got)
+	}
+	if tokyo.Timezone() != "Asia/Tokyo" {
+		t.Errorf("bound Timezone() = %q", tokyo.Timezone())
+	}
+
+	// Monday noon UTC is 21:00 in Tokyo and 13:00 in Berlin
+	if next := tokyo.NextFrom(now); next == nil || !next.Equal(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Tokyo NextFrom = %v, want 2026-03-03 00:00 UTC", next)
+	}
+	if next := berlin.NextFrom(now); next == nil || !next.Equal(time.Date(2026, 3, 3, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("Berlin NextFrom = %v, want 2026-03-03 08:00 UTC", next)
+	}
+	if s.NextFrom(now) != nil {
+		t.Error("WithTimezone must not modify the original schedule")
+	}
+}
+
+func TestWithTimezoneErrors(t *testing.T) {
+	if _, err := MustParse("every day at 09:00 in UTC").WithTimezone("Asia/Tokyo"); err == nil {
+		t.Error("expected error binding a schedule with a fixed timezone")
+	}
+	if _, err := MustParse("every day at 09:00 in local").WithTimezone("Not/AZone"); err == nil {
+		t.Error("expected error for an unknown timezone")
+	}
+}
@@ -0,0 +1,7 @@
+//go:build hron_tzdata
+
+package hron
+
+// Embed Go's copy of the zoneinfo database, used when the system has none; see
+// SetZoneProvider.
+import _ "time/tzdata"
@@ -0,0 +1,79 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUntilTimeParseDisplay(t *testing.T) {
+	tests := []struct {
+		input, canonical string
+	}{
+		{"every day at 09:00 until 2026-06-30 17:00", "every day at 09:00 until 2026-06-30 17:00"},
+		{"every day at 09:00 until 2026-06-30 at 5pm", "every day at 09:00 until 2026-06-30 17:00"},
+		{"every weekday at 09:00 until dec 24 noon in UTC", "every weekday at 09:00 until dec 24 12:00 in UTC"},
+		{"every hour until tomorrow 18:00", "every 1 hour from 00:00 to 23:59 until tomorrow 18:00"},
+	}
+	for _, tc := range tests {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tc.input, err)
+			continue
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("ParseSchedule(%q) does not roundtrip: %v", tc.input, err)
+		}
+	}
+
+	for _, input := range []string{
+		"every day at 09:00 until 2026-06-30 at",
+		"every day at 09:00 until 2026-06-30 25:00",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestUntilTimeEval(t *testing.T) {
+	s := MustParse("every day at 09:00, 18:00 until 2026-06-30 17:00 in UTC")
+	got := s.NextNFrom(time.Date(2026, 6, 29, 12, 0, 0, 0, time.UTC), 5)
+	want := []time.Time{
+		time.Date(2026, 6, 29, 18, 0, 0, 0, time.UTC),
+		time.Date(2026, 6, 30, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	now := time.Date(2026, 7, 5, 0, 0, 0, 0, time.UTC)
+	if prev := s.PreviousFrom(now); prev == nil || !prev.Equal(want[1]) {
+		t.Errorf("PreviousFrom(%v) = %v, want %v", now, prev, want[1])
+	}
+	if s.Matches(time.Date(2026, 6, 30, 18, 0, 0, 0, time.UTC)) {
+		t.Error("Matches(2026-06-30 18:00) = true after the until time")
+	}
+
+	// The until time itself is still allowed, in the schedule's timezone
+	interval := MustParse("every 30 min from 09:00 to 17:00 until 2026-06-30 10:00 in America/New_York")
+	ny, _ := time.LoadLocation("America/New_York")
+	last := interval.PreviousFrom(time.Date(2026, 7, 1, 0, 0, 0, 0, ny))
+	if want := time.Date(2026, 6, 30, 10, 0, 0, 0, ny); last == nil || !last.Equal(want) {
+		t.Errorf("PreviousFrom = %v, want %v", last, want)
+	}
+}
+
+func TestUntilTimeDescribe(t *testing.T) {
+	s := MustParse("every day at 09:00 until 2026-06-30 17:00")
+	if got, want := s.Describe(), "Runs at 9:00 AM every day, until June 30, 2026 at 5:00 PM"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUTCOffsetTimezone(t *testing.T) {
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		tz   string
+		want time.Time
+	}{
+		{"UTC+05:30", time.Date(2026, 7, 1, 3, 30, 0, 0, time.UTC)},
+		{"UTC+0530", time.Date(2026, 7, 1, 3, 30, 0, 0, time.UTC)},
+		{"GMT-8", time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC)},
+		{"utc-03", time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{"GMT+0", time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule("every day at 09:00 in " + tt.tz)
+		if err != nil {
+			t.Errorf("%s: %v", tt.tz, err)
+			continue
+		}
+		if got := s.NextFrom(from); got == nil || !got.Equal(tt.want) {
+			t.Errorf("%s: NextFrom = %v, want %v", tt.tz, got, tt.want)
+		}
+		if got := s.Timezone(); got != tt.tz {
+			t.Errorf("%s: Timezone() = %q", tt.tz, got)
+		}
+	}
+
+	for _, tz := range []string{"UTC+15", "UTC+5:3", "GMT+123", "UTC+05:60"} {
+		if _, err := ParseSchedule("every day at 09:00 in " + tz); err == nil {
+			t.Errorf("%s: ParseSchedule succeeded, want an error", tz)
+		}
+	}
+
+	s := MustParse("every day at 09:00 in UTC+05:30")
+	if got, want := s.Describe(), "Runs at 9:00 AM every day, in UTC+05:30"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if _, _, err := s.ToKubernetesCron(); err == nil {
+		t.Error("ToKubernetesCron accepted an offset without an IANA zone")
+	}
+	k, _, err := MustParse("every day at 09:00 in GMT-8").ToKubernetesCron()
+	if err != nil || k.TimeZone != "Etc/GMT+8" {
+		t.Errorf("ToKubernetesCron = %+v, %v, want time zone Etc/GMT+8", k, err)
+	}
+}
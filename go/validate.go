@@ -0,0 +1,263 @@
+package hron
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// FindingKind identifies the problem a ValidationFinding reports.
+type FindingKind int
+
+const (
+	// FindingDeadSchedule is an until date before the starting date: nothing ever runs.
+	FindingDeadSchedule FindingKind = iota
+	// FindingDuplicateTime is a time of day listed more than once.
+	FindingDuplicateTime
+	// FindingImpossibleDay is a day of the month that none of the during months has, such
+	// as the 31st during apr, jun.
+	FindingImpossibleDay
+	// FindingMisalignedAnchor is a starting date the schedule does not run on, so the
+	// interval is counted from a day that is not an occurrence.
+	FindingMisalignedAnchor
+	// FindingIntervalWindow is an interval window that ends before it starts without
+	// `next day`, so nothing ever runs, or that is shorter than one step, so it runs once
+	// a day.
+	FindingIntervalWindow
+)
+
+func (k FindingKind) String() string {
+	switch k {
+	case FindingDeadSchedule:
+		return "dead-schedule"
+	case FindingDuplicateTime:
+		return "duplicate-time"
+	case FindingImpossibleDay:
+		return "impossible-day"
+	case FindingIntervalWindow:
+		return "interval-window"
+	default:
+		return "misaligned-anchor"
+	}
+}
+
+// ValidationFinding is a problem in an expression that parses, with the span of input it
+// is about.
+type ValidationFinding struct {
+	Kind    FindingKind
+	Message string
+	Span    Span
+}
+
+// ValidationReport is what ValidateDetailed found in an expression that parses.
+type ValidationReport struct {
+	Schedule *ScheduleData
+	Findings []ValidationFinding // Ordered by position; empty when nothing is wrong
+}
+
+// ValidateDetailed parses input and checks it for expressions that parse but do not do
+// what they seem to: an until date before the starting date, a time listed twice, a day
+// of the month no during month has, an interval repeat whose starting date is not one of
+// its days, and an interval window that never opens or is shorter than one step. It returns the parse error, or the report of what it found; a timezone that
+// does not load is an EvalError.
+func ValidateDetailed(input string) (*ValidationReport, error) {
+	data, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewSchedule(data)
+	if err != nil {
+		return nil, err
+	}
+	tokens, _ := Tokenize(input)
+	v := &validator{data: data, tokens: tokens, input: input}
+	v.checkDead()
+	v.checkDuplicateTimes()
+	v.checkImpossibleDays()
+	v.checkAnchor(s)
+	v.checkIntervalWindow()
+	slices.SortStableFunc(v.findings, func(a, b ValidationFinding) int { return a.Span.Start - b.Span.Start })
+	return &ValidationReport{Schedule: data, Findings: v.findings}, nil
+}
+
+// validator collects the findings of ValidateDetailed.
+type validator struct {
+	data     *ScheduleData
+	tokens   []Token
+	input    string
+	findings []ValidationFinding
+}
+
+func (v *validator) add(kind FindingKind, span Span, format string, args ...any) {
+	v.findings = append(v.findings, ValidationFinding{Kind: kind, Message: fmt.Sprintf(format, args...), Span: span})
+}
+
+// headTokens returns the tokens of an expression before its trailing clauses.
+func headTokens(tokens []Token) []Token {
+	for i, tok := range tokens {
+		if isClauseKeyword(tok.Kind) {
+			return tokens[:i]
+		}
+	}
+	return tokens
+}
+
+// clauseTokens returns the tokens of the trailing clause starting with keyword, up to the
+// next clause, or nil if there is none.
+func clauseTokens(tokens []Token, keyword TokenKind) []Token {
+	for i, tok := range tokens {
+		if tok.Kind != keyword {
+			continue
+		}
+		end := i + 1
+		for end < len(tokens) && !isClauseKeyword(tokens[end].Kind) {
+			end++
+		}
+		return tokens[i:end]
+	}
+	return nil
+}
+
+// clauseSpan returns the span of the trailing clause starting with keyword, or of the
+// whole input if there is none.
+func (v *validator) clauseSpan(keyword TokenKind) Span {
+	clause := clauseTokens(v.tokens, keyword)
+	if clause == nil {
+		return Span{0, len(v.input)}
+	}
+	return Span{clause[0].Span.Start, clause[len(clause)-1].Span.End}
+}
+
+func isClauseKeyword(kind TokenKind) bool {
+	switch kind {
+	case TokenPlus, TokenMinus, TokenAligned, TokenExcept, TokenUntil, TokenStarting, TokenDuring, TokenIn:
+		return true
+	}
+	return false
+}
+
+// checkDead reports an until date before the starting date. Only ISO dates are compared;
+// relative and named dates depend on when the schedule is evaluated.
+func (v *validator) checkDead() {
+	until := v.data.Until
+	if until == nil || until.Kind != UntilSpecKindISO || v.data.Anchor == "" || until.Date >= v.data.Anchor {
+		return
+	}
+	v.add(FindingDeadSchedule, v.clauseSpan(TokenUntil), "until %s is before starting %s, so the schedule never runs", until.Date, v.data.Anchor)
+}
+
+// checkDuplicateTimes reports each repeat of a time of day in the at list.
+func (v *validator) checkDuplicateTimes() {
+	if v.data.Expr.Kind == ScheduleExprKindInterval {
+		// The times of an interval repeat are its window, which checkIntervalWindow checks
+		return
+	}
+	seen := map[TimeOfDay]bool{}
+	for _, tok := range headTokens(v.tokens) {
+		if tok.Kind != TokenTime {
+			continue
+		}
+		t := TimeOfDay{Hour: tok.TimeHour, Minute: tok.TimeMinute}
+		if seen[t] {
+			v.add(FindingDuplicateTime, tok.Span, "%s is listed more than once", t)
+		}
+		seen[t] = true
+	}
+}
+
+// checkImpossibleDays reports days of a monthly repeat, or ends of a range of days, that
+// are past the end of every during month.
+func (v *validator) checkImpossibleDays() {
+	longest := longestDuringMonth(v.data)
+	if longest == 0 {
+		return
+	}
+	for _, tok := range headTokens(v.tokens) {
+		if tok.Kind == TokenOrdinalNumber && tok.NumberVal > longest {
+			v.add(FindingImpossibleDay, tok.Span, "no during month has a %s day (the longest has %d days)", ordinalNumber(tok.NumberVal), longest)
+		}
+	}
+}
+
+// longestDuringMonth returns the most days any during month has, in a leap year, for a
+// monthly repeat on days of the month bounded only by during months. It returns 0 for
+// other schedules.
+func longestDuringMonth(data *ScheduleData) int {
+	expr := data.Expr
+	months := duringMonths(data)
+	if expr.Kind != ScheduleExprKindMonth || expr.MonthTarget.Kind != MonthTargetKindDays || len(months) == 0 || !duringMonthsOnly(data) {
+		return 0
+	}
+	longest := 0
+	for _, m := range months {
+		longest = max(longest, time.Date(2024, time.Month(m.Number())+1, 0, 0, 0, 0, 0, time.UTC).Day())
+	}
+	return longest
+}
+
+// checkAnchor reports an interval repeat whose starting date is not one of its days.
+// Schedules that need a holiday calendar, solar provider, or fiscal calendar to evaluate
+// are not checked.
+func (v *validator) checkAnchor(s *Schedule) {
+	data := v.data
+	if data.Anchor == "" || data.Expr.Interval < 2 || usesHolidays(data) || usesSolar(data) || usesFiscal(data) {
+		return
+	}
+	anchor, err := time.Parse("2006-01-02", data.Anchor)
+	if err != nil {
+		return
+	}
+	if s.location == nil {
+		s, _ = s.WithTimezone("UTC")
+	}
+	loc := s.location
+	next := s.NextFromInclusive(localMidnight(anchor, loc))
+	if next == nil {
+		return
+	}
+	if first := dateOnly(next.In(loc)); !first.Equal(anchor) {
+		v.add(FindingMisalignedAnchor, v.clauseSpan(TokenStarting), "the schedule does not run on its starting date %s; the interval counts from it, and the first run is %s", data.Anchor, first.Format("2006-01-02"))
+	}
+}
+
+// checkIntervalWindow reports an interval window that ends before it starts without
+// `next day`, or that one step of the interval does not fit in.
+func (v *validator) checkIntervalWindow() {
+	expr := v.data.Expr
+	if expr.Kind != ScheduleExprKindInterval {
+		return
+	}
+	start, end := intervalWindowSeconds(expr.FromTime, expr.ToTime, expr.Overnight)
+	span := intervalWindowSpan(headTokens(v.tokens))
+	switch {
+	case end < start:
+		v.add(FindingIntervalWindow, span, "the window %s to %s ends before it starts, so the schedule never runs; add 'next day' to run past midnight", expr.FromTime, expr.ToTime)
+	case expr.Unit.Seconds(expr.Interval) > end-start:
+		v.add(FindingIntervalWindow, span, "a step of %d %s is longer than the window %s to %s, so the schedule runs only at %s",
+			expr.Interval, unitDisplay(expr.Interval, expr.Unit), expr.FromTime, expr.ToTime, expr.FromTime)
+	}
+}
+
+// intervalWindowSpan returns the span of the from/to window among the head tokens of an
+// interval repeat, or of all of them when the window is implied.
+func intervalWindowSpan(head []Token) Span {
+	first := slices.IndexFunc(head, func(t Token) bool { return t.Kind == TokenFrom }) + 1
+	for i := 1; first == 0 && i < len(head); i++ {
+		// An inline window: the time before 'to' opens it
+		if head[i].Kind == TokenTo && head[i-1].Kind == TokenTime {
+			first = i - 1
+		}
+	}
+	if first == 0 || first >= len(head) {
+		return Span{head[0].Span.Start, head[len(head)-1].Span.End}
+	}
+	// The window runs to a day filter, the step of an inline window, or the clauses
+	last := len(head) - 1
+	for i := first; i < len(head); i++ {
+		if head[i].Kind == TokenOn || head[i].Kind == TokenEvery {
+			last = i - 1
+			break
+		}
+	}
+	return Span{head[first].Span.Start, head[last].Span.End}
+}
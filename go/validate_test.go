@@ -0,0 +1,56 @@
+package hron
+
+import "testing"
+
+func TestValidateDetailed(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  FindingKind
+		span  string
+	}{
+		{"every day at 09:00 until 2026-01-01 starting 2026-06-01", FindingDeadSchedule, "until 2026-01-01"},
+		{"every day at 09:00, 12:00, 9am", FindingDuplicateTime, "9am"},
+		{"every month on the 30th, 31st at 09:00 during apr, jun", FindingImpossibleDay, "31st"},
+		{"every month on the 1st to 30th at 09:00 during feb", FindingImpossibleDay, "30th"},
+		{"every 2 weeks on monday at 09:00 starting 2026-03-04", FindingMisalignedAnchor, "starting 2026-03-04"},
+		{"every 3 months on the 1st at 09:00 starting 2026-01-15 in local", FindingMisalignedAnchor, "starting 2026-01-15"},
+		{"every 2 hours from 17:00 to 09:00 on weekdays", FindingIntervalWindow, "17:00 to 09:00"},
+		{"every 30 min from 09:00 to 09:00", FindingIntervalWindow, "09:00 to 09:00"},
+		{"every 2 hours from 09:00 to 10:00 in UTC", FindingIntervalWindow, "09:00 to 10:00"},
+		{"every weekday at 22:00 to end of day every 3 hours", FindingIntervalWindow, "22:00 to end of day"},
+		{"every 25 hours", FindingIntervalWindow, "every 25 hours"},
+	}
+	for _, tt := range tests {
+		report, err := ValidateDetailed(tt.input)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if len(report.Findings) != 1 {
+			t.Errorf("%q: findings = %+v, want one", tt.input, report.Findings)
+			continue
+		}
+		f := report.Findings[0]
+		if f.Kind != tt.kind || tt.input[f.Span.Start:f.Span.End] != tt.span {
+			t.Errorf("%q: got %v at %q, want %v at %q", tt.input, f.Kind, tt.input[f.Span.Start:f.Span.End], tt.kind, tt.span)
+		}
+	}
+
+	for _, input := range []string{
+		"every day at 09:00, 12:00 until 2026-06-01 starting 2026-01-01",
+		"every month on the 30th at 09:00 during apr, jun",
+		"every 2 weeks on monday at 09:00 starting 2026-03-02",
+		"every 2 days at 09:00 starting 2026-03-04",
+		"every 2 hours from 17:00 to 09:00 next day",
+		"every 23 hours from 00:00 to 23:59",
+	} {
+		report, err := ValidateDetailed(input)
+		if err != nil || len(report.Findings) != 0 {
+			t.Errorf("%q: findings = %+v, err = %v, want none", input, report, err)
+		}
+	}
+
+	if _, err := ValidateDetailed("every day at 25:00"); err == nil {
+		t.Error("ValidateDetailed accepted an invalid expression")
+	}
+}
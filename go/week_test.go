@@ -0,0 +1,63 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekRepeatDays(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every week on weekdays at 09:00", "every week on monday, tuesday, wednesday, thursday, friday at 09:00"},
+		{"every week on weekend at 10:00", "every week on saturday, sunday at 10:00"},
+		{"every 2 weeks on weekday at 09:00", "every 2 weeks on monday, tuesday, wednesday, thursday, friday at 09:00"},
+		{"every 1 week on thursday, monday at 09:00", "every week on thursday, monday at 09:00"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := s.String(); got != tt.want {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("ParseSchedule(%q) does not roundtrip: %v", tt.input, err)
+		}
+	}
+
+	// A weekly repeat on listed days runs on the same days as the day repeat
+	week := MustParse("every week on monday, thursday at 09:00 in UTC")
+	days := MustParse("every monday, thursday at 09:00 in UTC")
+	from := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	got, want := week.NextNFrom(from, 6), days.NextNFrom(from, 6)
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWeekRepeatToCron(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"every week on monday, thursday at 09:00", "0 9 * * 1,4"},
+		{"every week on weekdays at 09:00", "0 9 * * 1-5"},
+		{"every week on saturday at 08:00, 20:00 during jun", "0 8,20 * 6 6"},
+	}
+	for _, tt := range tests {
+		got, err := MustParse(tt.input).ToCron()
+		if err != nil || got != tt.want {
+			t.Errorf("ToCron(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+		}
+	}
+	if _, err := MustParse("every 2 weeks on monday at 09:00").ToCron(); err == nil {
+		t.Error("ToCron of a multi-week repeat succeeded, want error")
+	}
+}
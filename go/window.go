@@ -0,0 +1,109 @@
+package hron
+
+import (
+	"time"
+)
+
+// TimeRange represents a half-open span of time [Start, End).
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the range.
+func (r TimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Complement returns the gaps between the active windows of the schedule within [from, to).
+// Only interval repeats have window semantics: each matching day is active from the
+// window's start time to its end time. Other expressions return an EvalError.
+func (s *Schedule) Complement(from, to time.Time) ([]TimeRange, error) {
+	if s.data.Expr.Kind != ScheduleExprKindInterval {
+		return nil, EvalError("complement requires an interval schedule with a from/to window")
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	var gaps []TimeRange
+	cursor := from
+	for _, w := range activeWindows(s.plan(), s.calendar, from, to) {
+		if w.Start.After(cursor) {
+			gaps = append(gaps, TimeRange{Start: cursor, End: w.Start})
+		}
+		if w.End.After(cursor) {
+			cursor = w.End
+		}
+	}
+	if to.After(cursor) {
+		gaps = append(gaps, TimeRange{Start: cursor, End: to})
+	}
+	return gaps, nil
+}
+
+// Gaps returns the stretches within [from, to) longer than maxGap that contain no
+// occurrence of the schedule, so a monitoring check can be verified to have no blind
+// windows. A gap runs from one occurrence (or from) to the next occurrence (or to).
+// A schedule that fails Validate has no occurrences, so all of [from, to) is one gap.
+func Gaps(schedule *Schedule, from, to time.Time, maxGap time.Duration) []TimeRange {
+	if !to.After(from) {
+		return nil
+	}
+	var gaps []TimeRange
+	cursor := from
+	// Occurrences is strictly after its argument; step back so one at from closes no gap
+	for occ := range schedule.Occurrences(from.Add(-time.Nanosecond)) {
+		if !occ.Before(to) {
+			break
+		}
+		if occ.Sub(cursor) > maxGap {
+			gaps = append(gaps, TimeRange{Start: cursor, End: occ})
+		}
+		cursor = occ
+	}
+	if to.Sub(cursor) > maxGap {
+		gaps = append(gaps, TimeRange{Start: cursor, End: to})
+	}
+	return gaps
+}
+
+// Gaps returns the stretches within [from, to) longer than maxGap without an occurrence;
+// see the Gaps function.
+func (s *Schedule) Gaps(from, to time.Time, maxGap time.Duration) []TimeRange {
+	return Gaps(s, from, to, maxGap)
+}
+
+// activeWindows returns the daily from/to windows of an interval schedule, clipped to [from, to).
+func activeWindows(p *evalPlan, cal HolidayCalendar, from, to time.Time) []TimeRange {
+	schedule, loc := p.data, p.loc
+	// Windows are found unshifted, like occurrences, and moved by the offset last
+	from, to = from.Add(-p.offset), to.Add(-p.offset)
+	var windows []TimeRange
+	d := dateOnly(from.In(loc)).AddDate(0, 0, -1)
+	last := dateOnly(to.In(loc))
+
+	for !d.After(last) {
+		start := atTimeOnDate(d, schedule.Expr.FromTime, loc)
+		end := atTimeOnDate(d, schedule.Expr.ToTime, loc)
+		if schedule.Expr.Overnight {
+			end = atTimeOnDate(d.AddDate(0, 0, 1), schedule.Expr.ToTime, loc)
+		}
+		d = d.AddDate(0, 0, 1)
+
+		// The first slot of the window matches exactly when the whole day is active
+		if !matches(p, cal, start.Add(p.offset)) {
+			continue
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			windows = append(windows, TimeRange{Start: start.Add(p.offset), End: end.Add(p.offset)})
+		}
+	}
+	return windows
+}
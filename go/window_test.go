@@ -0,0 +1,108 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComplementBusinessHours(t *testing.T) {
+	s, err := ParseSchedule("every 30 min from 09:00 to 17:00 on weekdays")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Friday 2026-02-06 through Monday 2026-02-09 noon
+	from := time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC)
+	gaps, err := s.Complement(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []TimeRange{
+		{Start: from, End: time.Date(2026, 2, 6, 9, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2026, 2, 6, 17, 0, 0, 0, time.UTC), End: time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC)},
+	}
+	if len(gaps) != len(want) {
+		t.Fatalf("Complement() = %v, want %v", gaps, want)
+	}
+	for i := range want {
+		if !gaps[i].Start.Equal(want[i].Start) || !gaps[i].End.Equal(want[i].End) {
+			t.Errorf("gap[%d] = %v, want %v", i, gaps[i], want[i])
+		}
+	}
+}
+
+func TestComplementRespectsExcept(t *testing.T) {
+	s, err := ParseSchedule("every 1 hour from 09:00 to 17:00 except 2026-02-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2026, 2, 9, 17, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 11, 9, 0, 0, 0, time.UTC)
+	gaps, err := s.Complement(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gaps) != 1 || gaps[0].Duration() != 40*time.Hour {
+		t.Errorf("Complement() = %v, want a single 40h gap", gaps)
+	}
+}
+
+func TestComplementRequiresWindow(t *testing.T) {
+	s := MustParse("every day at 09:00")
+	from := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	if _, err := s.Complement(from, from.Add(time.Hour)); err == nil {
+		t.Error("expected error for schedule without a window")
+	}
+}
+
+func TestComplementAcrossMidnight(t *testing.T) {
+	s := MustParse("every 30 min from 22:00 to 02:00 next day in UTC")
+
+	from := time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	gaps, err := s.Complement(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TimeRange{Start: time.Date(2026, 2, 9, 2, 0, 0, 0, time.UTC), End: time.Date(2026, 2, 9, 22, 0, 0, 0, time.UTC)}
+	if len(gaps) != 1 || !gaps[0].Start.Equal(want.Start) || !gaps[0].End.Equal(want.End) {
+		t.Errorf("Complement() = %v, want [%v]", gaps, want)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	s := MustParse("every 1 hour from 09:00 to 17:00 in UTC")
+
+	from := time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	gaps := s.Gaps(from, to, 2*time.Hour)
+	want := []TimeRange{
+		{Start: from, End: time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2026, 2, 9, 17, 0, 0, 0, time.UTC), End: to},
+	}
+	if len(gaps) != len(want) {
+		t.Fatalf("Gaps() = %v, want %v", gaps, want)
+	}
+	for i := range want {
+		if !gaps[i].Start.Equal(want[i].Start) || !gaps[i].End.Equal(want[i].End) {
+			t.Errorf("Gaps()[%d] = %v, want %v", i, gaps[i], want[i])
+		}
+	}
+
+	if gaps := s.Gaps(from, to, 9*time.Hour); len(gaps) != 0 {
+		t.Errorf("Gaps(9h) = %v, want none", gaps)
+	}
+}
+
+func TestGapsWithoutOccurrences(t *testing.T) {
+	s := MustParse("every day at 09:00 except holidays in UTC") // No calendar attached
+	from := time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+	gaps := Gaps(s, from, to, time.Hour)
+	if len(gaps) != 1 || !gaps[0].Start.Equal(from) || !gaps[0].End.Equal(to) {
+		t.Errorf("Gaps() = %v, want all of [from, to)", gaps)
+	}
+}
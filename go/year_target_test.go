@@ -0,0 +1,86 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearTargetListParseDisplay(t *testing.T) {
+	tests := []struct {
+		input, canonical string
+	}{
+		{"every jan 15 and jul 15 at 09:00", "every year on jan 15, jul 15 at 09:00"},
+		{"every year on jan 15, jul 15 at 9:00", "every year on jan 15, jul 15 at 09:00"},
+		{"every dec 24 at 18:00", "every year on dec 24 at 18:00"},
+		{"every year on the first monday of sep and dec 25 at 09:00", "every year on the first monday of sep, dec 25 at 09:00"},
+		{"every 2 years on mar 1, the last weekday of nov at 08:00", "every 2 years on mar 1, the last weekday of nov at 08:00"},
+	}
+	for _, tc := range tests {
+		s, err := ParseSchedule(tc.input)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tc.input, err)
+			continue
+		}
+		if got := s.String(); got != tc.canonical {
+			t.Errorf("ParseSchedule(%q).String() = %q, want %q", tc.input, got, tc.canonical)
+		}
+		if err := CheckRoundtrip(s.Data()); err != nil {
+			t.Errorf("ParseSchedule(%q) does not roundtrip: %v", tc.input, err)
+		}
+	}
+
+	for _, input := range []string{
+		"every jan 15 and at 09:00",
+		"every year on jan 15, feb 30 at 09:00",
+		"every jan 15, at 09:00",
+	} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestYearTargetListEval(t *testing.T) {
+	// Targets out of order still run in date order
+	s := MustParse("every jul 15 and jan 15 at 09:00 in UTC")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := s.NextNFrom(from, 3)
+	want := []time.Time{
+		time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 15, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 15, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	now := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	if prev := s.PreviousFrom(now); prev == nil || !prev.Equal(want[1]) {
+		t.Errorf("PreviousFrom(%v) = %v, want %v", now, prev, want[1])
+	}
+	if !s.Matches(want[1]) || s.Matches(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Matches does not follow the listed dates")
+	}
+
+	// Feb 29 only exists in leap years; the other target still runs
+	leap := MustParse("every year on feb 29, mar 1 at 12:00 in UTC")
+	next := leap.NextFrom(time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2027, 3, 1, 12, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", next, want)
+	}
+}
+
+func TestYearTargetListNormalize(t *testing.T) {
+	got := MustParse("every year on jul 15, jan 15, jul 15 at 09:00").Normalize().String()
+	if want := "every year on jan 15, jul 15 at 09:00"; got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+	if !Equal(MustParse("every jan 15 and jul 15 at 09:00"), MustParse("every year on jul 15, jan 15 at 09:00")) {
+		t.Error("Equal = false for the same dates in another order")
+	}
+}
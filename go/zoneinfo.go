@@ -0,0 +1,59 @@
+package hron
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ZoneProvider loads IANA timezones by name for the in clause and WithTimezone, in place
+// of the system zoneinfo database. Offset literals (UTC+05:30) are resolved without one.
+type ZoneProvider interface {
+	LoadLocation(name string) (*time.Location, error)
+}
+
+// zoneProvider is the provider set with SetZoneProvider, or nil for time.LoadLocation.
+var zoneProvider atomic.Pointer[ZoneProvider]
+
+// SetZoneProvider makes schedules parsed from now on load their timezones with zp, for
+// programs that ship zone data of their own; nil restores time.LoadLocation. Zones
+// already loaded are forgotten, but schedules already parsed keep theirs.
+//
+// Containers built from scratch have no zoneinfo database, so time.LoadLocation fails for
+// every IANA name there. Building with -tags hron_tzdata embeds Go's copy of the
+// database (time/tzdata, about 450 KB) as a fallback instead.
+func SetZoneProvider(zp ZoneProvider) {
+	if zp == nil {
+		zoneProvider.Store(nil)
+	} else {
+		zoneProvider.Store(&zp)
+	}
+	locations.Clear()
+}
+
+// loadZone loads the IANA timezone name with the zone provider. It returns an EvalError
+// that says so when the zone is missing because there is no zoneinfo database at all.
+func loadZone(name string) (*time.Location, error) {
+	load := time.LoadLocation
+	if zp := zoneProvider.Load(); zp != nil {
+		load = (*zp).LoadLocation
+	}
+	loc, err := load(name)
+	if err == nil {
+		return loc, nil
+	}
+	message := "unknown timezone: " + name
+	if zoneProvider.Load() == nil && !hasZoneinfo() {
+		message += " (no zoneinfo database found; build with -tags hron_tzdata or use SetZoneProvider)"
+	}
+	herr := EvalError(message)
+	herr.cause = err
+	return nil, herr
+}
+
+// hasZoneinfo reports whether time.LoadLocation finds a zoneinfo database, probing a zone
+// every copy of it has.
+var hasZoneinfo = sync.OnceValue(func() bool {
+	_, err := time.LoadLocation("America/New_York")
+	return err == nil
+})
@@ -0,0 +1,51 @@
+package hron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// mapZones is a ZoneProvider serving a fixed set of zones.
+type mapZones map[string]*time.Location
+
+func (m mapZones) LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := m[name]; ok {
+		return loc, nil
+	}
+	return nil, errors.New("no such zone")
+}
+
+func TestZoneProvider(t *testing.T) {
+	SetZoneProvider(mapZones{"Office/Main": time.FixedZone("Office/Main", 2*3600)})
+	t.Cleanup(func() { SetZoneProvider(nil) })
+
+	s, err := ParseSchedule("every day at 09:00 in Office/Main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	if got, want := s.NextFrom(from), time.Date(2026, 7, 1, 7, 0, 0, 0, time.UTC); got == nil || !got.Equal(want) {
+		t.Errorf("NextFrom = %v, want %v", got, want)
+	}
+	if _, err := ParseSchedule("every day at 09:00 in UTC+01:00"); err != nil {
+		t.Errorf("offset literal with a zone provider: %v", err)
+	}
+
+	_, err = ParseSchedule("every day at 09:00 in America/New_York")
+	var herr *HronError
+	if !errors.As(err, &herr) || herr.Kind != ErrorKindEval {
+		t.Fatalf("zone the provider lacks: err = %v, want an EvalError", err)
+	}
+	if herr.Message != "unknown timezone: America/New_York" {
+		t.Errorf("Message = %q", herr.Message)
+	}
+
+	SetZoneProvider(nil)
+	if _, err := ParseSchedule("every day at 09:00 in Office/Main"); err == nil {
+		t.Error("zone of a removed provider is still cached")
+	}
+	if _, err := ParseSchedule("every day at 09:00 in America/New_York"); err != nil {
+		t.Errorf("after restoring time.LoadLocation: %v", err)
+	}
+}
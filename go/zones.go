@@ -0,0 +1,88 @@
+package hron
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// resolveZones returns the location of every zone of an in clause listing several, or
+// nil for a schedule in one zone or none.
+func resolveZones(data *ScheduleData) ([]*time.Location, error) {
+	if len(data.Timezones) < 2 {
+		return nil, nil
+	}
+	zones := make([]*time.Location, len(data.Timezones))
+	for i, tz := range data.Timezones {
+		loc, err := resolveTimezone(tz)
+		if err != nil {
+			return nil, err
+		}
+		zones[i] = loc
+	}
+	return zones, nil
+}
+
+// zonePlans returns the plan of the schedule in each zone of its in clause, as if each
+// zone were its only one.
+func (s *Schedule) zonePlans() []*evalPlan {
+	plans := make([]*evalPlan, len(s.zones))
+	for i, loc := range s.zones {
+		data := *s.data
+		data.Timezone, data.Timezones = s.data.Timezones[i], nil
+		plans[i] = compilePlan(&data, loc, s.reference).attach(s.filter, s.solar, s.fiscal, s.eventSource())
+	}
+	return plans
+}
+
+// nextAcrossZones returns the earliest next occurrence of the schedule in any of its
+// zones. An instant that is an occurrence in several zones is returned once.
+func nextAcrossZones(ctx context.Context, p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (time.Time, bool, error) {
+	var best time.Time
+	found := false
+	for _, zone := range p.zones {
+		t, ok, err := nextTimeCtx(ctx, zone, cal, opts, now)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if ok && (!found || t.Before(best)) {
+			best, found = t, true
+		}
+	}
+	return best, found, nil
+}
+
+// prevAcrossZones returns the latest previous occurrence of the schedule in any of its
+// zones.
+func prevAcrossZones(p *evalPlan, cal HolidayCalendar, opts EvalOptions, now time.Time) (*time.Time, error) {
+	var best *time.Time
+	for _, zone := range p.zones {
+		t, err := previousFromErr(zone, cal, opts, now)
+		if err != nil {
+			return nil, err
+		}
+		if t != nil && (best == nil || t.After(*best)) {
+			best = t
+		}
+	}
+	return best, nil
+}
+
+// matchesAcrossZones checks if dt is an occurrence of the schedule in any of its zones.
+func matchesAcrossZones(p *evalPlan, cal HolidayCalendar, dt time.Time) bool {
+	return slices.ContainsFunc(p.zones, func(zone *evalPlan) bool {
+		return matches(zone, cal, dt)
+	})
+}
+
+// occurrenceLocation returns the zone t is an occurrence in: the schedule's zone, or the
+// first listed zone it matches in.
+func (s *Schedule) occurrenceLocation(t time.Time) *time.Location {
+	p := s.plan()
+	for _, zone := range p.zones {
+		if matches(zone, s.calendar, t) {
+			return zone.loc
+		}
+	}
+	return s.location
+}
@@ -0,0 +1,83 @@
+package hron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimezoneList(t *testing.T) {
+	s := MustParse("every weekday at 09:00 in America/New_York, Europe/London, Asia/Tokyo")
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday; 09:00 in Tokyo
+	want := []time.Time{
+		time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),  // London
+		time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC), // New York
+		time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),  // Tokyo
+		time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC),
+	}
+	got := s.NextNFrom(from, len(want))
+	if len(got) != len(want) {
+		t.Fatalf("NextNFrom = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("NextNFrom[%d] = %v, want %v", i, got[i].UTC(), want[i])
+		}
+		if !s.Matches(got[i]) {
+			t.Errorf("Matches(%v) = false", got[i])
+		}
+	}
+	if s.Matches(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Error("Matches accepted 10:00 London")
+	}
+	if prev := s.PreviousFrom(from); prev == nil || !prev.Equal(time.Date(2026, 2, 27, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("PreviousFrom = %v, want friday 09:00 in New York", prev)
+	}
+	for i, occ := range s.OccurrencesDetailed(from) {
+		if occ.Time.Hour() != 9 {
+			t.Errorf("occurrence %d at %v, want 09:00 in its zone", i, occ.Time)
+		}
+		if i == 3 {
+			break
+		}
+	}
+
+	// An instant that is an occurrence in two zones is returned once
+	winter := MustParse("every day at 09:00 in UTC, Europe/London")
+	if got := winter.NextNFrom(from, 2); len(got) != 2 || !got[1].Equal(got[0].AddDate(0, 0, 1)) {
+		t.Errorf("NextNFrom in UTC and London = %v, want one occurrence a day", got)
+	}
+
+	if got := s.String(); got != "every weekday at 09:00 in America/New_York, Europe/London, Asia/Tokyo" {
+		t.Errorf("String() = %q", got)
+	}
+	if got, want := s.Describe(), "Runs at 9:00 AM on weekdays, in New York time, London time, and Tokyo time"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	if got := s.Timezones(); len(got) != 3 || s.Timezone() != "America/New_York" {
+		t.Errorf("Timezones() = %v, Timezone() = %q", got, s.Timezone())
+	}
+	if _, err := s.ToCron(); err == nil {
+		t.Error("ToCron accepted several timezones")
+	}
+	if err := CheckRoundtrip(s.Data()); err != nil {
+		t.Error(err)
+	}
+	if got := Display(Normalize(s.Data())); got != "every weekday at 09:00 in America/New_York, Asia/Tokyo, Europe/London" {
+		t.Errorf("Normalize = %q", got)
+	}
+	single, err := s.InTimezone("UTC")
+	if err != nil || single.String() != "every weekday at 09:00 in UTC" || len(single.Timezones()) != 1 {
+		t.Errorf("InTimezone = %v, %v", single, err)
+	}
+
+	for _, input := range []string{
+		"every day at 09:00 in UTC, UTC",
+		"every day at 09:00 in UTC, local",
+		"every day at 09:00 in UTC,",
+		"every day at 09:00 in UTC, Nowhere/Special",
+	} {
+		if _, err := ParseSchedule(input); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", input)
+		}
+	}
+}